@@ -44,11 +44,18 @@ func main() {
 	channeld.InitMetrics()
 	channeld.InitConnections(channeld.GlobalSettings.ServerFSM, channeld.GlobalSettings.ClientFSM)
 	channeld.InitChannels()
+	channeld.StartSettingsReloader()
 
 	// Setup Prometheus
 	http.Handle("/metrics", promhttp.Handler())
 	go http.ListenAndServe(":8080", nil)
 
+	if channeld.GlobalSettings.AdminAddress != "" {
+		adminMux := http.NewServeMux()
+		adminMux.HandleFunc("/reload", channeld.HandleReloadSettings)
+		go http.ListenAndServe(channeld.GlobalSettings.AdminAddress, adminMux)
+	}
+
 	go channeld.StartListening(channeldpb.ConnectionType_SERVER, channeld.GlobalSettings.ServerNetwork, channeld.GlobalSettings.ServerAddress)
 	// FIXME: After all the server connections are established, the client connection should be listened.*/
 	channeld.StartListening(channeldpb.ConnectionType_CLIENT, channeld.GlobalSettings.ClientNetwork, channeld.GlobalSettings.ClientAddress)
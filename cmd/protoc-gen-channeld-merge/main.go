@@ -0,0 +1,486 @@
+// protoc-gen-channeld-merge generates the implementations of [channeld.MergeableChannelData], [channeld.ChannelDataInitializer],
+// [channeld.SpatialChannelEntityUpdater], and [channeld.HandoverDataMerger] for the channel data messages annotated with the options
+// in pkg/channeldpb/merge_options.proto, so the channel data don't need the reflection-based merge, which is >10 times slower.
+//
+// The generated Merge() follows the semantic of proto.Merge, except:
+//   - The existing message values of a map are merged with the new values, instead of being replaced;
+//   - The list and map fields are merged according to the @FieldMergeOptions, or the @ChannelDataMergeOptions of the channel if not set;
+//   - The change of the spatial field (see @FieldMergeOptions.spatialField) notifies the spatial notifier.
+//
+// Usage:
+//
+//	go install github.com/metaworking/channeld/cmd/protoc-gen-channeld-merge
+//	protoc --go_out=. --go_opt=paths=source_relative --channeld-merge_out=. --channeld-merge_opt=paths=source_relative -I . *.proto
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/metaworking/channeld/pkg/channeldpb"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+const (
+	errorsPackage     = protogen.GoImportPath("errors")
+	protoPackage      = protogen.GoImportPath("google.golang.org/protobuf/proto")
+	channeldPackage   = protogen.GoImportPath("github.com/metaworking/channeld/pkg/channeld")
+	channeldpbPackage = protogen.GoImportPath("github.com/metaworking/channeld/pkg/channeldpb")
+	commonPackage     = protogen.GoImportPath("github.com/metaworking/channeld/pkg/common")
+)
+
+func main() {
+	protogen.Options{}.Run(func(gen *protogen.Plugin) error {
+		gen.SupportedFeatures = uint64(pluginpb.CodeGeneratorResponse_FEATURE_PROTO3_OPTIONAL)
+		for _, f := range gen.Files {
+			if !f.Generate {
+				continue
+			}
+			if err := generateFile(gen, f); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func messageMergeOptions(msg *protogen.Message) *channeldpb.MessageMergeOptions {
+	opts, _ := proto.GetExtension(msg.Desc.Options(), channeldpb.E_MessageMerge).(*channeldpb.MessageMergeOptions)
+	return opts
+}
+
+// Returns nil if the field is not annotated, so the @ChannelDataMergeOptions of the channel applies.
+func fieldMergeOptions(field *protogen.Field) *channeldpb.FieldMergeOptions {
+	opts, _ := proto.GetExtension(field.Desc.Options(), channeldpb.E_FieldMerge).(*channeldpb.FieldMergeOptions)
+	return opts
+}
+
+// Returns the annotated messages in the file, including the nested ones.
+func annotatedMessages(messages []*protogen.Message) []*protogen.Message {
+	result := make([]*protogen.Message, 0)
+	for _, msg := range messages {
+		if msg.Desc.IsMapEntry() {
+			continue
+		}
+		if opts := messageMergeOptions(msg); opts != nil && (opts.Generate || opts.EntitiesField != "" || opts.HandoverMapField != "") {
+			result = append(result, msg)
+		}
+		result = append(result, annotatedMessages(msg.Messages)...)
+	}
+	return result
+}
+
+func generateFile(gen *protogen.Plugin, file *protogen.File) error {
+	messages := annotatedMessages(file.Messages)
+	if len(messages) == 0 {
+		return nil
+	}
+
+	g := gen.NewGeneratedFile(file.GeneratedFilenamePrefix+"_merge.pb.go", file.GoImportPath)
+	g.P("// Code generated by protoc-gen-channeld-merge. DO NOT EDIT.")
+	g.P("// source: ", file.Desc.Path())
+	g.P()
+	g.P("package ", file.GoPackageName)
+	g.P()
+
+	for _, msg := range messages {
+		opts := messageMergeOptions(msg)
+		if opts.Generate {
+			if err := generateMerge(g, msg); err != nil {
+				return err
+			}
+			generateInit(g, msg)
+		}
+		if opts.EntitiesField != "" {
+			if err := generateEntityUpdater(g, msg, opts.EntitiesField); err != nil {
+				return err
+			}
+		}
+		if opts.HandoverMapField != "" {
+			if err := generateHandoverMerger(gen, g, file, msg, opts); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Returns the Go type of the field's value, or the element type if it's a list.
+func goType(g *protogen.GeneratedFile, field *protogen.Field) string {
+	switch field.Desc.Kind() {
+	case protoreflect.BoolKind:
+		return "bool"
+	case protoreflect.EnumKind:
+		return g.QualifiedGoIdent(field.Enum.GoIdent)
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return "int32"
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return "uint32"
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return "int64"
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return "uint64"
+	case protoreflect.FloatKind:
+		return "float32"
+	case protoreflect.DoubleKind:
+		return "float64"
+	case protoreflect.StringKind:
+		return "string"
+	case protoreflect.BytesKind:
+		return "[]byte"
+	default:
+		return "*" + g.QualifiedGoIdent(field.Message.GoIdent)
+	}
+}
+
+func findField(msg *protogen.Message, name string) *protogen.Field {
+	for _, field := range msg.Fields {
+		if string(field.Desc.Name()) == name {
+			return field
+		}
+	}
+	return nil
+}
+
+func isMessage(field *protogen.Field) bool {
+	return field.Message != nil
+}
+
+func generateMerge(g *protogen.GeneratedFile, msg *protogen.Message) error {
+	typeName := msg.GoIdent.GoName
+	g.P("// Implement [channeld.MergeableChannelData]")
+	g.P("func (dst *", typeName, ") Merge(src ", commonPackage.Ident("ChannelDataMessage"), ", options *", channeldpbPackage.Ident("ChannelDataMergeOptions"),
+		", spatialNotifier ", commonPackage.Ident("SpatialInfoChangedNotifier"), ") error {")
+	g.P("srcData, ok := src.(*", typeName, ")")
+	g.P("if !ok {")
+	g.P("return ", errorsPackage.Ident("New"), "(\"src is not a ", typeName, "\")")
+	g.P("}")
+	g.P()
+
+	mergedOneofs := make(map[*protogen.Oneof]bool)
+	for _, field := range msg.Fields {
+		if oneof := field.Oneof; oneof != nil && !oneof.Desc.IsSynthetic() {
+			if mergedOneofs[oneof] {
+				continue
+			}
+			mergedOneofs[oneof] = true
+			g.P("if srcData.", oneof.GoName, " != nil {")
+			g.P(protoPackage.Ident("Merge"), "(dst, &", typeName, "{", oneof.GoName, ": srcData.", oneof.GoName, "})")
+			g.P("}")
+			g.P()
+			continue
+		}
+
+		var err error
+		switch {
+		case field.Desc.IsMap():
+			err = generateMapMerge(g, msg, field)
+		case field.Desc.IsList():
+			generateListMerge(g, field)
+		case isMessage(field):
+			g.P("if srcData.", field.GoName, " != nil {")
+			g.P("if dst.", field.GoName, " == nil {")
+			g.P("dst.", field.GoName, " = ", protoPackage.Ident("Clone"), "(srcData.", field.GoName, ").(", goType(g, field), ")")
+			g.P("} else {")
+			g.P(protoPackage.Ident("Merge"), "(dst.", field.GoName, ", srcData.", field.GoName, ")")
+			g.P("}")
+			g.P("}")
+		case field.Desc.Kind() == protoreflect.BytesKind:
+			if field.Desc.HasPresence() {
+				g.P("if srcData.", field.GoName, " != nil {")
+			} else {
+				g.P("if len(srcData.", field.GoName, ") > 0 {")
+			}
+			g.P("dst.", field.GoName, " = append([]byte{}, srcData.", field.GoName, "...)")
+			g.P("}")
+		case field.Desc.HasPresence():
+			g.P("if srcData.", field.GoName, " != nil {")
+			g.P("v := *srcData.", field.GoName)
+			g.P("dst.", field.GoName, " = &v")
+			g.P("}")
+		default:
+			switch field.Desc.Kind() {
+			case protoreflect.BoolKind:
+				g.P("if srcData.", field.GoName, " {")
+			case protoreflect.StringKind:
+				g.P("if srcData.", field.GoName, " != \"\" {")
+			default:
+				g.P("if srcData.", field.GoName, " != 0 {")
+			}
+			g.P("dst.", field.GoName, " = srcData.", field.GoName)
+			g.P("}")
+		}
+		if err != nil {
+			return err
+		}
+		g.P()
+	}
+
+	g.P("return nil")
+	g.P("}")
+	g.P()
+	return nil
+}
+
+// The annotated options override the @ChannelDataMergeOptions of the channel, so they are generated as constants.
+func generateListMerge(g *protogen.GeneratedFile, field *protogen.Field) {
+	opts := fieldMergeOptions(field)
+	name := field.GoName
+
+	if opts == nil {
+		g.P("if options != nil && options.ShouldReplaceList {")
+		g.P("dst.", name, " = dst.", name, "[:0]")
+		g.P("}")
+	} else if opts.ReplaceList {
+		g.P("dst.", name, " = dst.", name, "[:0]")
+	}
+	switch {
+	case isMessage(field):
+		g.P("for _, v := range srcData.", name, " {")
+		g.P("dst.", name, " = append(dst.", name, ", ", protoPackage.Ident("Clone"), "(v).(", goType(g, field), "))")
+		g.P("}")
+	case field.Desc.Kind() == protoreflect.BytesKind:
+		g.P("for _, v := range srcData.", name, " {")
+		g.P("dst.", name, " = append(dst.", name, ", append([]byte{}, v...))")
+		g.P("}")
+	default:
+		g.P("dst.", name, " = append(dst.", name, ", srcData.", name, "...)")
+	}
+
+	if opts == nil {
+		g.P("if options != nil && options.ListSizeLimit > 0 && len(dst.", name, ") > int(options.ListSizeLimit) {")
+		g.P("limit := int(options.ListSizeLimit)")
+		g.P("if options.TruncateTop {")
+		g.P("dst.", name, " = dst.", name, "[len(dst.", name, ")-limit:]")
+		g.P("} else {")
+		g.P("dst.", name, " = dst.", name, "[:limit]")
+		g.P("}")
+		g.P("}")
+	} else if opts.ListSizeLimit > 0 {
+		g.P("if limit := ", opts.ListSizeLimit, "; len(dst.", name, ") > limit {")
+		if opts.TruncateTop {
+			g.P("dst.", name, " = dst.", name, "[len(dst.", name, ")-limit:]")
+		} else {
+			g.P("dst.", name, " = dst.", name, "[:limit]")
+		}
+		g.P("}")
+	}
+}
+
+func generateMapMerge(g *protogen.GeneratedFile, msg *protogen.Message, field *protogen.Field) error {
+	opts := fieldMergeOptions(field)
+	annotated := opts != nil
+	if !annotated {
+		opts = &channeldpb.FieldMergeOptions{}
+	}
+	name := field.GoName
+	keyField, valueField := field.Message.Fields[0], field.Message.Fields[1]
+	mapType := "map[" + goType(g, keyField) + "]" + goType(g, valueField)
+
+	g.P("if len(srcData.", name, ") > 0 && dst.", name, " == nil {")
+	g.P("dst.", name, " = make(", mapType, ")")
+	g.P("}")
+	g.P("for k, v := range srcData.", name, " {")
+
+	if isMessage(valueField) {
+		if removedField := findField(valueField.Message, "removed"); removedField != nil && removedField.Desc.Kind() == protoreflect.BoolKind {
+			if !annotated {
+				g.P("if options != nil && options.ShouldCheckRemovableMapField && v.Get", removedField.GoName, "() {")
+			} else if opts.Removable {
+				g.P("if v.Get", removedField.GoName, "() {")
+			}
+			if !annotated || opts.Removable {
+				g.P("delete(dst.", name, ", k)")
+				g.P("continue")
+				g.P("}")
+			}
+		} else if opts.Removable {
+			return fmt.Errorf("%s: the map value %s doesn't have the bool field 'removed'", field.Desc.FullName(), valueField.Message.Desc.FullName())
+		}
+
+		g.P("if old, exists := dst.", name, "[k]; exists {")
+		if opts.SpatialField != "" {
+			if err := generateSpatialNotify(g, msg, field, opts.SpatialField); err != nil {
+				return err
+			}
+		}
+		g.P(protoPackage.Ident("Merge"), "(old, v)")
+		g.P("} else {")
+		g.P("dst.", name, "[k] = ", protoPackage.Ident("Clone"), "(v).(", goType(g, valueField), ")")
+		g.P("}")
+	} else {
+		if opts.Removable || opts.SpatialField != "" {
+			return fmt.Errorf("%s: removable and spatialField only apply to the map of message values", field.Desc.FullName())
+		}
+		if valueField.Desc.Kind() == protoreflect.BytesKind {
+			g.P("dst.", name, "[k] = append([]byte{}, v...)")
+		} else {
+			g.P("dst.", name, "[k] = v")
+		}
+	}
+	g.P("}")
+	return nil
+}
+
+func generateSpatialNotify(g *protogen.GeneratedFile, msg *protogen.Message, field *protogen.Field, spatialFieldName string) error {
+	valueField := field.Message.Fields[1]
+	spatialField := findField(valueField.Message, spatialFieldName)
+	if spatialField == nil || !isMessage(spatialField) || spatialField.Desc.IsList() || spatialField.Desc.IsMap() {
+		return fmt.Errorf("%s: spatialField '%s' should be a message field of %s", field.Desc.FullName(), spatialFieldName, valueField.Message.Desc.FullName())
+	}
+	x, y, z := findField(spatialField.Message, "x"), findField(spatialField.Message, "y"), findField(spatialField.Message, "z")
+	if x == nil || z == nil {
+		return fmt.Errorf("%s: the spatial field %s should have the x and z fields", field.Desc.FullName(), spatialField.Desc.FullName())
+	}
+
+	spatialInfo := func(v string) string {
+		pos := v + "." + spatialField.GoName
+		coords := []string{"X: float64(" + pos + "." + x.GoName + ")"}
+		if y != nil {
+			coords = append(coords, "Y: float64("+pos+"."+y.GoName+")")
+		}
+		coords = append(coords, "Z: float64("+pos+"."+z.GoName+")")
+		return g.QualifiedGoIdent(commonPackage.Ident("SpatialInfo")) + "{" + strings.Join(coords, ", ") + "}"
+	}
+
+	pos := spatialField.GoName
+	g.P("if spatialNotifier != nil && old.", pos, " != nil && v.", pos, " != nil &&")
+	g.P("(old.", pos, ".", x.GoName, " != v.", pos, ".", x.GoName, " || old.", pos, ".", z.GoName, " != v.", pos, ".", z.GoName, ") {")
+	g.P("spatialNotifier.Notify(")
+	g.P(spatialInfo("old"), ",")
+	g.P(spatialInfo("v"), ",")
+	g.P("func(srcChannelId ", commonPackage.Ident("ChannelId"), ", dstChannelId ", commonPackage.Ident("ChannelId"), ", handoverData interface{}) {")
+	g.P("handoverChan, ok := handoverData.(chan ", commonPackage.Ident("Message"), ")")
+	g.P("if !ok {")
+	g.P("return")
+	g.P("}")
+	// The handover data contains the entries of the same key in all the map fields.
+	keyType := goType(g, field.Message.Fields[0])
+	g.P("data := &", msg.GoIdent.GoName, "{")
+	g.P(field.GoName, ": map[", keyType, "]", goType(g, valueField), "{k: old},")
+	g.P("}")
+	for _, other := range msg.Fields {
+		if other == field || !other.Desc.IsMap() || goType(g, other.Message.Fields[0]) != keyType {
+			continue
+		}
+		g.P("if entry, exists := dst.", other.GoName, "[k]; exists {")
+		g.P("data.", other.GoName, " = map[", keyType, "]", goType(g, other.Message.Fields[1]), "{k: entry}")
+		g.P("}")
+	}
+	g.P("handoverChan <- data")
+	g.P("},")
+	g.P(")")
+	g.P("}")
+	return nil
+}
+
+func generateInit(g *protogen.GeneratedFile, msg *protogen.Message) {
+	g.P("// Implement [channeld.ChannelDataInitializer]")
+	g.P("func (data *", msg.GoIdent.GoName, ") Init() error {")
+	for _, field := range msg.Fields {
+		if !field.Desc.IsMap() {
+			continue
+		}
+		g.P("if data.", field.GoName, " == nil {")
+		g.P("data.", field.GoName, " = make(map[", goType(g, field.Message.Fields[0]), "]", goType(g, field.Message.Fields[1]), ")")
+		g.P("}")
+	}
+	g.P("return nil")
+	g.P("}")
+	g.P()
+}
+
+func isIntegerKind(kind protoreflect.Kind) bool {
+	switch kind {
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Uint32Kind, protoreflect.Fixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind,
+		protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return true
+	}
+	return false
+}
+
+func generateEntityUpdater(g *protogen.GeneratedFile, msg *protogen.Message, entitiesFieldName string) error {
+	field := findField(msg, entitiesFieldName)
+	if field == nil || !field.Desc.IsMap() || !isIntegerKind(field.Message.Fields[0].Desc.Kind()) || !isMessage(field.Message.Fields[1]) {
+		return fmt.Errorf("%s: entitiesField '%s' should be a map field with integer keys and message values", msg.Desc.FullName(), entitiesFieldName)
+	}
+	keyType := goType(g, field.Message.Fields[0])
+	valueType := goType(g, field.Message.Fields[1])
+
+	g.P("// Implement [channeld.SpatialChannelEntityUpdater]")
+	g.P("func (data *", msg.GoIdent.GoName, ") AddEntity(entityId ", channeldPackage.Ident("EntityId"), ", msg ", commonPackage.Ident("Message"), ") error {")
+	g.P("entity, ok := msg.(", valueType, ")")
+	g.P("if !ok {")
+	g.P("return ", errorsPackage.Ident("New"), "(\"msg is not a ", field.Message.Fields[1].Message.GoIdent.GoName, "\")")
+	g.P("}")
+	g.P("if data.", field.GoName, " == nil {")
+	g.P("data.", field.GoName, " = make(map[", keyType, "]", valueType, ")")
+	g.P("}")
+	g.P("data.", field.GoName, "[", keyType, "(entityId)] = entity")
+	g.P("return nil")
+	g.P("}")
+	g.P()
+	g.P("func (data *", msg.GoIdent.GoName, ") RemoveEntity(entityId ", channeldPackage.Ident("EntityId"), ") error {")
+	g.P("delete(data.", field.GoName, ", ", keyType, "(entityId))")
+	g.P("return nil")
+	g.P("}")
+	g.P()
+	return nil
+}
+
+func generateHandoverMerger(gen *protogen.Plugin, g *protogen.GeneratedFile, file *protogen.File, msg *protogen.Message, opts *channeldpb.MessageMergeOptions) error {
+	parts := strings.Split(opts.HandoverMapField, ".")
+	if len(parts) != 2 {
+		return fmt.Errorf("%s: handoverMapField '%s' should be in the form of '<message name>.<field name>'", msg.Desc.FullName(), opts.HandoverMapField)
+	}
+
+	// Find the spatial channel data message in the same package.
+	var target *protogen.Message
+	for _, f := range gen.Files {
+		if f.GoImportPath != file.GoImportPath {
+			continue
+		}
+		for _, m := range f.Messages {
+			if string(m.Desc.Name()) == parts[0] {
+				target = m
+			}
+		}
+	}
+	if target == nil {
+		return fmt.Errorf("%s: message '%s' of the handoverMapField is not found in the package", msg.Desc.FullName(), parts[0])
+	}
+	mapField := findField(target, parts[1])
+	if mapField == nil || !mapField.Desc.IsMap() || mapField.Message.Fields[1].Message != msg {
+		return fmt.Errorf("%s: handoverMapField '%s' should be a map field with the value type %s", msg.Desc.FullName(), opts.HandoverMapField, msg.Desc.Name())
+	}
+	idField := findField(msg, opts.EntityIdField)
+	keyType := goType(g, mapField.Message.Fields[0])
+	if idField == nil || idField.Desc.HasPresence() || idField.Desc.IsList() || idField.Desc.IsMap() || goType(g, idField) != keyType {
+		return fmt.Errorf("%s: entityIdField '%s' should be a non-optional %s field", msg.Desc.FullName(), opts.EntityIdField, keyType)
+	}
+
+	typeName := msg.GoIdent.GoName
+	g.P("// Implement [channeld.HandoverDataMerger]")
+	g.P("func (data *", typeName, ") MergeTo(msg ", commonPackage.Ident("Message"), ", fullData bool) error {")
+	g.P("handoverData, ok := msg.(*", g.QualifiedGoIdent(target.GoIdent), ")")
+	g.P("if !ok {")
+	g.P("return ", errorsPackage.Ident("New"), "(\"msg is not a ", target.GoIdent.GoName, "\")")
+	g.P("}")
+	g.P("if handoverData.", mapField.GoName, " == nil {")
+	g.P("handoverData.", mapField.GoName, " = make(map[", keyType, "]*", typeName, ")")
+	g.P("}")
+	g.P("if fullData {")
+	g.P("handoverData.", mapField.GoName, "[data.", idField.GoName, "] = ", protoPackage.Ident("Clone"), "(data).(*", typeName, ")")
+	g.P("} else {")
+	g.P("handoverData.", mapField.GoName, "[data.", idField.GoName, "] = &", typeName, "{", idField.GoName, ": data.", idField.GoName, "}")
+	g.P("}")
+	g.P("return nil")
+	g.P("}")
+	g.P()
+	return nil
+}
@@ -0,0 +1,166 @@
+package testpb
+
+import (
+	"testing"
+
+	"github.com/metaworking/channeld/pkg/channeld"
+	"github.com/metaworking/channeld/pkg/channeldpb"
+	"github.com/metaworking/channeld/pkg/common"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+type testSpatialNotifier struct {
+	oldInfo, newInfo common.SpatialInfo
+	handoverData     common.Message
+}
+
+func (n *testSpatialNotifier) Notify(oldInfo common.SpatialInfo, newInfo common.SpatialInfo, handoverDataProvider func(common.ChannelId, common.ChannelId, interface{})) {
+	n.oldInfo, n.newInfo = oldInfo, newInfo
+	handoverChan := make(chan common.Message, 1)
+	handoverDataProvider(0, 1, handoverChan)
+	n.handoverData = <-handoverChan
+}
+
+var _ channeld.MergeableChannelData = &TestSpatialChannelData{}
+var _ channeld.ChannelDataInitializer = &TestSpatialChannelData{}
+var _ channeld.SpatialChannelEntityUpdater = &TestSpatialChannelData{}
+var _ channeld.HandoverDataMerger = &TestEntityChannelData{}
+
+func TestGeneratedMerge(t *testing.T) {
+	dst := &TestSpatialChannelData{}
+	assert.NoError(t, dst.Init())
+
+	src := &TestSpatialChannelData{
+		Name:         "a",
+		Count:        proto.Uint32(0),
+		SingleEntity: &TestEntityChannelData{Id: 1, Hp: 100},
+		Logs:         []string{"1", "2"},
+		History:      []*TestEntityChannelData{{Id: 1}},
+		Entities:     map[uint32]*TestEntityChannelData{1: {Id: 1, Hp: 100, Position: &TestPosition{X: 1, Z: 1}}},
+		Names:        map[uint32]string{1: "one"},
+		Choice:       &TestSpatialChannelData_ChoiceText{ChoiceText: "text"},
+	}
+	assert.NoError(t, dst.Merge(src, nil, nil))
+	assert.True(t, proto.Equal(src, dst))
+	// The src is not shared with the dst
+	src.SingleEntity.Hp = 0
+	src.Entities[1].Hp = 0
+	assert.EqualValues(t, 100, dst.SingleEntity.Hp)
+	assert.EqualValues(t, 100, dst.Entities[1].Hp)
+
+	assert.NoError(t, dst.Merge(&TestSpatialChannelData{
+		SingleEntity: &TestEntityChannelData{Hp: 50},
+		Logs:         []string{"3", "4"},
+		History:      []*TestEntityChannelData{{Id: 2}},
+		// Partial update of the existing map value
+		Entities: map[uint32]*TestEntityChannelData{1: {Hp: 50}, 2: {Id: 2}},
+		Choice:   &TestSpatialChannelData_ChoiceEntity{ChoiceEntity: &TestEntityChannelData{Id: 3}},
+	}, nil, nil))
+	assert.Equal(t, "a", dst.Name)
+	assert.EqualValues(t, 0, *dst.Count)
+	assert.EqualValues(t, 1, dst.SingleEntity.Id)
+	assert.EqualValues(t, 50, dst.SingleEntity.Hp)
+	// Truncated from the top
+	assert.Equal(t, []string{"2", "3", "4"}, dst.Logs)
+	// Replaced
+	assert.Len(t, dst.History, 1)
+	assert.EqualValues(t, 2, dst.History[0].Id)
+	assert.Len(t, dst.Entities, 2)
+	assert.EqualValues(t, 1, dst.Entities[1].Id)
+	assert.EqualValues(t, 50, dst.Entities[1].Hp)
+	assert.EqualValues(t, 3, dst.GetChoiceEntity().Id)
+
+	// Removable
+	assert.NoError(t, dst.Merge(&TestSpatialChannelData{
+		Entities: map[uint32]*TestEntityChannelData{2: {Removed: true}},
+	}, nil, nil))
+	assert.NotContains(t, dst.Entities, uint32(2))
+}
+
+func TestGeneratedMergeWithOptions(t *testing.T) {
+	dst := &TestSpatialChannelData{
+		Nums:       []uint32{1, 2},
+		Removables: map[uint32]*TestEntityChannelData{1: {Id: 1}},
+	}
+	src := &TestSpatialChannelData{
+		Nums:       []uint32{3, 4, 5},
+		Removables: map[uint32]*TestEntityChannelData{1: {Removed: true}},
+	}
+
+	// Without the options
+	other := proto.Clone(dst).(*TestSpatialChannelData)
+	assert.NoError(t, other.Merge(src, nil, nil))
+	assert.Equal(t, []uint32{1, 2, 3, 4, 5}, other.Nums)
+	assert.True(t, other.Removables[1].Removed)
+
+	// Same as the reflection-based merge
+	options := &channeldpb.ChannelDataMergeOptions{ListSizeLimit: 2, TruncateTop: true, ShouldCheckRemovableMapField: true}
+	other = proto.Clone(dst).(*TestSpatialChannelData)
+	channeld.ReflectMerge(other, src, options)
+	assert.NoError(t, dst.Merge(src, options, nil))
+	assert.Equal(t, []uint32{4, 5}, dst.Nums)
+	assert.Empty(t, dst.Removables)
+	assert.True(t, proto.Equal(other, dst))
+
+	options = &channeldpb.ChannelDataMergeOptions{ShouldReplaceList: true, ListSizeLimit: 2}
+	assert.NoError(t, dst.Merge(src, options, nil))
+	assert.Equal(t, []uint32{3, 4}, dst.Nums)
+
+	// The annotated fields ignore the options
+	dst = &TestSpatialChannelData{
+		Logs:    []string{"1", "2"},
+		History: []*TestEntityChannelData{{Id: 1}},
+	}
+	options = &channeldpb.ChannelDataMergeOptions{ShouldReplaceList: true, ListSizeLimit: 1}
+	assert.NoError(t, dst.Merge(&TestSpatialChannelData{
+		Logs:    []string{"3", "4"},
+		History: []*TestEntityChannelData{{Id: 2}, {Id: 3}},
+	}, options, nil))
+	assert.Equal(t, []string{"2", "3", "4"}, dst.Logs)
+	assert.Len(t, dst.History, 2)
+}
+
+func TestGeneratedSpatialNotify(t *testing.T) {
+	dst := &TestSpatialChannelData{
+		Entities: map[uint32]*TestEntityChannelData{1: {Id: 1, Position: &TestPosition{X: 1, Y: 2, Z: 3}}},
+		Names:    map[uint32]string{1: "one", 2: "two"},
+	}
+	notifier := &testSpatialNotifier{}
+
+	// The change of Y doesn't notify
+	assert.NoError(t, dst.Merge(&TestSpatialChannelData{
+		Entities: map[uint32]*TestEntityChannelData{1: {Position: &TestPosition{X: 1, Y: 5, Z: 3}}},
+	}, nil, notifier))
+	assert.Nil(t, notifier.handoverData)
+
+	assert.NoError(t, dst.Merge(&TestSpatialChannelData{
+		Entities: map[uint32]*TestEntityChannelData{1: {Position: &TestPosition{X: 10, Y: 5, Z: 3}}},
+	}, nil, notifier))
+	assert.Equal(t, common.SpatialInfo{X: 1, Y: 5, Z: 3}, notifier.oldInfo)
+	assert.Equal(t, common.SpatialInfo{X: 10, Y: 5, Z: 3}, notifier.newInfo)
+	assert.EqualValues(t, 10, dst.Entities[1].Position.X)
+
+	handoverData := notifier.handoverData.(*TestSpatialChannelData)
+	assert.Len(t, handoverData.Entities, 1)
+	assert.EqualValues(t, 1, handoverData.Entities[1].Id)
+	// The entries of the same key in the other map fields
+	assert.Equal(t, map[uint32]string{1: "one"}, handoverData.Names)
+}
+
+func TestGeneratedEntityUpdaterAndHandoverMerger(t *testing.T) {
+	spatialData := &TestSpatialChannelData{}
+	entityData := &TestEntityChannelData{Id: 1, Hp: 100}
+
+	assert.NoError(t, spatialData.AddEntity(1, entityData))
+	assert.Equal(t, entityData, spatialData.Entities[1])
+	assert.Error(t, spatialData.AddEntity(2, &TestPosition{}))
+	assert.NoError(t, spatialData.RemoveEntity(1))
+	assert.Empty(t, spatialData.Entities)
+
+	assert.NoError(t, entityData.MergeTo(spatialData, false))
+	assert.True(t, proto.Equal(&TestEntityChannelData{Id: 1}, spatialData.Entities[1]))
+	assert.NoError(t, entityData.MergeTo(spatialData, true))
+	assert.True(t, proto.Equal(entityData, spatialData.Entities[1]))
+	assert.Error(t, entityData.MergeTo(&TestPosition{}, true))
+}
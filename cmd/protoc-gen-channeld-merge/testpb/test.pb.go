@@ -0,0 +1,501 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.20.1
+// source: test.proto
+
+package testpb
+
+import (
+	_ "github.com/metaworking/channeld/pkg/channeldpb"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type TestSpatialChannelData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name         string                            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Count        *uint32                           `protobuf:"varint,2,opt,name=count,proto3,oneof" json:"count,omitempty"`
+	SingleEntity *TestEntityChannelData            `protobuf:"bytes,3,opt,name=singleEntity,proto3" json:"singleEntity,omitempty"`
+	Logs         []string                          `protobuf:"bytes,4,rep,name=logs,proto3" json:"logs,omitempty"`
+	History      []*TestEntityChannelData          `protobuf:"bytes,5,rep,name=history,proto3" json:"history,omitempty"`
+	Entities     map[uint32]*TestEntityChannelData `protobuf:"bytes,6,rep,name=entities,proto3" json:"entities,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Names        map[uint32]string                 `protobuf:"bytes,7,rep,name=names,proto3" json:"names,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Types that are assignable to Choice:
+	//	*TestSpatialChannelData_ChoiceText
+	//	*TestSpatialChannelData_ChoiceEntity
+	Choice isTestSpatialChannelData_Choice `protobuf_oneof:"choice"`
+	// Merged with the ChannelDataMergeOptions of the channel
+	Nums       []uint32                          `protobuf:"varint,10,rep,packed,name=nums,proto3" json:"nums,omitempty"`
+	Removables map[uint32]*TestEntityChannelData `protobuf:"bytes,11,rep,name=removables,proto3" json:"removables,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *TestSpatialChannelData) Reset() {
+	*x = TestSpatialChannelData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_test_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TestSpatialChannelData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestSpatialChannelData) ProtoMessage() {}
+
+func (x *TestSpatialChannelData) ProtoReflect() protoreflect.Message {
+	mi := &file_test_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestSpatialChannelData.ProtoReflect.Descriptor instead.
+func (*TestSpatialChannelData) Descriptor() ([]byte, []int) {
+	return file_test_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TestSpatialChannelData) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TestSpatialChannelData) GetCount() uint32 {
+	if x != nil && x.Count != nil {
+		return *x.Count
+	}
+	return 0
+}
+
+func (x *TestSpatialChannelData) GetSingleEntity() *TestEntityChannelData {
+	if x != nil {
+		return x.SingleEntity
+	}
+	return nil
+}
+
+func (x *TestSpatialChannelData) GetLogs() []string {
+	if x != nil {
+		return x.Logs
+	}
+	return nil
+}
+
+func (x *TestSpatialChannelData) GetHistory() []*TestEntityChannelData {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+func (x *TestSpatialChannelData) GetEntities() map[uint32]*TestEntityChannelData {
+	if x != nil {
+		return x.Entities
+	}
+	return nil
+}
+
+func (x *TestSpatialChannelData) GetNames() map[uint32]string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+func (m *TestSpatialChannelData) GetChoice() isTestSpatialChannelData_Choice {
+	if m != nil {
+		return m.Choice
+	}
+	return nil
+}
+
+func (x *TestSpatialChannelData) GetChoiceText() string {
+	if x, ok := x.GetChoice().(*TestSpatialChannelData_ChoiceText); ok {
+		return x.ChoiceText
+	}
+	return ""
+}
+
+func (x *TestSpatialChannelData) GetChoiceEntity() *TestEntityChannelData {
+	if x, ok := x.GetChoice().(*TestSpatialChannelData_ChoiceEntity); ok {
+		return x.ChoiceEntity
+	}
+	return nil
+}
+
+func (x *TestSpatialChannelData) GetNums() []uint32 {
+	if x != nil {
+		return x.Nums
+	}
+	return nil
+}
+
+func (x *TestSpatialChannelData) GetRemovables() map[uint32]*TestEntityChannelData {
+	if x != nil {
+		return x.Removables
+	}
+	return nil
+}
+
+type isTestSpatialChannelData_Choice interface {
+	isTestSpatialChannelData_Choice()
+}
+
+type TestSpatialChannelData_ChoiceText struct {
+	ChoiceText string `protobuf:"bytes,8,opt,name=choiceText,proto3,oneof"`
+}
+
+type TestSpatialChannelData_ChoiceEntity struct {
+	ChoiceEntity *TestEntityChannelData `protobuf:"bytes,9,opt,name=choiceEntity,proto3,oneof"`
+}
+
+func (*TestSpatialChannelData_ChoiceText) isTestSpatialChannelData_Choice() {}
+
+func (*TestSpatialChannelData_ChoiceEntity) isTestSpatialChannelData_Choice() {}
+
+type TestEntityChannelData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Removed  bool          `protobuf:"varint,1,opt,name=removed,proto3" json:"removed,omitempty"`
+	Id       uint32        `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Position *TestPosition `protobuf:"bytes,3,opt,name=position,proto3" json:"position,omitempty"`
+	Hp       uint32        `protobuf:"varint,4,opt,name=hp,proto3" json:"hp,omitempty"`
+}
+
+func (x *TestEntityChannelData) Reset() {
+	*x = TestEntityChannelData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_test_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TestEntityChannelData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestEntityChannelData) ProtoMessage() {}
+
+func (x *TestEntityChannelData) ProtoReflect() protoreflect.Message {
+	mi := &file_test_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestEntityChannelData.ProtoReflect.Descriptor instead.
+func (*TestEntityChannelData) Descriptor() ([]byte, []int) {
+	return file_test_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TestEntityChannelData) GetRemoved() bool {
+	if x != nil {
+		return x.Removed
+	}
+	return false
+}
+
+func (x *TestEntityChannelData) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TestEntityChannelData) GetPosition() *TestPosition {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+func (x *TestEntityChannelData) GetHp() uint32 {
+	if x != nil {
+		return x.Hp
+	}
+	return 0
+}
+
+type TestPosition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	X float32 `protobuf:"fixed32,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y float32 `protobuf:"fixed32,2,opt,name=y,proto3" json:"y,omitempty"`
+	Z float32 `protobuf:"fixed32,3,opt,name=z,proto3" json:"z,omitempty"`
+}
+
+func (x *TestPosition) Reset() {
+	*x = TestPosition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_test_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TestPosition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestPosition) ProtoMessage() {}
+
+func (x *TestPosition) ProtoReflect() protoreflect.Message {
+	mi := &file_test_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestPosition.ProtoReflect.Descriptor instead.
+func (*TestPosition) Descriptor() ([]byte, []int) {
+	return file_test_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TestPosition) GetX() float32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *TestPosition) GetY() float32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *TestPosition) GetZ() float32 {
+	if x != nil {
+		return x.Z
+	}
+	return 0
+}
+
+var File_test_proto protoreflect.FileDescriptor
+
+var file_test_proto_rawDesc = []byte{
+	0x0a, 0x0a, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x6d, 0x65,
+	0x72, 0x67, 0x65, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62, 0x1a, 0x22, 0x70, 0x6b, 0x67, 0x2f, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x64, 0x70, 0x62, 0x2f, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x5f,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x93, 0x07,
+	0x0a, 0x16, 0x54, 0x65, 0x73, 0x74, 0x53, 0x70, 0x61, 0x74, 0x69, 0x61, 0x6c, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x01, 0x52, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x46, 0x0a, 0x0c, 0x73, 0x69, 0x6e, 0x67, 0x6c,
+	0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e,
+	0x6d, 0x65, 0x72, 0x67, 0x65, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x54, 0x65, 0x73, 0x74,
+	0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x44, 0x61, 0x74,
+	0x61, 0x52, 0x0c, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12,
+	0x1c, 0x0a, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x42, 0x08, 0xca,
+	0xf3, 0x18, 0x04, 0x10, 0x03, 0x18, 0x01, 0x52, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x12, 0x44, 0x0a,
+	0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22,
+	0x2e, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x54, 0x65, 0x73,
+	0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x44, 0x61,
+	0x74, 0x61, 0x42, 0x06, 0xca, 0xf3, 0x18, 0x02, 0x08, 0x01, 0x52, 0x07, 0x68, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x12, 0x5f, 0x0a, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x74, 0x65, 0x73,
+	0x74, 0x70, 0x62, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x53, 0x70, 0x61, 0x74, 0x69, 0x61, 0x6c, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74,
+	0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x10, 0xca, 0xf3, 0x18, 0x0c, 0x2a, 0x08,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x01, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x69, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x07, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x74, 0x65, 0x73, 0x74, 0x70,
+	0x62, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x53, 0x70, 0x61, 0x74, 0x69, 0x61, 0x6c, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0a, 0x63, 0x68,
+	0x6f, 0x69, 0x63, 0x65, 0x54, 0x65, 0x78, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x0a, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x54, 0x65, 0x78, 0x74, 0x12, 0x48, 0x0a, 0x0c,
+	0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62,
+	0x2e, 0x54, 0x65, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x0c, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65,
+	0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x75, 0x6d, 0x73, 0x18, 0x0a,
+	0x20, 0x03, 0x28, 0x0d, 0x52, 0x04, 0x6e, 0x75, 0x6d, 0x73, 0x12, 0x53, 0x0a, 0x0a, 0x72, 0x65,
+	0x6d, 0x6f, 0x76, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33,
+	0x2e, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x54, 0x65, 0x73,
+	0x74, 0x53, 0x70, 0x61, 0x74, 0x69, 0x61, 0x6c, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x44,
+	0x61, 0x74, 0x61, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x0a, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x1a,
+	0x5f, 0x0a, 0x0d, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x38, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x22, 0x2e, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62, 0x2e,
+	0x54, 0x65, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x44, 0x61, 0x74, 0x61, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x1a, 0x38, 0x0a, 0x0a, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x61, 0x0a, 0x0f, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x38, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22,
+	0x2e, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x54, 0x65, 0x73,
+	0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x44, 0x61,
+	0x74, 0x61, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x3a, 0x10, 0xca,
+	0xf3, 0x18, 0x0c, 0x08, 0x01, 0x12, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42,
+	0x08, 0x0a, 0x06, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x22, 0xb3, 0x01, 0x0a, 0x15, 0x54, 0x65, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x12, 0x18, 0x0a,
+	0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x35, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x65, 0x72, 0x67,
+	0x65, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x50, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e,
+	0x0a, 0x02, 0x68, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x68, 0x70, 0x3a, 0x29,
+	0xca, 0xf3, 0x18, 0x25, 0x22, 0x02, 0x69, 0x64, 0x1a, 0x1f, 0x54, 0x65, 0x73, 0x74, 0x53, 0x70,
+	0x61, 0x74, 0x69, 0x61, 0x6c, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x44, 0x61, 0x74, 0x61,
+	0x2e, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x22, 0x38, 0x0a, 0x0c, 0x54, 0x65, 0x73,
+	0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x02, 0x52, 0x01, 0x79, 0x12, 0x0c, 0x0a, 0x01, 0x7a, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x01, 0x7a, 0x42, 0x46, 0x5a, 0x44, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x6d, 0x65, 0x74, 0x61, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2f, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x64, 0x2f, 0x63, 0x6d, 0x64, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x64, 0x2d, 0x6d,
+	0x65, 0x72, 0x67, 0x65, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_test_proto_rawDescOnce sync.Once
+	file_test_proto_rawDescData = file_test_proto_rawDesc
+)
+
+func file_test_proto_rawDescGZIP() []byte {
+	file_test_proto_rawDescOnce.Do(func() {
+		file_test_proto_rawDescData = protoimpl.X.CompressGZIP(file_test_proto_rawDescData)
+	})
+	return file_test_proto_rawDescData
+}
+
+var file_test_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_test_proto_goTypes = []interface{}{
+	(*TestSpatialChannelData)(nil), // 0: mergetestpb.TestSpatialChannelData
+	(*TestEntityChannelData)(nil),  // 1: mergetestpb.TestEntityChannelData
+	(*TestPosition)(nil),           // 2: mergetestpb.TestPosition
+	nil,                            // 3: mergetestpb.TestSpatialChannelData.EntitiesEntry
+	nil,                            // 4: mergetestpb.TestSpatialChannelData.NamesEntry
+	nil,                            // 5: mergetestpb.TestSpatialChannelData.RemovablesEntry
+}
+var file_test_proto_depIdxs = []int32{
+	1, // 0: mergetestpb.TestSpatialChannelData.singleEntity:type_name -> mergetestpb.TestEntityChannelData
+	1, // 1: mergetestpb.TestSpatialChannelData.history:type_name -> mergetestpb.TestEntityChannelData
+	3, // 2: mergetestpb.TestSpatialChannelData.entities:type_name -> mergetestpb.TestSpatialChannelData.EntitiesEntry
+	4, // 3: mergetestpb.TestSpatialChannelData.names:type_name -> mergetestpb.TestSpatialChannelData.NamesEntry
+	1, // 4: mergetestpb.TestSpatialChannelData.choiceEntity:type_name -> mergetestpb.TestEntityChannelData
+	5, // 5: mergetestpb.TestSpatialChannelData.removables:type_name -> mergetestpb.TestSpatialChannelData.RemovablesEntry
+	2, // 6: mergetestpb.TestEntityChannelData.position:type_name -> mergetestpb.TestPosition
+	1, // 7: mergetestpb.TestSpatialChannelData.EntitiesEntry.value:type_name -> mergetestpb.TestEntityChannelData
+	1, // 8: mergetestpb.TestSpatialChannelData.RemovablesEntry.value:type_name -> mergetestpb.TestEntityChannelData
+	9, // [9:9] is the sub-list for method output_type
+	9, // [9:9] is the sub-list for method input_type
+	9, // [9:9] is the sub-list for extension type_name
+	9, // [9:9] is the sub-list for extension extendee
+	0, // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_test_proto_init() }
+func file_test_proto_init() {
+	if File_test_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_test_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestSpatialChannelData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_test_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestEntityChannelData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_test_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestPosition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_test_proto_msgTypes[0].OneofWrappers = []interface{}{
+		(*TestSpatialChannelData_ChoiceText)(nil),
+		(*TestSpatialChannelData_ChoiceEntity)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_test_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_test_proto_goTypes,
+		DependencyIndexes: file_test_proto_depIdxs,
+		MessageInfos:      file_test_proto_msgTypes,
+	}.Build()
+	File_test_proto = out.File
+	file_test_proto_rawDesc = nil
+	file_test_proto_goTypes = nil
+	file_test_proto_depIdxs = nil
+}
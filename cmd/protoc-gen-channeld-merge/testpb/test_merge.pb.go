@@ -0,0 +1,175 @@
+// Code generated by protoc-gen-channeld-merge. DO NOT EDIT.
+// source: test.proto
+
+package testpb
+
+import (
+	errors "errors"
+	channeld "github.com/metaworking/channeld/pkg/channeld"
+	channeldpb "github.com/metaworking/channeld/pkg/channeldpb"
+	common "github.com/metaworking/channeld/pkg/common"
+	proto "google.golang.org/protobuf/proto"
+)
+
+// Implement [channeld.MergeableChannelData]
+func (dst *TestSpatialChannelData) Merge(src common.ChannelDataMessage, options *channeldpb.ChannelDataMergeOptions, spatialNotifier common.SpatialInfoChangedNotifier) error {
+	srcData, ok := src.(*TestSpatialChannelData)
+	if !ok {
+		return errors.New("src is not a TestSpatialChannelData")
+	}
+
+	if srcData.Name != "" {
+		dst.Name = srcData.Name
+	}
+
+	if srcData.Count != nil {
+		v := *srcData.Count
+		dst.Count = &v
+	}
+
+	if srcData.SingleEntity != nil {
+		if dst.SingleEntity == nil {
+			dst.SingleEntity = proto.Clone(srcData.SingleEntity).(*TestEntityChannelData)
+		} else {
+			proto.Merge(dst.SingleEntity, srcData.SingleEntity)
+		}
+	}
+
+	dst.Logs = append(dst.Logs, srcData.Logs...)
+	if limit := 3; len(dst.Logs) > limit {
+		dst.Logs = dst.Logs[len(dst.Logs)-limit:]
+	}
+
+	dst.History = dst.History[:0]
+	for _, v := range srcData.History {
+		dst.History = append(dst.History, proto.Clone(v).(*TestEntityChannelData))
+	}
+
+	if len(srcData.Entities) > 0 && dst.Entities == nil {
+		dst.Entities = make(map[uint32]*TestEntityChannelData)
+	}
+	for k, v := range srcData.Entities {
+		if v.GetRemoved() {
+			delete(dst.Entities, k)
+			continue
+		}
+		if old, exists := dst.Entities[k]; exists {
+			if spatialNotifier != nil && old.Position != nil && v.Position != nil &&
+				(old.Position.X != v.Position.X || old.Position.Z != v.Position.Z) {
+				spatialNotifier.Notify(
+					common.SpatialInfo{X: float64(old.Position.X), Y: float64(old.Position.Y), Z: float64(old.Position.Z)},
+					common.SpatialInfo{X: float64(v.Position.X), Y: float64(v.Position.Y), Z: float64(v.Position.Z)},
+					func(srcChannelId common.ChannelId, dstChannelId common.ChannelId, handoverData interface{}) {
+						handoverChan, ok := handoverData.(chan common.Message)
+						if !ok {
+							return
+						}
+						data := &TestSpatialChannelData{
+							Entities: map[uint32]*TestEntityChannelData{k: old},
+						}
+						if entry, exists := dst.Names[k]; exists {
+							data.Names = map[uint32]string{k: entry}
+						}
+						if entry, exists := dst.Removables[k]; exists {
+							data.Removables = map[uint32]*TestEntityChannelData{k: entry}
+						}
+						handoverChan <- data
+					},
+				)
+			}
+			proto.Merge(old, v)
+		} else {
+			dst.Entities[k] = proto.Clone(v).(*TestEntityChannelData)
+		}
+	}
+
+	if len(srcData.Names) > 0 && dst.Names == nil {
+		dst.Names = make(map[uint32]string)
+	}
+	for k, v := range srcData.Names {
+		dst.Names[k] = v
+	}
+
+	if srcData.Choice != nil {
+		proto.Merge(dst, &TestSpatialChannelData{Choice: srcData.Choice})
+	}
+
+	if options != nil && options.ShouldReplaceList {
+		dst.Nums = dst.Nums[:0]
+	}
+	dst.Nums = append(dst.Nums, srcData.Nums...)
+	if options != nil && options.ListSizeLimit > 0 && len(dst.Nums) > int(options.ListSizeLimit) {
+		limit := int(options.ListSizeLimit)
+		if options.TruncateTop {
+			dst.Nums = dst.Nums[len(dst.Nums)-limit:]
+		} else {
+			dst.Nums = dst.Nums[:limit]
+		}
+	}
+
+	if len(srcData.Removables) > 0 && dst.Removables == nil {
+		dst.Removables = make(map[uint32]*TestEntityChannelData)
+	}
+	for k, v := range srcData.Removables {
+		if options != nil && options.ShouldCheckRemovableMapField && v.GetRemoved() {
+			delete(dst.Removables, k)
+			continue
+		}
+		if old, exists := dst.Removables[k]; exists {
+			proto.Merge(old, v)
+		} else {
+			dst.Removables[k] = proto.Clone(v).(*TestEntityChannelData)
+		}
+	}
+
+	return nil
+}
+
+// Implement [channeld.ChannelDataInitializer]
+func (data *TestSpatialChannelData) Init() error {
+	if data.Entities == nil {
+		data.Entities = make(map[uint32]*TestEntityChannelData)
+	}
+	if data.Names == nil {
+		data.Names = make(map[uint32]string)
+	}
+	if data.Removables == nil {
+		data.Removables = make(map[uint32]*TestEntityChannelData)
+	}
+	return nil
+}
+
+// Implement [channeld.SpatialChannelEntityUpdater]
+func (data *TestSpatialChannelData) AddEntity(entityId channeld.EntityId, msg common.Message) error {
+	entity, ok := msg.(*TestEntityChannelData)
+	if !ok {
+		return errors.New("msg is not a TestEntityChannelData")
+	}
+	if data.Entities == nil {
+		data.Entities = make(map[uint32]*TestEntityChannelData)
+	}
+	data.Entities[uint32(entityId)] = entity
+	return nil
+}
+
+func (data *TestSpatialChannelData) RemoveEntity(entityId channeld.EntityId) error {
+	delete(data.Entities, uint32(entityId))
+	return nil
+}
+
+// Implement [channeld.HandoverDataMerger]
+func (data *TestEntityChannelData) MergeTo(msg common.Message, fullData bool) error {
+	handoverData, ok := msg.(*TestSpatialChannelData)
+	if !ok {
+		return errors.New("msg is not a TestSpatialChannelData")
+	}
+	if handoverData.Entities == nil {
+		handoverData.Entities = make(map[uint32]*TestEntityChannelData)
+	}
+	if fullData {
+		handoverData.Entities[data.Id] = proto.Clone(data).(*TestEntityChannelData)
+	} else {
+		handoverData.Entities[data.Id] = &TestEntityChannelData{Id: data.Id}
+	}
+	return nil
+}
@@ -1,5 +1,5 @@
 cd "%CHANNELD_PATH%\internal\testpb"
-protoc --go_out=. --go_opt=paths=source_relative -I . *.proto
+protoc --go_out=. --go_opt=paths=source_relative -I . -I "%CHANNELD_PATH%" *.proto
 
 cd "%CHANNELD_PATH%\pkg\channeldpb"
 protoc --go_out=. --go_opt=paths=source_relative -I . *.proto
@@ -21,3 +21,6 @@ protoc --go_out=. --go_opt=paths=source_relative -I . -I "%CHANNELD_PATH%/pkg/un
 
 cd "%CHANNELD_PATH%\examples\unity-mirror-tanks\tankspb"
 protoc --go_out=. --go_opt=paths=source_relative -I . -I "%CHANNELD_PATH%" *.proto
+
+cd "%CHANNELD_PATH%\cmd\protoc-gen-channeld-merge\testpb"
+protoc --go_out=. --go_opt=paths=source_relative --channeld-merge_out=. --channeld-merge_opt=paths=source_relative -I . -I "%CHANNELD_PATH%" *.proto
@@ -34,6 +34,9 @@ func (dst *TestMergeMessage) Merge(src proto.Message, options *channeldpb.Channe
 		}
 	}
 
+	if dst.Kv == nil && len(srcMsg.Kv) > 0 {
+		dst.Kv = make(map[int64]*TestMergeMessage_StringWrapper, len(srcMsg.Kv))
+	}
 	for k, v := range srcMsg.Kv {
 		if v.Removed {
 			delete(dst.Kv, k)
@@ -0,0 +1,122 @@
+package channeld
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	// The time span to measure the fan-out bandwidth of a connection.
+	BandwidthBudgetWindow = time.Second
+	// The max factor to stretch the fan-out interval of a subscription.
+	MaxFanOutIntervalStretch = 16.0
+)
+
+// Keeps the channel data fan-outs to a connection within the bytes-per-second budget, by stretching the fan-out intervals.
+// Accessed in the goroutines of all the channels that the connection subscribes to.
+type bandwidthBudget struct {
+	lock sync.Mutex
+	// 0 = no limit.
+	bytesPerSec uint32
+	windowStart time.Time
+	windowBytes int
+	// The factor (>= 1) to stretch the fan-out intervals, adjusted at the end of each window.
+	stretch float64
+}
+
+func newBandwidthBudget(bytesPerSec uint32) *bandwidthBudget {
+	return &bandwidthBudget{
+		bytesPerSec: bytesPerSec,
+		windowStart: time.Now(),
+		stretch:     1,
+	}
+}
+
+func (b *bandwidthBudget) setLimit(bytesPerSec uint32) {
+	defer func() {
+		b.lock.Unlock()
+	}()
+	b.lock.Lock()
+
+	b.bytesPerSec = bytesPerSec
+	if bytesPerSec == 0 {
+		b.stretch = 1
+	}
+}
+
+// Records the bytes fanned-out to the connection.
+func (b *bandwidthBudget) onFanOut(bytes int, now time.Time) {
+	defer func() {
+		b.lock.Unlock()
+	}()
+	b.lock.Lock()
+
+	b.updateWindow(now)
+	b.windowBytes += bytes
+}
+
+// Adjusts the stretch factor if the window has passed. The caller should hold the lock.
+func (b *bandwidthBudget) updateWindow(now time.Time) {
+	elapsed := now.Sub(b.windowStart)
+	if elapsed < BandwidthBudgetWindow {
+		return
+	}
+
+	if b.bytesPerSec > 0 {
+		rate := float64(b.windowBytes) / elapsed.Seconds()
+		limit := float64(b.bytesPerSec)
+		if rate > limit {
+			// Over the budget - stretch in proportion.
+			b.stretch *= rate / limit
+		} else if rate < limit*0.8 {
+			// Well under the budget - recover gradually.
+			b.stretch *= 0.8
+		}
+		if b.stretch < 1 {
+			b.stretch = 1
+		} else if b.stretch > MaxFanOutIntervalStretch {
+			b.stretch = MaxFanOutIntervalStretch
+		}
+	}
+
+	b.windowStart = now
+	b.windowBytes = 0
+}
+
+// Returns the fan-out interval stretched by the budget. The lower priority (higher value) is stretched more.
+func (b *bandwidthBudget) stretchInterval(intervalMs uint32, priority uint32, now time.Time) uint32 {
+	defer func() {
+		b.lock.Unlock()
+	}()
+	b.lock.Lock()
+
+	b.updateWindow(now)
+	if b.bytesPerSec == 0 || b.stretch <= 1 {
+		return intervalMs
+	}
+
+	factor := 1 + (b.stretch-1)*float64(priority+1)
+	if factor > MaxFanOutIntervalStretch {
+		factor = MaxFanOutIntervalStretch
+	}
+	return uint32(float64(intervalMs) * factor)
+}
+
+// Returns the (shortest) fan-out interval of the subscription, with the bandwidth budget of the connection applied.
+func (ch *Channel) fanOutIntervalOf(conn ConnectionInChannel, cs *ChannelSubscription, t ChannelTime) uint32 {
+	return ch.stretchFanOutInterval(conn, cs.minFanOutIntervalMs(), cs.options.GetPriority(), t)
+}
+
+func (ch *Channel) stretchFanOutInterval(conn ConnectionInChannel, intervalMs uint32, priority uint32, t ChannelTime) uint32 {
+	c, ok := conn.(*Connection)
+	if !ok || c.fanOutBudget == nil {
+		return intervalMs
+	}
+	return c.fanOutBudget.stretchInterval(intervalMs, priority, ch.startTime.Add(time.Duration(t)))
+}
+
+func (ch *Channel) recordFanOut(conn ConnectionInChannel, bytes int, t ChannelTime) {
+	if c, ok := conn.(*Connection); ok && c.fanOutBudget != nil {
+		c.fanOutBudget.onFanOut(bytes, ch.startTime.Add(time.Duration(t)))
+	}
+}
@@ -0,0 +1,142 @@
+package channeld
+
+import (
+	"testing"
+	"time"
+
+	"github.com/metaworking/channeld/internal/testpb"
+	"github.com/metaworking/channeld/pkg/channeldpb"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestBandwidthBudget(t *testing.T) {
+	b := newBandwidthBudget(0)
+	start := b.windowStart
+
+	// No limit
+	b.onFanOut(1000, start)
+	assert.EqualValues(t, 50, b.stretchInterval(50, 0, start.Add(BandwidthBudgetWindow)))
+
+	b.setLimit(100)
+	// 3x over the budget
+	b.onFanOut(300, start.Add(BandwidthBudgetWindow))
+	assert.EqualValues(t, 150, b.stretchInterval(50, 0, start.Add(2*BandwidthBudgetWindow)))
+	// The lower priority is stretched more
+	assert.EqualValues(t, 250, b.stretchInterval(50, 1, start.Add(2*BandwidthBudgetWindow)))
+	// Capped by the max stretch
+	assert.EqualValues(t, 50*MaxFanOutIntervalStretch, b.stretchInterval(50, 10, start.Add(2*BandwidthBudgetWindow)))
+
+	// Nothing fanned-out in the window - recover gradually
+	assert.EqualValues(t, 120, b.stretchInterval(50, 0, start.Add(3*BandwidthBudgetWindow)))
+
+	// Removing the limit resets the stretch
+	b.setLimit(0)
+	assert.EqualValues(t, 50, b.stretchInterval(50, 0, start.Add(3*BandwidthBudgetWindow)))
+}
+
+func TestBandwidthBudgetFanOut(t *testing.T) {
+	InitLogs()
+	InitChannels()
+	InitConnections("../../config/server_conn_fsm_test.json", "../../config/client_non_authoratative_fsm.json")
+
+	c0 := addTestConnection(channeldpb.ConnectionType_SERVER)
+	c1 := addTestConnection(channeldpb.ConnectionType_CLIENT)
+
+	testChannel, _ := CreateChannel(channeldpb.ChannelType_TEST, c0)
+	// Stop the channel from being ticked by the scheduler
+	scheduler.unschedule(testChannel)
+	testChannel.InitData(&testpb.TestChannelDataMessage{Text: "a", Num: 1}, nil)
+
+	c0.SubscribeToChannel(testChannel, nil)
+	c1.SubscribeToChannel(testChannel, &channeldpb.ChannelSubscriptionOptions{
+		FanOutIntervalMs: proto.Uint32(50),
+		BandwidthBudget:  proto.Uint32(100),
+	})
+	assert.EqualValues(t, 100, c1.fanOutBudget.bytesPerSec)
+
+	channelStartTime := ChannelTime(100 * int64(time.Millisecond))
+	testChannel.tickData(channelStartTime)
+	assert.Equal(t, 1, len(c1.testQueue()))
+
+	// Over the budget in the last window
+	c1.fanOutBudget.lock.Lock()
+	c1.fanOutBudget.stretch = 2
+	c1.fanOutBudget.windowStart = testChannel.startTime.Add(time.Duration(channelStartTime))
+	c1.fanOutBudget.windowBytes = 0
+	c1.fanOutBudget.lock.Unlock()
+
+	testChannel.Data().OnUpdate(&testpb.TestChannelDataMessage{Text: "b"}, channelStartTime.AddMs(10), c0.Id(), nil)
+	// Not fanned-out at the base interval
+	testChannel.tickData(channelStartTime.AddMs(50))
+	assert.Equal(t, 1, len(c1.testQueue()))
+	// Fanned-out at the stretched interval
+	testChannel.tickData(channelStartTime.AddMs(100))
+	assert.Equal(t, 2, len(c1.testQueue()))
+
+	// The bytes are recorded
+	assert.Greater(t, c1.fanOutBudget.windowBytes, 0)
+}
+
+func TestBandwidthBudgetFanOutBufferOverflow(t *testing.T) {
+	InitLogs()
+	InitChannels()
+	InitConnections("../../config/server_conn_fsm_test.json", "../../config/client_non_authoratative_fsm.json")
+
+	c0 := addTestConnection(channeldpb.ConnectionType_SERVER)
+	c1 := addTestConnection(channeldpb.ConnectionType_CLIENT)
+
+	testChannel, _ := CreateChannel(channeldpb.ChannelType_TEST, c0)
+	// Stop the channel from being ticked by the scheduler
+	scheduler.unschedule(testChannel)
+	testChannel.InitData(&testpb.TestChannelDataMessage{Text: "a", Num: 1}, nil)
+
+	c0.SubscribeToChannel(testChannel, nil)
+	c1.SubscribeToChannel(testChannel, &channeldpb.ChannelSubscriptionOptions{
+		FanOutIntervalMs: proto.Uint32(50),
+		BandwidthBudget:  proto.Uint32(100),
+	})
+
+	channelStartTime := ChannelTime(100 * int64(time.Millisecond))
+	testChannel.tickData(channelStartTime)
+	assert.Equal(t, 1, len(c1.testQueue()))
+
+	c1.fanOutBudget.lock.Lock()
+	c1.fanOutBudget.stretch = 2
+	c1.fanOutBudget.windowStart = testChannel.startTime.Add(time.Duration(channelStartTime))
+	c1.fanOutBudget.windowBytes = 0
+	c1.fanOutBudget.lock.Unlock()
+
+	// The buffer removes the updates older than the unstretched interval when it's full.
+	const updateCount = MaxUpdateMsgBufferSize + 100
+	for i := 0; i < updateCount; i++ {
+		arrivalTime := channelStartTime.AddMs(10)
+		if i >= updateCount/2 {
+			arrivalTime = channelStartTime.AddMs(90)
+		}
+		testChannel.Data().OnUpdate(&testpb.TestChannelDataMessage{Num: uint32(i + 2)}, arrivalTime, c0.Id(), nil)
+	}
+	assert.EqualValues(t, MaxUpdateMsgBufferSize, testChannel.data.updateMsgBuffer.Len())
+
+	testChannel.tickData(channelStartTime.AddMs(50))
+	assert.Equal(t, 1, len(c1.testQueue()))
+
+	// The removed updates are not lost - the full states are fanned-out instead.
+	testChannel.tickData(channelStartTime.AddMs(100))
+	assert.Equal(t, 2, len(c1.testQueue()))
+	updateMsg := c1.latestMsg().(*channeldpb.ChannelDataUpdateMessage)
+	dataMsg, err := updateMsg.Data.UnmarshalNew()
+	assert.NoError(t, err)
+	assert.Equal(t, "a", dataMsg.(*testpb.TestChannelDataMessage).Text)
+	assert.EqualValues(t, updateCount+1, dataMsg.(*testpb.TestChannelDataMessage).Num)
+
+	// Back to the merged updates
+	testChannel.Data().OnUpdate(&testpb.TestChannelDataMessage{Num: 1000}, channelStartTime.AddMs(150), c0.Id(), nil)
+	testChannel.tickData(channelStartTime.AddMs(200))
+	assert.Equal(t, 3, len(c1.testQueue()))
+	updateMsg = c1.latestMsg().(*channeldpb.ChannelDataUpdateMessage)
+	dataMsg, err = updateMsg.Data.UnmarshalNew()
+	assert.NoError(t, err)
+	assert.Equal(t, "", dataMsg.(*testpb.TestChannelDataMessage).Text)
+	assert.EqualValues(t, 1000, dataMsg.(*testpb.TestChannelDataMessage).Num)
+}
@@ -4,6 +4,7 @@ import (
 	"container/list"
 	"errors"
 	"fmt"
+	"math"
 	"net"
 	"sync"
 	"sync/atomic"
@@ -70,26 +71,83 @@ type Channel struct {
 	connectionsLock sync.RWMutex
 	// Read-only property, e.g. name
 	metadata string
-	data     *ChannelData
+	// Typed key/value properties that can be updated by the owner and queried by ListChannelMessage.
+	attributes     map[string]*channeldpb.ChannelAttributeValue
+	attributesLock sync.RWMutex
+	data           *ChannelData
 	// The ID of the client connection that causes the latest ChannelDataUpdate
 	latestDataUpdateConnId ConnectionId
 	spatialNotifier        common.SpatialInfoChangedNotifier
 	entityController       EntityGroupController
 	inMsgQueue             chan channelMessage
-	fanOutQueue            *list.List
+	// Held by RemoveChannel while closing the inbox, so tryExecute never sends to a closed inbox.
+	inMsgQueueLock sync.RWMutex
+	fanOutQueue    *list.List
 	// Time since channel created
 	startTime             time.Time
 	tickInterval          time.Duration
 	tickFrames            int
 	enableClientBroadcast bool
-	logger                *Logger
-	removing              int32
+	// Set to 1 if any subscriber hasn't received the latest channel data. Accessed atomically.
+	fanOutPending int32
+	// The entry of the channel in the scheduler.
+	schedulerEntry *schedulerEntry
+	// The messages scheduled by ScheduleMessageMessage, by the schedule ID.
+	scheduledMessages     map[uint32]*scheduledMessage
+	scheduledMessagesLock sync.Mutex
+	nextScheduleId        uint32
+	// The earliest time of the scheduled messages. Accessed atomically.
+	nextScheduledTime ChannelTime
+	// Initialized from the channel settings. Can be overridden by CreateChannelMessage.
+	maxSubscribers MaxSubscribersType
+	// The connections that the subscriber slots are reserved for, and their connection types.
+	reservedSubSlots map[ConnectionId]channeldpb.ConnectionType
+	// Initialized from the channel settings. Can be overridden by CreateChannelMessage.
+	enablePresence bool
+	// 0 means the channel has no parent.
+	parentId common.ChannelId
+	// The child channels are added/removed in the GLOBAL channel's goroutine, but can be read in any goroutine.
+	children *xsync.MapOf[common.ChannelId, *Channel]
+	logger   *Logger
+	removing int32
+	// The custom logic of the channel type. See RegisterChannelBehavior.
+	behavior ChannelBehavior
+	// The transaction that locks the channel. Only accessed in the channel's goroutine.
+	lockedBy *channelTransaction
 }
 
 const (
 	GlobalChannelId common.ChannelId = 0
+
+	DefaultInMsgQueueCapacity = 1024
+)
+
+type InMsgQueueOverflowPolicy uint8
+
+const (
+	// Drop the message and send an ErrorResultMessage (CHANNEL_BUSY) to the sender.
+	InMsgQueueOverflowPolicy_Reject InMsgQueueOverflowPolicy = 0
+	// Silently drop the user-space message. The other messages are rejected as InMsgQueueOverflowPolicy_Reject.
+	InMsgQueueOverflowPolicy_DropUserSpace InMsgQueueOverflowPolicy = 1
+	// Drop the message and close the sender connection.
+	InMsgQueueOverflowPolicy_Disconnect InMsgQueueOverflowPolicy = 2
 )
 
+func (p InMsgQueueOverflowPolicy) String() string {
+	switch p {
+	case InMsgQueueOverflowPolicy_Reject:
+		return "reject"
+	case InMsgQueueOverflowPolicy_DropUserSpace:
+		return "drop_user_space"
+	case InMsgQueueOverflowPolicy_Disconnect:
+		return "disconnect"
+	}
+	return "unknown"
+}
+
+var ErrChannelBusy = errors.New("channel's inbox is full")
+var ErrChannelUnavailable = errors.New("channel is being removed or its inbox is full")
+
 var nextChannelId common.ChannelId
 var nextSpatialChannelId common.ChannelId
 
@@ -106,6 +164,7 @@ func InitChannels() {
 	}
 
 	allChannels = xsync.NewTypedMapOf[common.ChannelId, *Channel](UintIdHasher[common.ChannelId]())
+	scheduler = newChannelScheduler(GlobalSettings.TickWorkers)
 
 	nextChannelId = 0
 	nextSpatialChannelId = GlobalSettings.SpatialChannelIdStart
@@ -115,7 +174,9 @@ func InitChannels() {
 		rootLogger.Panic("failed to create global channel", zap.Error(err))
 	}
 
-	for chType, settings := range GlobalSettings.ChannelSettings {
+	Event_ChannelCreated.Listen(onChannelCreatedForAutoSub)
+
+	for chType, settings := range GlobalSettings.allChannelSettings() {
 		if settings.DataMsgFullName == "" {
 			continue
 		}
@@ -157,16 +218,24 @@ func createChannelWithId(channelId common.ChannelId, t channeldpb.ChannelType, o
 		/* Channel data is not created by default. See handleCreateChannel().
 		data:                  ReflectChannelData(t, nil),
 		*/
-		inMsgQueue:   make(chan channelMessage, 1024),
-		fanOutQueue:  list.New(),
-		startTime:    time.Now(),
-		tickInterval: time.Duration(GlobalSettings.GetChannelSettings(t).TickIntervalMs) * time.Millisecond,
-		tickFrames:   0,
+		inMsgQueue:        make(chan channelMessage, inMsgQueueCapacity(t)),
+		fanOutQueue:       list.New(),
+		startTime:         time.Now(),
+		tickInterval:      time.Duration(GlobalSettings.GetChannelSettings(t).TickIntervalMs) * time.Millisecond,
+		tickFrames:        0,
+		maxSubscribers:    GlobalSettings.GetChannelSettings(t).MaxSubscribers,
+		reservedSubSlots:  make(map[ConnectionId]channeldpb.ConnectionType),
+		attributes:        make(map[string]*channeldpb.ChannelAttributeValue),
+		scheduledMessages: make(map[uint32]*scheduledMessage),
+		nextScheduledTime: math.MaxInt64,
+		enablePresence:    GlobalSettings.GetChannelSettings(t).EnablePresence,
+		children:          xsync.NewTypedMapOf[common.ChannelId, *Channel](UintIdHasher[common.ChannelId]()),
 		logger: &Logger{rootLogger.With(
 			zap.String("channelType", t.String()),
 			zap.Uint32("channelId", uint32(channelId)),
 		)},
 		removing: 0,
+		behavior: channelBehaviorRegistry[t],
 	}
 
 	if ch.channelType == channeldpb.ChannelType_ENTITY {
@@ -182,7 +251,8 @@ func createChannelWithId(channelId common.ChannelId, t channeldpb.ChannelType, o
 	}
 
 	allChannels.Store(ch.id, ch)
-	go ch.Tick()
+	ch.schedulerEntry = &schedulerEntry{ch: ch}
+	scheduler.schedule(ch.schedulerEntry, 0)
 
 	channelNum.WithLabelValues(ch.channelType.String()).Inc()
 
@@ -239,15 +309,42 @@ func CreateChannel(t channeldpb.ChannelType, owner ConnectionInChannel) (*Channe
 }
 
 func RemoveChannel(ch *Channel) {
+	// Remove the descendant channels first
+	ch.children.Range(func(_ common.ChannelId, child *Channel) bool {
+		// Send RemoveChannelMessage to all subscribed connections of the child channel
+		for conn := range child.GetAllConnections() {
+			conn.Send(MessageContext{
+				MsgType:   channeldpb.MessageType_REMOVE_CHANNEL,
+				Msg:       &channeldpb.RemoveChannelMessage{ChannelId: uint32(child.id)},
+				Broadcast: 0,
+				StubId:    0,
+				ChannelId: uint32(GlobalChannelId),
+			})
+		}
+		RemoveChannel(child)
+		child.Logger().Info("removed child channel", zap.Uint32("parentChannelId", uint32(ch.id)))
+		return true
+	})
+
+	if parent := ch.Parent(); parent != nil {
+		parent.children.Delete(ch.id)
+	}
+
 	Event_ChannelRemoving.Broadcast(ch)
 
+	if ch.behavior != nil {
+		ch.behavior.OnRemoving(ch)
+	}
+
 	if ch.channelType == channeldpb.ChannelType_ENTITY {
 		ch.entityController.Uninitialize(ch)
 		Event_AuthComplete.UnlistenFor(ch)
 	}
 
+	ch.inMsgQueueLock.Lock()
 	atomic.AddInt32(&ch.removing, 1)
 	close(ch.inMsgQueue)
+	ch.inMsgQueueLock.Unlock()
 	allChannels.Delete(ch.id)
 	// Reset the channel full status cache
 	if ch.channelType == channeldpb.ChannelType_SPATIAL {
@@ -260,6 +357,9 @@ func RemoveChannel(ch *Channel) {
 	}
 
 	channelNum.WithLabelValues(ch.channelType.String()).Dec()
+	ch.clearScheduledMessages()
+	// The messages left in the inbox will never be handled.
+	channelInMsgQueueDepth.WithLabelValues(ch.channelType.String()).Sub(float64(len(ch.inMsgQueue)))
 
 	Event_ChannelRemoved.Broadcast(ch.id)
 }
@@ -272,15 +372,101 @@ func (ch *Channel) Type() channeldpb.ChannelType {
 	return ch.channelType
 }
 
+// Returns nil if the channel has no parent, or the parent channel has been removed.
+func (ch *Channel) Parent() *Channel {
+	if ch.parentId == 0 {
+		return nil
+	}
+	return GetChannel(ch.parentId)
+}
+
+// Sets the parent of the channel. Should be called in the GLOBAL channel's goroutine.
+func (ch *Channel) SetParent(parent *Channel) {
+	if oldParent := ch.Parent(); oldParent != nil {
+		oldParent.children.Delete(ch.id)
+	}
+	if parent == nil {
+		ch.parentId = 0
+		return
+	}
+	ch.parentId = parent.id
+	parent.children.Store(ch.id, ch)
+}
+
+// Goroutine-safe iteration of the descendant channels, in depth-first order.
+func (ch *Channel) RangeDescendants(f func(child *Channel) bool) bool {
+	shouldContinue := true
+	ch.children.Range(func(_ common.ChannelId, child *Channel) bool {
+		shouldContinue = f(child) && child.RangeDescendants(f)
+		return shouldContinue
+	})
+	return shouldContinue
+}
+
 func (ch *Channel) IsRemoving() bool {
-	return ch.removing > 0
+	return atomic.LoadInt32(&ch.removing) > 0
+}
+
+func inMsgQueueCapacity(t channeldpb.ChannelType) int {
+	capacity := GlobalSettings.GetChannelSettings(t).InMsgQueueCapacity
+	if capacity <= 0 {
+		capacity = DefaultInMsgQueueCapacity
+	}
+	return capacity
+}
+
+// Puts the message sent by channeld itself into the channel's inbox. The message is never dropped, so the call may block.
+func (ch *Channel) enqueueMessage(cm channelMessage) {
+	channelInMsgQueueDepth.WithLabelValues(ch.channelType.String()).Inc()
+	ch.inMsgQueue <- cm
+	ch.wake()
+}
+
+// Puts the message from a connection into the channel's inbox without blocking.
+// If the inbox is full, the message is dropped and the InMsgQueueOverflowPolicy of the channel type is applied.
+func (ch *Channel) tryEnqueueMessage(cm channelMessage) {
+	select {
+	case ch.inMsgQueue <- cm:
+		channelInMsgQueueDepth.WithLabelValues(ch.channelType.String()).Inc()
+		ch.wake()
+		return
+	default:
+	}
+
+	policy := GlobalSettings.GetChannelSettings(ch.channelType).InMsgQueueOverflowPolicy
+	if policy == InMsgQueueOverflowPolicy_DropUserSpace && cm.ctx.MsgType < channeldpb.MessageType_USER_SPACE_START {
+		policy = InMsgQueueOverflowPolicy_Reject
+	}
+	channelInMsgQueueOverflow.WithLabelValues(ch.channelType.String(), policy.String()).Inc()
+
+	switch policy {
+	case InMsgQueueOverflowPolicy_Reject:
+		ch.Logger().Warn("rejected message as the inbox is full",
+			zap.Uint32("msgType", uint32(cm.ctx.MsgType)),
+			zap.Uint32("connId", uint32(cm.ctx.Connection.Id())),
+		)
+		cm.ctx.SendErrorResult(channeldpb.ErrorResultMessage_CHANNEL_BUSY, ErrChannelBusy)
+	case InMsgQueueOverflowPolicy_DropUserSpace:
+		ch.Logger().Debug("dropped user-space message as the inbox is full",
+			zap.Uint32("msgType", uint32(cm.ctx.MsgType)),
+			zap.Uint32("connId", uint32(cm.ctx.Connection.Id())),
+		)
+	case InMsgQueueOverflowPolicy_Disconnect:
+		securityLogger.Info("closed connection as it floods the channel's inbox",
+			zap.String("channelType", ch.channelType.String()),
+			zap.Uint32("channelId", uint32(ch.id)),
+			zap.Uint32("connId", uint32(cm.ctx.Connection.Id())),
+			zap.Uint32("msgType", uint32(cm.ctx.MsgType)),
+		)
+		cm.ctx.Connection.Close()
+	}
 }
 
 func (ch *Channel) PutMessage(msg common.Message, handler MessageHandlerFunc, conn *Connection, pack *channeldpb.MessagePack) {
 	if ch.IsRemoving() {
 		return
 	}
-	ch.inMsgQueue <- channelMessage{ctx: MessageContext{
+	cm := channelMessage{ctx: MessageContext{
 		MsgType:     channeldpb.MessageType(pack.MsgType),
 		Msg:         msg,
 		Connection:  conn,
@@ -290,13 +476,23 @@ func (ch *Channel) PutMessage(msg common.Message, handler MessageHandlerFunc, co
 		ChannelId:   pack.ChannelId,
 		arrivalTime: ch.GetTime(),
 	}, handler: handler}
+
+	if conn == nil {
+		ch.enqueueMessage(cm)
+	} else {
+		ch.tryEnqueueMessage(cm)
+	}
 }
 
 func (ch *Channel) PutMessageContext(ctx MessageContext, handler MessageHandlerFunc) {
 	if ch.IsRemoving() {
 		return
 	}
-	ch.inMsgQueue <- channelMessage{ctx: ctx, handler: handler}
+	if ctx.Connection == nil {
+		ch.enqueueMessage(channelMessage{ctx: ctx, handler: handler})
+	} else {
+		ch.tryEnqueueMessage(channelMessage{ctx: ctx, handler: handler})
+	}
 }
 
 // Put the message into the channel's message queue. This method is used internally to make sure the message is handled in the channel's goroutine, to avoid race condition.
@@ -313,7 +509,7 @@ func (ch *Channel) PutMessageInternal(msgType channeldpb.MessageType, msg common
 		return
 	}
 
-	ch.inMsgQueue <- channelMessage{ctx: MessageContext{
+	ch.enqueueMessage(channelMessage{ctx: MessageContext{
 		MsgType:     msgType,
 		Msg:         msg,
 		Connection:  ch.ownerConnection,
@@ -322,52 +518,68 @@ func (ch *Channel) PutMessageInternal(msgType channeldpb.MessageType, msg common
 		StubId:      0,
 		ChannelId:   uint32(ch.id),
 		arrivalTime: ch.GetTime(),
-	}, handler: entry.handler}
+	}, handler: entry.handler})
 }
 
 // Runs a function in the channel's go-routine.
 // Any code that modifies the channel's data outside the the channel's go-routine should be run in this way.
 func (ch *Channel) Execute(callback func(ch *Channel)) {
-	ch.inMsgQueue <- channelMessage{handler: func(_ MessageContext) {
+	ch.enqueueMessage(channelMessage{handler: func(_ MessageContext) {
 		callback(ch)
-	}}
+	}})
+}
+
+// Same as Execute, but never blocks, and is safe to call while the channel is being removed.
+// Returns false if the channel is being removed or its inbox is full, in which case the callback is never run.
+func (ch *Channel) tryExecute(callback func(ch *Channel)) bool {
+	ch.inMsgQueueLock.RLock()
+	defer ch.inMsgQueueLock.RUnlock()
+
+	if ch.IsRemoving() {
+		return false
+	}
+	select {
+	case ch.inMsgQueue <- channelMessage{handler: func(_ MessageContext) {
+		callback(ch)
+	}}:
+		channelInMsgQueueDepth.WithLabelValues(ch.channelType.String()).Inc()
+		ch.wake()
+		return true
+	default:
+		return false
+	}
 }
 
 func (ch *Channel) GetTime() ChannelTime {
 	return ChannelTime(time.Since(ch.startTime))
 }
 
-func (ch *Channel) Tick() {
-	for {
-		if ch.IsRemoving() {
-			return
-		}
-
-		tickStart := time.Now()
-
-		// Run the code of SpatialController only in GLOBAL channel, to avoid any race condition.
-		if ch.channelType == channeldpb.ChannelType_GLOBAL && spatialController != nil {
-			spatialController.Tick()
-		}
+// Runs a single tick of the channel. Called by the scheduler in a worker goroutine.
+// The scheduler guarantees that the ticks of the same channel never run concurrently.
+func (ch *Channel) tick(tickStart time.Time) {
+	// Run the code of SpatialController only in GLOBAL channel, to avoid any race condition.
+	if ch.channelType == channeldpb.ChannelType_GLOBAL && spatialController != nil {
+		spatialController.Tick()
+	}
 
-		ch.tickFrames++
+	ch.tickFrames++
 
-		ch.tickMessages(tickStart)
+	ch.tickMessages(tickStart)
 
-		ch.tickData(ch.GetTime())
+	ch.tickScheduledMessages(ch.GetTime())
 
-		ch.tickConnections()
+	ch.tickData(ch.GetTime())
 
-		tickDuration := time.Since(tickStart)
-		channelTickDuration.WithLabelValues(ch.channelType.String()).Set(float64(tickDuration) / float64(time.Millisecond))
+	ch.tickConnections()
 
-		time.Sleep(ch.tickInterval - tickDuration)
-	}
+	tickDuration := time.Since(tickStart)
+	channelTickDuration.WithLabelValues(ch.channelType.String()).Set(float64(tickDuration) / float64(time.Millisecond))
 }
 
 func (ch *Channel) tickMessages(tickStart time.Time) {
 	for len(ch.inMsgQueue) > 0 {
 		cm := <-ch.inMsgQueue
+		channelInMsgQueueDepth.WithLabelValues(ch.channelType.String()).Dec()
 
 		// No message in the context, just execute the handler.
 		if cm.ctx.Msg == nil {
@@ -401,6 +613,10 @@ func (ch *Channel) tickConnections() {
 			// Unsub the connection from the channel
 			delete(ch.subscribedConnections, conn)
 			conn.Logger().Info("removed subscription of a disconnected endpoint", zap.Uint32("channelId", uint32(ch.id)))
+			ch.notifyPresence(channeldpb.ChannelPresenceMessage_LEAVE, conn)
+			if ch.behavior != nil {
+				ch.behavior.OnUnsubscribe(ch, conn)
+			}
 			if ownerConn, ok := ch.ownerConnection.(*Connection); ok && conn != nil {
 				if ownerConn == conn {
 					// Reset the owner if it's removed
@@ -509,6 +725,15 @@ func (ch *Channel) HasOwner() bool {
 	return ok && conn != nil && !conn.IsClosing()
 }
 
+// Only the channel owner or the subscriber with WRITE_ACCESS can update the channel data.
+func (ch *Channel) hasDataWriteAccess(conn ConnectionInChannel) bool {
+	if ch.ownerConnection == conn {
+		return true
+	}
+	cs := ch.subscribedConnections[conn]
+	return cs != nil && *cs.options.DataAccess == channeldpb.ChannelDataAccess_WRITE_ACCESS
+}
+
 func (chA *Channel) IsSameOwner(chB *Channel) bool {
 	return chA.HasOwner() && chB.HasOwner() && chA.ownerConnection == chB.ownerConnection
 }
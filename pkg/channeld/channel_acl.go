@@ -10,6 +10,10 @@ const (
 	ChannelAccessType_Sub    ChannelAccessType = 0
 	ChannelAccessType_Unsub  ChannelAccessType = 1
 	ChannelAccessType_Remove ChannelAccessType = 2
+	// Query the channel info via GetChannelInfoMessage
+	ChannelAccessType_GetInfo ChannelAccessType = 3
+	// Read the channel data via GetChannelDataMessage without subscribing to the channel
+	ChannelAccessType_GetData ChannelAccessType = 4
 )
 
 type ChannelAccessLevel uint8
@@ -33,7 +37,7 @@ func (ch *Channel) CheckACL(c ConnectionInChannel, accessType ChannelAccessType)
 	level := ChannelAccessLevel_None
 
 	// get acl from global setting
-	channelSettings, exists := GlobalSettings.ChannelSettings[ch.channelType]
+	channelSettings, exists := GlobalSettings.lookupChannelSettings(ch.channelType)
 	if exists {
 		aclSettings := channelSettings.ACLSettings
 		switch accessType {
@@ -43,6 +47,10 @@ func (ch *Channel) CheckACL(c ConnectionInChannel, accessType ChannelAccessType)
 			level = aclSettings.Unsub
 		case ChannelAccessType_Remove:
 			level = aclSettings.Remove
+		case ChannelAccessType_GetInfo:
+			level = aclSettings.GetInfo
+		case ChannelAccessType_GetData:
+			level = aclSettings.GetData
 		}
 	}
 
@@ -99,9 +99,11 @@ func setChannelACLSettings(chTypes []channeldpb.ChannelType, acl ChannelAccessLe
 	for _, t := range chTypes {
 		GlobalSettings.ChannelSettings[t] = ChannelSettingsType{
 			ACLSettings: ACLSettingsType{
-				Sub:    acl,
-				Unsub:  acl,
-				Remove: acl,
+				Sub:     acl,
+				Unsub:   acl,
+				Remove:  acl,
+				GetInfo: acl,
+				GetData: acl,
 			},
 		}
 	}
@@ -111,7 +113,7 @@ func TestCheckACL(t *testing.T) {
 	InitLogs()
 	InitChannels()
 
-	accessTypes := []ChannelAccessType{ChannelAccessType_Sub, ChannelAccessType_Unsub, ChannelAccessType_Remove}
+	accessTypes := []ChannelAccessType{ChannelAccessType_Sub, ChannelAccessType_Unsub, ChannelAccessType_Remove, ChannelAccessType_GetInfo, ChannelAccessType_GetData}
 
 	const ChannelType_Test1 channeldpb.ChannelType = 201
 	allChannelTypesForTest := []channeldpb.ChannelType{channeldpb.ChannelType_GLOBAL, channeldpb.ChannelType_SUBWORLD, channeldpb.ChannelType_PRIVATE, channeldpb.ChannelType_SPATIAL, ChannelType_Test1}
@@ -0,0 +1,221 @@
+package channeld
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/metaworking/channeld/pkg/channeldpb"
+	"google.golang.org/protobuf/proto"
+)
+
+var ErrInvalidAttributeValue = errors.New("channel attribute value is not set")
+
+func validateAttributes(attributes map[string]*channeldpb.ChannelAttributeValue) error {
+	for key, value := range attributes {
+		if key == "" {
+			return errors.New("channel attribute key is empty")
+		}
+		if value == nil || value.Value == nil {
+			return fmt.Errorf("invalid attribute '%s': %w", key, ErrInvalidAttributeValue)
+		}
+	}
+	return nil
+}
+
+// Adds, overwrites, or removes the attributes. The attributes should be validated beforehand.
+func (ch *Channel) UpdateAttributes(attributes map[string]*channeldpb.ChannelAttributeValue, keysToRemove []string) {
+	defer func() {
+		ch.attributesLock.Unlock()
+	}()
+	ch.attributesLock.Lock()
+
+	for _, key := range keysToRemove {
+		delete(ch.attributes, key)
+	}
+	for key, value := range attributes {
+		ch.attributes[key] = proto.Clone(value).(*channeldpb.ChannelAttributeValue)
+	}
+}
+
+// Returns a copy of all the attributes of the channel. Can be called in any goroutine.
+func (ch *Channel) GetAttributes() map[string]*channeldpb.ChannelAttributeValue {
+	defer func() {
+		ch.attributesLock.RUnlock()
+	}()
+	ch.attributesLock.RLock()
+
+	attributes := make(map[string]*channeldpb.ChannelAttributeValue, len(ch.attributes))
+	for key, value := range ch.attributes {
+		attributes[key] = value
+	}
+	return attributes
+}
+
+// The attributes of different types are ordered by the type: string < int < bool.
+func attributeTypeOrder(v *channeldpb.ChannelAttributeValue) int {
+	switch v.Value.(type) {
+	case *channeldpb.ChannelAttributeValue_StringValue:
+		return 1
+	case *channeldpb.ChannelAttributeValue_IntValue:
+		return 2
+	case *channeldpb.ChannelAttributeValue_BoolValue:
+		return 3
+	}
+	return 0
+}
+
+// Returns -1, 0, or 1 if a is less than, equal to, or greater than b.
+func compareAttributeValues(a, b *channeldpb.ChannelAttributeValue) int {
+	ta, tb := attributeTypeOrder(a), attributeTypeOrder(b)
+	if ta != tb {
+		if ta < tb {
+			return -1
+		}
+		return 1
+	}
+
+	switch va := a.Value.(type) {
+	case *channeldpb.ChannelAttributeValue_StringValue:
+		return strings.Compare(va.StringValue, b.GetStringValue())
+	case *channeldpb.ChannelAttributeValue_IntValue:
+		vb := b.GetIntValue()
+		if va.IntValue < vb {
+			return -1
+		} else if va.IntValue > vb {
+			return 1
+		}
+	case *channeldpb.ChannelAttributeValue_BoolValue:
+		vb := b.GetBoolValue()
+		if !va.BoolValue && vb {
+			return -1
+		} else if va.BoolValue && !vb {
+			return 1
+		}
+	}
+	return 0
+}
+
+// Returns true if the attributes match the filter. A nil filter matches any attributes.
+func matchAttributeFilter(attributes map[string]*channeldpb.ChannelAttributeValue, filter *channeldpb.ChannelAttributeFilter) bool {
+	if filter == nil {
+		return true
+	}
+
+	switch filter.Op {
+	case channeldpb.ChannelAttributeFilter_AND:
+		for _, subFilter := range filter.SubFilters {
+			if !matchAttributeFilter(attributes, subFilter) {
+				return false
+			}
+		}
+		return true
+	case channeldpb.ChannelAttributeFilter_OR:
+		for _, subFilter := range filter.SubFilters {
+			if matchAttributeFilter(attributes, subFilter) {
+				return true
+			}
+		}
+		return false
+	}
+
+	value, exists := attributes[filter.Key]
+	if filter.Op == channeldpb.ChannelAttributeFilter_NOT_EXISTS {
+		return !exists
+	}
+	if !exists {
+		return false
+	}
+	if filter.Op == channeldpb.ChannelAttributeFilter_EXISTS {
+		return true
+	}
+	// Values of different types are not comparable.
+	if filter.Value == nil || attributeTypeOrder(value) != attributeTypeOrder(filter.Value) {
+		return false
+	}
+
+	result := compareAttributeValues(value, filter.Value)
+	switch filter.Op {
+	case channeldpb.ChannelAttributeFilter_EQ:
+		return result == 0
+	case channeldpb.ChannelAttributeFilter_NE:
+		return result != 0
+	case channeldpb.ChannelAttributeFilter_LT:
+		return result < 0
+	case channeldpb.ChannelAttributeFilter_LTE:
+		return result <= 0
+	case channeldpb.ChannelAttributeFilter_GT:
+		return result > 0
+	case channeldpb.ChannelAttributeFilter_GTE:
+		return result >= 0
+	}
+	return false
+}
+
+// Compares the positions of two channels in the sorted list. The channels without the sort value are always put at the end.
+// The channels with the same sort value are ordered by the channel ID.
+func compareListPosition(aId uint32, aValue *channeldpb.ChannelAttributeValue, bId uint32, bValue *channeldpb.ChannelAttributeValue, descending bool) int {
+	if aValue != nil && bValue == nil {
+		return -1
+	} else if aValue == nil && bValue != nil {
+		return 1
+	} else if aValue != nil && bValue != nil {
+		result := compareAttributeValues(aValue, bValue)
+		if descending {
+			result = -result
+		}
+		if result != 0 {
+			return result
+		}
+	}
+
+	if aId < bId {
+		return -1
+	} else if aId > bId {
+		return 1
+	}
+	return 0
+}
+
+// Sorts the channels and returns the page after the cursor, and the cursor of the next page (nil if there's no more page).
+func paginateChannelList(infos []*channeldpb.ListChannelResultMessage_ChannelInfo, msg *channeldpb.ListChannelMessage) (
+	[]*channeldpb.ListChannelResultMessage_ChannelInfo, []byte, error) {
+
+	sortValue := func(info *channeldpb.ListChannelResultMessage_ChannelInfo) *channeldpb.ChannelAttributeValue {
+		if msg.SortByAttribute == "" {
+			return nil
+		}
+		return info.Attributes[msg.SortByAttribute]
+	}
+
+	sort.Slice(infos, func(i, j int) bool {
+		return compareListPosition(infos[i].ChannelId, sortValue(infos[i]), infos[j].ChannelId, sortValue(infos[j]), msg.SortDescending) < 0
+	})
+
+	if len(msg.Cursor) > 0 {
+		cursor := &channeldpb.ListChannelCursor{}
+		if err := proto.Unmarshal(msg.Cursor, cursor); err != nil {
+			return nil, nil, fmt.Errorf("invalid cursor: %w", err)
+		}
+		start := sort.Search(len(infos), func(i int) bool {
+			return compareListPosition(infos[i].ChannelId, sortValue(infos[i]), cursor.LastChannelId, cursor.LastSortValue, msg.SortDescending) > 0
+		})
+		infos = infos[start:]
+	}
+
+	if msg.PageSize == 0 || len(infos) <= int(msg.PageSize) {
+		return infos, nil, nil
+	}
+
+	infos = infos[:msg.PageSize]
+	last := infos[len(infos)-1]
+	nextCursor, err := proto.Marshal(&channeldpb.ListChannelCursor{
+		LastChannelId: last.ChannelId,
+		LastSortValue: sortValue(last),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return infos, nextCursor, nil
+}
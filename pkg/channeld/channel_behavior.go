@@ -0,0 +1,91 @@
+package channeld
+
+import (
+	"github.com/metaworking/channeld/pkg/channeldpb"
+	"github.com/metaworking/channeld/pkg/common"
+	"go.uber.org/zap"
+)
+
+// The custom logic of a channel type, e.g. validation, derived fields, and game rules.
+// Unlike replacing the built-in message handlers via RegisterMessageHandler, a behavior only applies to the channels of its type.
+//
+// The hooks are called in the channel's goroutine, except OnRemoving, which is called in the goroutine that removes the channel.
+// Embed NoopChannelBehavior to only implement the hooks needed.
+type ChannelBehavior interface {
+	// Called after the channel is created and its data is initialized.
+	OnCreated(ch *Channel, owner ConnectionInChannel)
+	// Called before a connection subscribes to the channel, or updates its subscription options, via SubscribedToChannelMessage or UpdateSubOptionsMessage.
+	// Returns an error to veto the subscription. The owner's subscription upon the channel creation can't be vetoed.
+	OnSubscribe(ch *Channel, conn ConnectionInChannel, options *channeldpb.ChannelSubscriptionOptions) error
+	// Called after a connection unsubscribed from the channel, or its subscription is removed as it's disconnected.
+	OnUnsubscribe(ch *Channel, conn ConnectionInChannel)
+	// Called before the update is merged into the channel data. The update message can be mutated.
+	// Returns an error to reject the update.
+	OnDataUpdate(ch *Channel, updateMsg common.ChannelDataMessage, sender ConnectionInChannel) error
+	// Called before the user-space message is forwarded. Returns an error to drop the message.
+	OnUserMessage(ctx MessageContext) error
+	// Called before the channel is removed.
+	OnRemoving(ch *Channel)
+}
+
+type NoopChannelBehavior struct{}
+
+func (NoopChannelBehavior) OnCreated(ch *Channel, owner ConnectionInChannel) {}
+
+func (NoopChannelBehavior) OnSubscribe(ch *Channel, conn ConnectionInChannel, options *channeldpb.ChannelSubscriptionOptions) error {
+	return nil
+}
+
+func (NoopChannelBehavior) OnUnsubscribe(ch *Channel, conn ConnectionInChannel) {}
+
+func (NoopChannelBehavior) OnDataUpdate(ch *Channel, updateMsg common.ChannelDataMessage, sender ConnectionInChannel) error {
+	return nil
+}
+
+func (NoopChannelBehavior) OnUserMessage(ctx MessageContext) error {
+	return nil
+}
+
+func (NoopChannelBehavior) OnRemoving(ch *Channel) {}
+
+var channelBehaviorRegistry = make(map[channeldpb.ChannelType]ChannelBehavior)
+
+// Register the behavior of a specific channel type. Only the channels created after the registration have the behavior.
+// Registering nil removes the behavior of the channel type.
+func RegisterChannelBehavior(channelType channeldpb.ChannelType, behavior ChannelBehavior) {
+	if behavior == nil {
+		delete(channelBehaviorRegistry, channelType)
+		return
+	}
+
+	if _, exists := channelBehaviorRegistry[channelType]; exists && rootLogger != nil {
+		rootLogger.Warn("channel behavior already exists, will be replaced", zap.String("channelType", channelType.String()))
+	}
+	channelBehaviorRegistry[channelType] = behavior
+}
+
+// Calls OnCreated in the channel's goroutine.
+func (ch *Channel) onCreated(owner ConnectionInChannel) {
+	if ch.behavior == nil {
+		return
+	}
+	ch.Execute(func(ch *Channel) {
+		ch.behavior.OnCreated(ch, owner)
+	})
+}
+
+// Returns false if the user-space message is dropped by the channel behavior.
+func (ch *Channel) allowUserMessage(ctx MessageContext) bool {
+	if ch.behavior == nil {
+		return true
+	}
+	if err := ch.behavior.OnUserMessage(ctx); err != nil {
+		ch.Logger().Debug("user-space message is dropped by the channel behavior",
+			zap.Uint32("msgType", uint32(ctx.MsgType)),
+			zap.Uint32("connId", uint32(ctx.Connection.Id())),
+			zap.Error(err),
+		)
+		return false
+	}
+	return true
+}
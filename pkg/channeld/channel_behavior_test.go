@@ -0,0 +1,138 @@
+package channeld
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/metaworking/channeld/internal/testpb"
+	"github.com/metaworking/channeld/pkg/channeldpb"
+	"github.com/metaworking/channeld/pkg/common"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+type testChannelBehavior struct {
+	NoopChannelBehavior
+	calls        []string
+	vetoedConnId ConnectionId
+}
+
+func (b *testChannelBehavior) OnCreated(ch *Channel, owner ConnectionInChannel) {
+	b.calls = append(b.calls, "OnCreated")
+}
+
+func (b *testChannelBehavior) OnSubscribe(ch *Channel, conn ConnectionInChannel, options *channeldpb.ChannelSubscriptionOptions) error {
+	b.calls = append(b.calls, "OnSubscribe")
+	if conn.Id() == b.vetoedConnId {
+		return errors.New("vetoed")
+	}
+	return nil
+}
+
+func (b *testChannelBehavior) OnUnsubscribe(ch *Channel, conn ConnectionInChannel) {
+	b.calls = append(b.calls, "OnUnsubscribe")
+}
+
+func (b *testChannelBehavior) OnDataUpdate(ch *Channel, updateMsg common.ChannelDataMessage, sender ConnectionInChannel) error {
+	b.calls = append(b.calls, "OnDataUpdate")
+	msg := updateMsg.(*testpb.TestChannelDataMessage)
+	if msg.Text == "bad" {
+		return errors.New("bad text")
+	}
+	// Derived field
+	msg.Num = uint32(len(msg.Text))
+	return nil
+}
+
+func (b *testChannelBehavior) OnUserMessage(ctx MessageContext) error {
+	b.calls = append(b.calls, "OnUserMessage")
+	return errors.New("dropped")
+}
+
+func (b *testChannelBehavior) OnRemoving(ch *Channel) {
+	b.calls = append(b.calls, "OnRemoving")
+}
+
+func TestChannelBehavior(t *testing.T) {
+	InitLogs()
+	InitChannels()
+	InitConnections("../../config/server_conn_fsm_test.json", "../../config/client_non_authoratative_fsm.json")
+
+	behavior := &testChannelBehavior{}
+	RegisterChannelBehavior(channeldpb.ChannelType_TEST, behavior)
+	defer RegisterChannelBehavior(channeldpb.ChannelType_TEST, nil)
+
+	server := addTestConnection(channeldpb.ConnectionType_SERVER)
+	ch, _ := CreateChannel(channeldpb.ChannelType_TEST, server)
+	// Stop the channel from being ticked by the scheduler
+	scheduler.unschedule(ch)
+	ch.InitData(&testpb.TestChannelDataMessage{}, nil)
+
+	// OnCreated is called in the channel's goroutine
+	ch.onCreated(server)
+	assert.Empty(t, behavior.calls)
+	ch.tickMessages(time.Now())
+	assert.Equal(t, []string{"OnCreated"}, behavior.calls)
+
+	// The other channel types are not affected
+	otherCh, _ := CreateChannel(channeldpb.ChannelType_SUBWORLD, server)
+	assert.Nil(t, otherCh.behavior)
+	RemoveChannel(otherCh)
+
+	client1 := addTestConnection(channeldpb.ConnectionType_CLIENT)
+	client2 := addTestConnection(channeldpb.ConnectionType_CLIENT)
+	behavior.vetoedConnId = client2.Id()
+	subToChannel := func(conn *Connection) {
+		handleSubToChannel(MessageContext{
+			MsgType:    channeldpb.MessageType_SUB_TO_CHANNEL,
+			Msg:        &channeldpb.SubscribedToChannelMessage{ConnId: uint32(conn.Id())},
+			Connection: conn,
+			Channel:    ch,
+		})
+	}
+	subToChannel(client1)
+	assert.Contains(t, ch.subscribedConnections, client1)
+	subToChannel(client2)
+	assert.NotContains(t, ch.subscribedConnections, client2)
+	assert.Equal(t, channeldpb.ErrorResultMessage_NO_ACCESS, client2.latestMsg().(*channeldpb.ErrorResultMessage).ErrorCode)
+
+	handleUnsubFromChannel(MessageContext{
+		MsgType:    channeldpb.MessageType_UNSUB_FROM_CHANNEL,
+		Msg:        &channeldpb.UnsubscribedFromChannelMessage{ConnId: uint32(client1.Id())},
+		Connection: client1,
+		Channel:    ch,
+	})
+	assert.Equal(t, []string{"OnCreated", "OnSubscribe", "OnSubscribe", "OnUnsubscribe"}, behavior.calls)
+
+	updateData := func(text string) {
+		any, _ := anypb.New(&testpb.TestChannelDataMessage{Text: text})
+		handleChannelDataUpdate(MessageContext{
+			MsgType:    channeldpb.MessageType_CHANNEL_DATA_UPDATE,
+			Msg:        &channeldpb.ChannelDataUpdateMessage{Data: any},
+			Connection: server,
+			Channel:    ch,
+		})
+	}
+	// The update is mutated before merged
+	updateData("hello")
+	assert.EqualValues(t, 5, ch.Data().msg.(*testpb.TestChannelDataMessage).Num)
+	// The update is rejected
+	updateData("bad")
+	assert.Equal(t, "hello", ch.Data().msg.(*testpb.TestChannelDataMessage).Text)
+	assert.Equal(t, channeldpb.ErrorResultMessage_INVALID_MESSAGE, server.latestMsg().(*channeldpb.ErrorResultMessage).ErrorCode)
+
+	// The user-space message is dropped
+	serverMsgCount := len(server.testQueue())
+	handleClientToServerUserMessage(MessageContext{
+		MsgType:    channeldpb.MessageType_USER_SPACE_START,
+		Msg:        &channeldpb.ServerForwardMessage{ClientConnId: uint32(client1.Id())},
+		Connection: client1,
+		Channel:    ch,
+	})
+	assert.Equal(t, serverMsgCount, len(server.testQueue()))
+
+	RemoveChannel(ch)
+	assert.Equal(t, []string{"OnCreated", "OnSubscribe", "OnSubscribe", "OnUnsubscribe",
+		"OnDataUpdate", "OnDataUpdate", "OnUserMessage", "OnRemoving"}, behavior.calls)
+}
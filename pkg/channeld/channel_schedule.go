@@ -0,0 +1,145 @@
+package channeld
+
+import (
+	"math"
+	"sort"
+	"sync/atomic"
+	"time"
+
+	"github.com/metaworking/channeld/pkg/channeldpb"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+)
+
+func init() {
+	// Registered here to avoid the initialization cycle: the handler unpacks the scheduled message via MessageMap.
+	RegisterMessageHandler(uint32(channeldpb.MessageType_SCHEDULE_MESSAGE), &channeldpb.ScheduleMessageMessage{}, handleScheduleMessage)
+	RegisterMessageHandler(uint32(channeldpb.MessageType_CANCEL_SCHEDULED), &channeldpb.CancelScheduledMessage{}, handleCancelScheduled)
+}
+
+// The message scheduled by ScheduleMessageMessage. See handleScheduleMessage.
+type scheduledMessage struct {
+	id uint32
+	// The template of the context to handle. The message is cloned every time it's handled.
+	ctx     MessageContext
+	handler MessageHandlerFunc
+	// The channel time to handle the message next time.
+	nextTime ChannelTime
+	// 0 means the message is handled only once.
+	interval ChannelTime
+}
+
+// Returns the ID of the scheduled message. Should be called in the channel's goroutine.
+func (ch *Channel) scheduleMessage(ctx MessageContext, handler MessageHandlerFunc, nextTime ChannelTime, interval ChannelTime) uint32 {
+	defer func() {
+		ch.scheduledMessagesLock.Unlock()
+	}()
+	ch.scheduledMessagesLock.Lock()
+
+	ch.nextScheduleId++
+	ch.scheduledMessages[ch.nextScheduleId] = &scheduledMessage{
+		id:       ch.nextScheduleId,
+		ctx:      ctx,
+		handler:  handler,
+		nextTime: nextTime,
+		interval: interval,
+	}
+	ch.updateNextScheduledTime()
+	return ch.nextScheduleId
+}
+
+// Returns false if the scheduled message doesn't exist, or it's not scheduled by the connection.
+func (ch *Channel) cancelScheduledMessage(id uint32, conn ConnectionInChannel) bool {
+	defer func() {
+		ch.scheduledMessagesLock.Unlock()
+	}()
+	ch.scheduledMessagesLock.Lock()
+
+	sm, exists := ch.scheduledMessages[id]
+	if !exists || sm.ctx.Connection != conn {
+		return false
+	}
+	delete(ch.scheduledMessages, id)
+	ch.updateNextScheduledTime()
+	return true
+}
+
+// Removes all the scheduled messages. Called when the channel is removed.
+func (ch *Channel) clearScheduledMessages() {
+	defer func() {
+		ch.scheduledMessagesLock.Unlock()
+	}()
+	ch.scheduledMessagesLock.Lock()
+
+	ch.scheduledMessages = make(map[uint32]*scheduledMessage)
+	ch.updateNextScheduledTime()
+}
+
+// The caller should hold the scheduledMessagesLock.
+func (ch *Channel) updateNextScheduledTime() {
+	var nextTime ChannelTime = math.MaxInt64
+	for _, sm := range ch.scheduledMessages {
+		if sm.nextTime < nextTime {
+			nextTime = sm.nextTime
+		}
+	}
+	atomic.StoreInt64((*int64)(&ch.nextScheduledTime), int64(nextTime))
+}
+
+// Returns true if any scheduled message is due at the channel time. Can be called in any goroutine.
+func (ch *Channel) hasDueScheduledMessage(t ChannelTime) bool {
+	return ChannelTime(atomic.LoadInt64((*int64)(&ch.nextScheduledTime))) <= t
+}
+
+// Handles the due scheduled messages in the channel's goroutine.
+func (ch *Channel) tickScheduledMessages(t ChannelTime) {
+	if !ch.hasDueScheduledMessage(t) {
+		return
+	}
+
+	ch.scheduledMessagesLock.Lock()
+	due := make([]*scheduledMessage, 0)
+	for id, sm := range ch.scheduledMessages {
+		if sm.nextTime > t {
+			continue
+		}
+		if sm.ctx.Connection == nil || sm.ctx.Connection.IsClosing() {
+			ch.Logger().Debug("removed scheduled message as the connection is closed", zap.Uint32("scheduleId", id))
+			delete(ch.scheduledMessages, id)
+			continue
+		}
+		due = append(due, sm)
+		if sm.interval > 0 {
+			sm.nextTime += sm.interval
+			// Skip the missed intervals
+			if sm.nextTime <= t {
+				sm.nextTime = t + sm.interval
+			}
+		} else {
+			delete(ch.scheduledMessages, id)
+		}
+	}
+	ch.updateNextScheduledTime()
+	// Release the lock before handling, as the handler may schedule or cancel messages.
+	ch.scheduledMessagesLock.Unlock()
+
+	// Handle in the order of scheduling
+	sort.Slice(due, func(i, j int) bool {
+		return due[i].id < due[j].id
+	})
+
+	for _, sm := range due {
+		ctx := sm.ctx
+		ctx.Msg = proto.Clone(sm.ctx.Msg)
+		ctx.arrivalTime = t
+		sm.handler(ctx)
+	}
+}
+
+func msToChannelTime(ms int64) ChannelTime {
+	return ChannelTime(time.Duration(ms) * time.Millisecond)
+}
+
+func channelTimeToMs(t ChannelTime) int64 {
+	return time.Duration(t).Milliseconds()
+}
@@ -2,12 +2,15 @@ package channeld
 
 import (
 	"log"
+	"math"
 	"sync"
 	"testing"
 	"time"
 
 	"github.com/metaworking/channeld/pkg/channeldpb"
 	"github.com/metaworking/channeld/pkg/common"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
 )
 
 func TestConcurrentAccessChannels(t *testing.T) {
@@ -53,3 +56,358 @@ func TestConcurrentAccessChannels(t *testing.T) {
 
 	wg.Wait()
 }
+
+func TestChannelHierarchy(t *testing.T) {
+	InitLogs()
+	InitChannels()
+	InitConnections("../../config/server_conn_fsm_test.json", "../../config/client_non_authoratative_fsm.json")
+
+	server := addTestConnection(channeldpb.ConnectionType_SERVER)
+	createChannel := func(conn *Connection, parentId common.ChannelId) *channeldpb.CreateChannelResultMessage {
+		handleCreateChannel(MessageContext{
+			MsgType: channeldpb.MessageType_CREATE_CHANNEL,
+			Msg: &channeldpb.CreateChannelMessage{
+				ChannelType:     channeldpb.ChannelType_SUBWORLD,
+				ParentChannelId: uint32(parentId),
+			},
+			Connection: conn,
+			Channel:    globalChannel,
+		})
+		for i := len(conn.testQueue()) - 1; i >= 0; i-- {
+			if result, ok := conn.testQueue()[i].(*channeldpb.CreateChannelResultMessage); ok {
+				return result
+			}
+		}
+		return nil
+	}
+
+	guild := GetChannel(common.ChannelId(createChannel(server, 0).ChannelId))
+	hall := GetChannel(common.ChannelId(createChannel(server, guild.id).ChannelId))
+	room := GetChannel(common.ChannelId(createChannel(server, hall.id).ChannelId))
+	assert.EqualValues(t, guild.id, hall.Parent().id)
+	assert.EqualValues(t, hall.id, room.Parent().id)
+	assert.Nil(t, guild.Parent())
+
+	// Only the owner of the parent channel can create the child channel
+	other := addTestConnection(channeldpb.ConnectionType_SERVER)
+	assert.Nil(t, createChannel(other, guild.id))
+	assert.Equal(t, channeldpb.ErrorResultMessage_NO_ACCESS, other.latestMsg().(*channeldpb.ErrorResultMessage).ErrorCode)
+
+	handleListChannel(MessageContext{
+		Msg:        &channeldpb.ListChannelMessage{SubtreeRootId: uint32(hall.id)},
+		Connection: server,
+		Channel:    globalChannel,
+	})
+	// The subtree includes the root
+	assert.Equal(t, 2, len(server.latestMsg().(*channeldpb.ListChannelResultMessage).Channels))
+
+	// Subscribing to the parent also subscribes to the descendants
+	client := addTestConnection(channeldpb.ConnectionType_CLIENT)
+	handleSubToChannel(MessageContext{
+		MsgType:    channeldpb.MessageType_SUB_TO_CHANNEL,
+		Msg:        &channeldpb.SubscribedToChannelMessage{ConnId: uint32(client.id), SubToChildren: true},
+		Connection: client,
+		Channel:    guild,
+		ChannelId:  uint32(guild.id),
+	})
+	assert.Contains(t, guild.GetAllConnections(), client)
+	assert.Eventually(t, func() bool {
+		_, exists := room.GetAllConnections()[client]
+		return exists
+	}, time.Second, 10*time.Millisecond)
+	assert.Contains(t, hall.GetAllConnections(), client)
+
+	// Removing the parent removes the descendants
+	RemoveChannel(guild)
+	assert.Nil(t, GetChannel(guild.id))
+	assert.Nil(t, GetChannel(hall.id))
+	assert.Nil(t, GetChannel(room.id))
+	assert.Equal(t, uint32(room.id), client.latestMsg().(*channeldpb.RemoveChannelMessage).ChannelId)
+}
+
+func TestChannelAttributes(t *testing.T) {
+	InitLogs()
+	InitChannels()
+	InitConnections("../../config/server_conn_fsm_test.json", "../../config/client_non_authoratative_fsm.json")
+
+	strVal := func(s string) *channeldpb.ChannelAttributeValue {
+		return &channeldpb.ChannelAttributeValue{Value: &channeldpb.ChannelAttributeValue_StringValue{StringValue: s}}
+	}
+	intVal := func(i int64) *channeldpb.ChannelAttributeValue {
+		return &channeldpb.ChannelAttributeValue{Value: &channeldpb.ChannelAttributeValue_IntValue{IntValue: i}}
+	}
+	boolVal := func(b bool) *channeldpb.ChannelAttributeValue {
+		return &channeldpb.ChannelAttributeValue{Value: &channeldpb.ChannelAttributeValue_BoolValue{BoolValue: b}}
+	}
+
+	server := addTestConnection(channeldpb.ConnectionType_SERVER)
+	rooms := make([]*Channel, 0)
+	for i, region := range []string{"EU", "EU", "US", "EU", "EU", "EU"} {
+		handleCreateChannel(MessageContext{
+			MsgType: channeldpb.MessageType_CREATE_CHANNEL,
+			Msg: &channeldpb.CreateChannelMessage{
+				ChannelType: channeldpb.ChannelType_SUBWORLD,
+				Attributes: map[string]*channeldpb.ChannelAttributeValue{
+					"region":  strVal(region),
+					"players": intVal(int64(10 - i)),
+					"ranked":  boolVal(i != 1),
+				},
+			},
+			Connection: server,
+			Channel:    globalChannel,
+		})
+		var ch *Channel
+		for j := len(server.testQueue()) - 1; j >= 0; j-- {
+			if result, ok := server.testQueue()[j].(*channeldpb.CreateChannelResultMessage); ok {
+				ch = GetChannel(common.ChannelId(result.ChannelId))
+				break
+			}
+		}
+		rooms = append(rooms, ch)
+	}
+
+	// Invalid attribute value
+	handleCreateChannel(MessageContext{
+		MsgType: channeldpb.MessageType_CREATE_CHANNEL,
+		Msg: &channeldpb.CreateChannelMessage{
+			ChannelType: channeldpb.ChannelType_SUBWORLD,
+			Attributes:  map[string]*channeldpb.ChannelAttributeValue{"region": {}},
+		},
+		Connection: server,
+		Channel:    globalChannel,
+	})
+	assert.Equal(t, channeldpb.ErrorResultMessage_INVALID_MESSAGE, server.latestMsg().(*channeldpb.ErrorResultMessage).ErrorCode)
+
+	// Only the owner can update the attributes
+	client := addTestConnection(channeldpb.ConnectionType_CLIENT)
+	handleUpdateChannelAttributes(MessageContext{
+		MsgType:    channeldpb.MessageType_UPDATE_CHANNEL_ATTRIBUTES,
+		Msg:        &channeldpb.UpdateChannelAttributesMessage{KeysToRemove: []string{"region"}},
+		Connection: client,
+		Channel:    rooms[4],
+	})
+	assert.Equal(t, channeldpb.ErrorResultMessage_NO_ACCESS, client.latestMsg().(*channeldpb.ErrorResultMessage).ErrorCode)
+
+	handleUpdateChannelAttributes(MessageContext{
+		MsgType:    channeldpb.MessageType_UPDATE_CHANNEL_ATTRIBUTES,
+		Msg:        &channeldpb.UpdateChannelAttributesMessage{KeysToRemove: []string{"players"}},
+		Connection: server,
+		Channel:    rooms[4],
+	})
+	_, exists := server.latestMsg().(*channeldpb.UpdateChannelAttributesResultMessage).Attributes["players"]
+	assert.False(t, exists)
+
+	listChannel := func(msg *channeldpb.ListChannelMessage) *channeldpb.ListChannelResultMessage {
+		msg.TypeFilter = channeldpb.ChannelType_SUBWORLD
+		handleListChannel(MessageContext{
+			Msg:        msg,
+			Connection: server,
+			Channel:    globalChannel,
+		})
+		return server.latestMsg().(*channeldpb.ListChannelResultMessage)
+	}
+	channelIds := func(result *channeldpb.ListChannelResultMessage) []uint32 {
+		ids := make([]uint32, len(result.Channels))
+		for i, info := range result.Channels {
+			ids[i] = info.ChannelId
+		}
+		return ids
+	}
+
+	// region == "EU" AND ranked == true AND (players >= 7 OR players doesn't exist)
+	filter := &channeldpb.ChannelAttributeFilter{
+		Op: channeldpb.ChannelAttributeFilter_AND,
+		SubFilters: []*channeldpb.ChannelAttributeFilter{
+			{Op: channeldpb.ChannelAttributeFilter_EQ, Key: "region", Value: strVal("EU")},
+			{Op: channeldpb.ChannelAttributeFilter_EQ, Key: "ranked", Value: boolVal(true)},
+			{
+				Op: channeldpb.ChannelAttributeFilter_OR,
+				SubFilters: []*channeldpb.ChannelAttributeFilter{
+					{Op: channeldpb.ChannelAttributeFilter_GTE, Key: "players", Value: intVal(7)},
+					{Op: channeldpb.ChannelAttributeFilter_NOT_EXISTS, Key: "players"},
+				},
+			},
+		},
+	}
+	result := listChannel(&channeldpb.ListChannelMessage{AttributeFilter: filter, SortByAttribute: "players"})
+	// rooms[5] has less than 7 players. rooms[4] doesn't have the "players" attribute, so it's at the end.
+	assert.Equal(t, []uint32{uint32(rooms[3].id), uint32(rooms[0].id), uint32(rooms[4].id)}, channelIds(result))
+	assert.Empty(t, result.NextCursor)
+
+	result = listChannel(&channeldpb.ListChannelMessage{AttributeFilter: filter, SortByAttribute: "players", SortDescending: true, PageSize: 2})
+	assert.Equal(t, []uint32{uint32(rooms[0].id), uint32(rooms[3].id)}, channelIds(result))
+	assert.NotEmpty(t, result.NextCursor)
+
+	result = listChannel(&channeldpb.ListChannelMessage{AttributeFilter: filter, SortByAttribute: "players", SortDescending: true, PageSize: 2, Cursor: result.NextCursor})
+	assert.Equal(t, []uint32{uint32(rooms[4].id)}, channelIds(result))
+	assert.Empty(t, result.NextCursor)
+
+	// Comparing with a value of different type never matches
+	result = listChannel(&channeldpb.ListChannelMessage{
+		AttributeFilter: &channeldpb.ChannelAttributeFilter{Op: channeldpb.ChannelAttributeFilter_LT, Key: "players", Value: strVal("100")},
+	})
+	assert.Empty(t, result.Channels)
+
+	for _, ch := range rooms {
+		RemoveChannel(ch)
+	}
+}
+
+func TestInMsgQueueOverflow(t *testing.T) {
+	InitLogs()
+	InitChannels()
+	InitConnections("../../config/server_conn_fsm_test.json", "../../config/client_non_authoratative_fsm.json")
+
+	settings := GlobalSettings.GetChannelSettings(channeldpb.ChannelType_TEST)
+	settings.InMsgQueueCapacity = 2
+	GlobalSettings.ChannelSettings[channeldpb.ChannelType_TEST] = settings
+	defer delete(GlobalSettings.ChannelSettings, channeldpb.ChannelType_TEST)
+
+	ch, _ := CreateChannel(channeldpb.ChannelType_TEST, nil)
+	// Drop the channel from the scheduler, so the messages stay in the inbox.
+	scheduler.unschedule(ch)
+
+	c := addTestConnection(channeldpb.ConnectionType_CLIENT)
+	putMessage := func(msgType channeldpb.MessageType) {
+		ch.PutMessage(&channeldpb.ServerForwardMessage{}, handleClientToServerUserMessage, c, &channeldpb.MessagePack{
+			ChannelId: uint32(ch.id),
+			MsgType:   uint32(msgType),
+		})
+	}
+
+	putMessage(channeldpb.MessageType_USER_SPACE_START)
+	putMessage(channeldpb.MessageType_USER_SPACE_START)
+	assert.Equal(t, 2, len(ch.inMsgQueue))
+	assert.Empty(t, c.testQueue())
+
+	// InMsgQueueOverflowPolicy_Reject
+	putMessage(channeldpb.MessageType_USER_SPACE_START)
+	assert.Equal(t, 2, len(ch.inMsgQueue))
+	assert.Equal(t, channeldpb.ErrorResultMessage_CHANNEL_BUSY, c.latestMsg().(*channeldpb.ErrorResultMessage).ErrorCode)
+
+	// InMsgQueueOverflowPolicy_DropUserSpace
+	settings.InMsgQueueOverflowPolicy = InMsgQueueOverflowPolicy_DropUserSpace
+	GlobalSettings.ChannelSettings[channeldpb.ChannelType_TEST] = settings
+	putMessage(channeldpb.MessageType_USER_SPACE_START)
+	assert.Equal(t, 1, len(c.testQueue()))
+	// Non-user-space message is rejected
+	putMessage(channeldpb.MessageType_CHANNEL_DATA_UPDATE)
+	assert.Equal(t, 2, len(c.testQueue()))
+	assert.EqualValues(t, channeldpb.MessageType_CHANNEL_DATA_UPDATE, c.latestMsg().(*channeldpb.ErrorResultMessage).MsgType)
+
+	// InMsgQueueOverflowPolicy_Disconnect
+	settings.InMsgQueueOverflowPolicy = InMsgQueueOverflowPolicy_Disconnect
+	GlobalSettings.ChannelSettings[channeldpb.ChannelType_TEST] = settings
+	putMessage(channeldpb.MessageType_USER_SPACE_START)
+	assert.True(t, c.IsClosing())
+
+	RemoveChannel(ch)
+}
+
+func TestScheduleMessage(t *testing.T) {
+	InitLogs()
+	InitChannels()
+	InitConnections("../../config/server_conn_fsm_test.json", "../../config/client_non_authoratative_fsm.json")
+
+	server := addTestConnection(channeldpb.ConnectionType_SERVER)
+	// Use the client FSM which allows the user-space messages
+	fsm := *clientFsm
+	server.fsm = &fsm
+	server.fsm.ChangeState("OPEN")
+	ch, _ := CreateChannel(channeldpb.ChannelType_TEST, server)
+	// Stop the channel from being ticked by the scheduler
+	scheduler.unschedule(ch)
+
+	forwardMsg, _ := proto.Marshal(&channeldpb.ServerForwardMessage{Payload: []byte("hello")})
+	schedule := func(conn *Connection, channelTimeMs int64, intervalMs uint32) {
+		handleScheduleMessage(MessageContext{
+			MsgType: channeldpb.MessageType_SCHEDULE_MESSAGE,
+			Msg: &channeldpb.ScheduleMessageMessage{
+				Message: &channeldpb.MessagePack{
+					ChannelId: uint32(ch.id),
+					MsgType:   uint32(channeldpb.MessageType_USER_SPACE_START),
+					MsgBody:   forwardMsg,
+				},
+				ChannelTimeMs: channelTimeMs,
+				IntervalMs:    intervalMs,
+			},
+			Connection: conn,
+			Channel:    ch,
+			ChannelId:  uint32(ch.id),
+		})
+	}
+	countForwarded := func() int {
+		n := 0
+		for _, msg := range server.testQueue() {
+			if _, ok := msg.(*channeldpb.ServerForwardMessage); ok {
+				n++
+			}
+		}
+		return n
+	}
+
+	// Schedule a message to be handled once at 1s
+	schedule(server, 1000, 0)
+	result, ok := server.latestMsg().(*channeldpb.ScheduleMessageResultMessage)
+	assert.True(t, ok)
+	assert.EqualValues(t, 1, result.ScheduleId)
+	assert.EqualValues(t, 1000, result.ChannelTimeMs)
+
+	ch.tickScheduledMessages(msToChannelTime(999))
+	assert.Equal(t, 0, countForwarded())
+	ch.tickScheduledMessages(msToChannelTime(1000))
+	assert.Equal(t, 1, countForwarded())
+	ch.tickScheduledMessages(msToChannelTime(2000))
+	assert.Equal(t, 1, countForwarded())
+	assert.Empty(t, ch.scheduledMessages)
+
+	// Schedule a message to be handled every 100ms, starting at 1s
+	schedule(server, 1000, 100)
+	id := server.latestMsg().(*channeldpb.ScheduleMessageResultMessage).ScheduleId
+	ch.tickScheduledMessages(msToChannelTime(1000))
+	assert.Equal(t, 2, countForwarded())
+	ch.tickScheduledMessages(msToChannelTime(1050))
+	assert.Equal(t, 2, countForwarded())
+	ch.tickScheduledMessages(msToChannelTime(1100))
+	assert.Equal(t, 3, countForwarded())
+	// The missed intervals are skipped
+	ch.tickScheduledMessages(msToChannelTime(1550))
+	assert.Equal(t, 4, countForwarded())
+	assert.EqualValues(t, msToChannelTime(1650), ch.scheduledMessages[id].nextTime)
+
+	// Cancel the repeating message
+	handleCancelScheduled(MessageContext{
+		MsgType:    channeldpb.MessageType_CANCEL_SCHEDULED,
+		Msg:        &channeldpb.CancelScheduledMessage{ScheduleId: id},
+		Connection: server,
+		Channel:    ch,
+	})
+	ch.tickScheduledMessages(msToChannelTime(2000))
+	assert.Equal(t, 4, countForwarded())
+	// Cancel again fails
+	handleCancelScheduled(MessageContext{
+		MsgType:    channeldpb.MessageType_CANCEL_SCHEDULED,
+		Msg:        &channeldpb.CancelScheduledMessage{ScheduleId: id},
+		Connection: server,
+		Channel:    ch,
+	})
+	assert.Equal(t, channeldpb.ErrorResultMessage_INVALID_MESSAGE, server.latestMsg().(*channeldpb.ErrorResultMessage).ErrorCode)
+
+	// Neither the channel time nor the interval is set
+	schedule(server, 0, 0)
+	assert.Equal(t, channeldpb.ErrorResultMessage_INVALID_MESSAGE, server.latestMsg().(*channeldpb.ErrorResultMessage).ErrorCode)
+
+	// Only the server connection can schedule messages
+	client := addTestConnection(channeldpb.ConnectionType_CLIENT)
+	client.fsm.ChangeState("OPEN")
+	schedule(client, 1000, 0)
+	assert.Equal(t, channeldpb.ErrorResultMessage_NO_ACCESS, client.latestMsg().(*channeldpb.ErrorResultMessage).ErrorCode)
+	assert.Empty(t, ch.scheduledMessages)
+
+	// The scheduled messages are removed with the channel
+	schedule(server, 0, 100)
+	assert.Len(t, ch.scheduledMessages, 1)
+	RemoveChannel(ch)
+	assert.Empty(t, ch.scheduledMessages)
+	assert.False(t, ch.hasDueScheduledMessage(math.MaxInt64-1))
+}
@@ -0,0 +1,277 @@
+package channeld
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync/atomic"
+	"time"
+
+	"github.com/metaworking/channeld/pkg/channeldpb"
+	"github.com/metaworking/channeld/pkg/common"
+	"go.uber.org/zap"
+)
+
+const DefaultTransactionTimeoutMs = 1000
+
+var ErrChannelLocked = errors.New("channel is locked by another transaction")
+var ErrTransactionTimeout = errors.New("transaction timed out")
+var ErrNoDataWriteAccess = errors.New("no write access to the channel data")
+
+const (
+	transactionState_Pending   int32 = 0
+	transactionState_Committed int32 = 1
+	transactionState_Aborted   int32 = 2
+)
+
+type transactionUpdate struct {
+	ch        *Channel
+	updateMsg common.ChannelDataMessage
+}
+
+type transactionPrepareResult struct {
+	ch  *Channel
+	err error
+}
+
+// Applies the data updates of multiple channels all-or-nothing. See ChannelDataTransactionMessage.
+//
+// The transaction runs in two phases, both in the channels' goroutines via Channel.tryExecute:
+// 1. Prepare: each channel checks the update and locks itself. A locked channel rejects the other updates and transactions.
+// 2. Commit or abort: each channel applies the update if all the channels are prepared in time, and unlocks itself.
+// The finish step is handed to all the channels before the transaction is committed, so a channel that is being removed
+// or too busy to take it aborts the transaction, instead of missing the update of a committed one.
+// As the channels never wait for each other, the transactions can't deadlock.
+type channelTransaction struct {
+	id      uint32
+	sender  ConnectionInChannel
+	updates []transactionUpdate
+	// Buffered with the size of the updates, so the prepare step never blocks the channel.
+	prepared chan transactionPrepareResult
+	// Closed when the transaction is committed or aborted.
+	decided chan struct{}
+	// Accessed atomically.
+	state int32
+}
+
+var nextTransactionId uint32 = 0
+
+func handleChannelDataTransaction(ctx MessageContext) {
+	if ctx.Channel != globalChannel {
+		ctx.Connection.Logger().Error("illegal attemp to run transaction outside the GLOBAL channel")
+		return
+	}
+
+	msg, ok := ctx.Msg.(*channeldpb.ChannelDataTransactionMessage)
+	if !ok {
+		ctx.Connection.Logger().Error("message is not a ChannelDataTransactionMessage, will not be handled.")
+		return
+	}
+
+	tx, failedResult := newChannelTransaction(ctx.Connection, msg.Updates)
+	if failedResult != nil {
+		ctx.Msg = failedResult
+		ctx.Connection.Send(ctx)
+		return
+	}
+
+	timeout := GlobalSettings.multiChannelTimeout(msg.TimeoutMs, DefaultTransactionTimeoutMs)
+
+	// Don't block the GLOBAL channel while waiting for the other channels.
+	go func() {
+		ctx.Msg = tx.run(timeout)
+		ctx.Connection.Send(ctx)
+	}()
+}
+
+// Returns the result message if any update is invalid.
+func newChannelTransaction(sender ConnectionInChannel, updates []*channeldpb.ChannelDataTransactionMessage_Update) (*channelTransaction, *channeldpb.ChannelDataTransactionResultMessage) {
+	if len(updates) == 0 {
+		return nil, &channeldpb.ChannelDataTransactionResultMessage{Reason: "no update in the transaction"}
+	}
+
+	tx := &channelTransaction{
+		id:       atomic.AddUint32(&nextTransactionId, 1),
+		sender:   sender,
+		updates:  make([]transactionUpdate, 0, len(updates)),
+		prepared: make(chan transactionPrepareResult, len(updates)),
+		decided:  make(chan struct{}),
+	}
+
+	for _, update := range updates {
+		failed := func(reason string) *channeldpb.ChannelDataTransactionResultMessage {
+			return &channeldpb.ChannelDataTransactionResultMessage{
+				FailedChannelId: Pointer(update.ChannelId),
+				Reason:          reason,
+			}
+		}
+
+		ch := GetChannel(common.ChannelId(update.ChannelId))
+		if ch == nil || ch.IsRemoving() {
+			return nil, failed("channel doesn't exist")
+		}
+		for _, u := range tx.updates {
+			if u.ch == ch {
+				return nil, failed("duplicated channel in the transaction")
+			}
+		}
+		if update.Data == nil {
+			return nil, failed("no data to update")
+		}
+		updateMsg, err := update.Data.UnmarshalNew()
+		if err != nil {
+			return nil, failed(fmt.Sprintf("failed to unmarshal the update data: %v", err))
+		}
+		tx.updates = append(tx.updates, transactionUpdate{ch: ch, updateMsg: updateMsg})
+	}
+	return tx, nil
+}
+
+func (tx *channelTransaction) run(timeout time.Duration) *channeldpb.ChannelDataTransactionResultMessage {
+	// Prepare in the order of the channel ID.
+	sort.Slice(tx.updates, func(i, j int) bool {
+		return tx.updates[i].ch.id < tx.updates[j].ch.id
+	})
+
+	var failedCh *Channel
+	var failedErr error
+	for _, u := range tx.updates {
+		u := u
+		if !u.ch.tryExecute(func(ch *Channel) {
+			tx.prepared <- transactionPrepareResult{ch: ch, err: tx.prepare(ch, u.updateMsg)}
+		}) {
+			failedCh, failedErr = u.ch, ErrChannelUnavailable
+			break
+		}
+	}
+
+	preparedChannels := make(map[*Channel]bool, len(tx.updates))
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	for failedErr == nil && len(preparedChannels) < len(tx.updates) {
+		select {
+		case result := <-tx.prepared:
+			if result.err != nil {
+				failedCh, failedErr = result.ch, result.err
+			} else {
+				preparedChannels[result.ch] = true
+			}
+		case <-timer.C:
+			failedErr = ErrTransactionTimeout
+			// Report the first channel that is not prepared.
+			for _, u := range tx.updates {
+				if !preparedChannels[u.ch] {
+					failedCh = u.ch
+					break
+				}
+			}
+		}
+	}
+
+	// The finish step always runs after the prepare step in the same channel, as the inbox is FIFO.
+	finishing := make(map[*Channel]bool, len(tx.updates))
+	if failedErr == nil {
+		for _, u := range tx.updates {
+			if !tx.enqueueFinish(u) {
+				failedCh, failedErr = u.ch, ErrChannelUnavailable
+				break
+			}
+			finishing[u.ch] = true
+		}
+	}
+
+	if failedErr == nil {
+		atomic.StoreInt32(&tx.state, transactionState_Committed)
+	} else {
+		// The channels that are prepared later than the abort won't be locked.
+		atomic.StoreInt32(&tx.state, transactionState_Aborted)
+	}
+	close(tx.decided)
+
+	// Unlock the rest of the channels of the aborted transaction.
+	for _, u := range tx.updates {
+		if !finishing[u.ch] {
+			tx.unlockLater(u)
+		}
+	}
+
+	if failedErr != nil {
+		rootLogger.Info("aborted transaction",
+			zap.Uint32("txId", tx.id),
+			zap.Uint32("connId", uint32(tx.sender.Id())),
+			zap.Uint32("failedChannelId", uint32(failedCh.id)),
+			zap.Error(failedErr),
+		)
+		return &channeldpb.ChannelDataTransactionResultMessage{
+			FailedChannelId: Pointer(uint32(failedCh.id)),
+			Reason:          failedErr.Error(),
+		}
+	}
+
+	rootLogger.Debug("committed transaction", zap.Uint32("txId", tx.id), zap.Int("channels", len(tx.updates)))
+	return &channeldpb.ChannelDataTransactionResultMessage{Success: true}
+}
+
+// Checks the update and locks the channel. Runs in the channel's goroutine.
+func (tx *channelTransaction) prepare(ch *Channel, updateMsg common.ChannelDataMessage) error {
+	if atomic.LoadInt32(&tx.state) != transactionState_Pending {
+		return ErrTransactionTimeout
+	}
+	if ch.lockedBy != nil {
+		return ErrChannelLocked
+	}
+	if !ch.hasDataWriteAccess(tx.sender) {
+		return ErrNoDataWriteAccess
+	}
+	if ch.Data() == nil {
+		return errors.New("channel data is not initialized")
+	}
+	if err := ch.validateDataUpdate(updateMsg); err != nil {
+		return err
+	}
+	ch.stripDisallowedFields(tx.sender, updateMsg)
+	if ch.behavior != nil {
+		if err := ch.behavior.OnDataUpdate(ch, updateMsg, tx.sender); err != nil {
+			return err
+		}
+	}
+
+	ch.lockedBy = tx
+	return nil
+}
+
+// Puts the finish step into the channel's inbox. The step waits for the transaction to be decided, which doesn't take long
+// as the finish steps of the other channels are enqueued without blocking.
+func (tx *channelTransaction) enqueueFinish(u transactionUpdate) bool {
+	return u.ch.tryExecute(func(ch *Channel) {
+		<-tx.decided
+		tx.finish(ch, u.updateMsg)
+	})
+}
+
+// Runs the finish step of the aborted transaction. If the channel's inbox is full, keeps retrying in another goroutine
+// until the channel takes it or is removed, so the channel is never left locked.
+func (tx *channelTransaction) unlockLater(u transactionUpdate) {
+	if tx.enqueueFinish(u) || u.ch.IsRemoving() {
+		return
+	}
+	go func() {
+		for !tx.enqueueFinish(u) && !u.ch.IsRemoving() {
+			time.Sleep(10 * time.Millisecond)
+		}
+	}()
+}
+
+// Applies the update if the transaction is committed, and unlocks the channel. Runs in the channel's goroutine.
+func (tx *channelTransaction) finish(ch *Channel, updateMsg common.ChannelDataMessage) {
+	if ch.lockedBy != tx {
+		return
+	}
+	ch.lockedBy = nil
+
+	if atomic.LoadInt32(&tx.state) == transactionState_Committed {
+		ch.recordDataWrites(updateMsg, tx.sender)
+		ch.Data().OnUpdate(updateMsg, ch.GetTime(), tx.sender.Id(), ch.spatialNotifier)
+	}
+}
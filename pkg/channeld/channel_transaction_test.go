@@ -0,0 +1,135 @@
+package channeld
+
+import (
+	"testing"
+	"time"
+
+	"github.com/metaworking/channeld/internal/testpb"
+	"github.com/metaworking/channeld/pkg/channeldpb"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+func TestChannelDataTransaction(t *testing.T) {
+	InitLogs()
+	InitChannels()
+	InitConnections("../../config/server_conn_fsm_test.json", "../../config/client_non_authoratative_fsm.json")
+
+	server := addTestConnection(channeldpb.ConnectionType_SERVER)
+	other := addTestConnection(channeldpb.ConnectionType_SERVER)
+	ch1, _ := CreateChannel(channeldpb.ChannelType_PRIVATE, server)
+	ch2, _ := CreateChannel(channeldpb.ChannelType_PRIVATE, server)
+	ch3, _ := CreateChannel(channeldpb.ChannelType_PRIVATE, other)
+	channels := []*Channel{ch1, ch2, ch3}
+	for _, ch := range channels {
+		ch.InitData(&testpb.TestChannelDataMessage{Text: "a"}, nil)
+		// Drop the channel from the scheduler, so the test can tick it manually.
+		scheduler.unschedule(ch)
+	}
+
+	update := func(ch *Channel, text string) *channeldpb.ChannelDataTransactionMessage_Update {
+		any, _ := anypb.New(&testpb.TestChannelDataMessage{Text: text})
+		return &channeldpb.ChannelDataTransactionMessage_Update{ChannelId: uint32(ch.id), Data: any}
+	}
+	textOf := func(ch *Channel) string {
+		return ch.Data().msg.(*testpb.TestChannelDataMessage).Text
+	}
+	// Runs the transaction while ticking the channels in the test goroutine.
+	runTransaction := func(tx *channelTransaction, timeout time.Duration, tickedChannels ...*Channel) *channeldpb.ChannelDataTransactionResultMessage {
+		resultChan := make(chan *channeldpb.ChannelDataTransactionResultMessage)
+		go func() {
+			resultChan <- tx.run(timeout)
+		}()
+		for {
+			select {
+			case result := <-resultChan:
+				// Run the finish step
+				for _, ch := range tickedChannels {
+					ch.tickMessages(time.Now())
+				}
+				return result
+			default:
+				for _, ch := range tickedChannels {
+					ch.tickMessages(time.Now())
+				}
+				time.Sleep(time.Millisecond)
+			}
+		}
+	}
+
+	// Invalid transactions
+	_, result := newChannelTransaction(server, nil)
+	assert.False(t, result.Success)
+	_, result = newChannelTransaction(server, []*channeldpb.ChannelDataTransactionMessage_Update{update(ch1, "b"), update(ch1, "c")})
+	assert.EqualValues(t, ch1.id, *result.FailedChannelId)
+	_, result = newChannelTransaction(server, []*channeldpb.ChannelDataTransactionMessage_Update{update(ch1, "b"), {ChannelId: 12345}})
+	assert.EqualValues(t, 12345, *result.FailedChannelId)
+
+	// All channels are updated
+	tx, result := newChannelTransaction(server, []*channeldpb.ChannelDataTransactionMessage_Update{update(ch2, "b"), update(ch1, "b")})
+	assert.Nil(t, result)
+	result = runTransaction(tx, time.Second, ch1, ch2)
+	assert.True(t, result.Success)
+	assert.Nil(t, result.FailedChannelId)
+	assert.Equal(t, "b", textOf(ch1))
+	assert.Equal(t, "b", textOf(ch2))
+	assert.Nil(t, ch1.lockedBy)
+	assert.Nil(t, ch2.lockedBy)
+
+	// No access to ch3 - no channel is updated
+	tx, _ = newChannelTransaction(server, []*channeldpb.ChannelDataTransactionMessage_Update{update(ch1, "c"), update(ch3, "c")})
+	result = runTransaction(tx, time.Second, ch1, ch3)
+	assert.False(t, result.Success)
+	assert.EqualValues(t, ch3.id, *result.FailedChannelId)
+	assert.Equal(t, ErrNoDataWriteAccess.Error(), result.Reason)
+	assert.Equal(t, "b", textOf(ch1))
+	assert.Equal(t, "a", textOf(ch3))
+	assert.Nil(t, ch1.lockedBy)
+
+	// ch2 is not ticked in time - no channel is updated
+	tx, _ = newChannelTransaction(server, []*channeldpb.ChannelDataTransactionMessage_Update{update(ch1, "d"), update(ch2, "d")})
+	result = runTransaction(tx, 50*time.Millisecond, ch1)
+	assert.False(t, result.Success)
+	assert.EqualValues(t, ch2.id, *result.FailedChannelId)
+	assert.Equal(t, ErrTransactionTimeout.Error(), result.Reason)
+	assert.Equal(t, "b", textOf(ch1))
+	assert.Nil(t, ch1.lockedBy)
+	// The late prepare step doesn't lock ch2
+	ch2.tickMessages(time.Now())
+	assert.Equal(t, "b", textOf(ch2))
+	assert.Nil(t, ch2.lockedBy)
+
+	// The locked channel rejects the data update
+	ch1.lockedBy = &channelTransaction{}
+	any, _ := anypb.New(&testpb.TestChannelDataMessage{Text: "e"})
+	handleChannelDataUpdate(MessageContext{
+		MsgType:    channeldpb.MessageType_CHANNEL_DATA_UPDATE,
+		Msg:        &channeldpb.ChannelDataUpdateMessage{Data: any},
+		Connection: server,
+		Channel:    ch1,
+	})
+	assert.Equal(t, channeldpb.ErrorResultMessage_CHANNEL_BUSY, server.latestMsg().(*channeldpb.ErrorResultMessage).ErrorCode)
+	assert.Equal(t, "b", textOf(ch1))
+	// ... and the other transactions
+	tx, _ = newChannelTransaction(server, []*channeldpb.ChannelDataTransactionMessage_Update{update(ch1, "f")})
+	result = runTransaction(tx, time.Second, ch1)
+	assert.Equal(t, ErrChannelLocked.Error(), result.Reason)
+	ch1.lockedBy = nil
+
+	// ch4 is removed before the transaction runs - no channel is updated
+	ch4, _ := CreateChannel(channeldpb.ChannelType_PRIVATE, server)
+	ch4.InitData(&testpb.TestChannelDataMessage{Text: "a"}, nil)
+	scheduler.unschedule(ch4)
+	tx, _ = newChannelTransaction(server, []*channeldpb.ChannelDataTransactionMessage_Update{update(ch1, "g"), update(ch4, "g")})
+	RemoveChannel(ch4)
+	result = runTransaction(tx, time.Second, ch1)
+	assert.False(t, result.Success)
+	assert.EqualValues(t, ch4.id, *result.FailedChannelId)
+	assert.Equal(t, ErrChannelUnavailable.Error(), result.Reason)
+	assert.Equal(t, "b", textOf(ch1))
+	assert.Nil(t, ch1.lockedBy)
+
+	for _, ch := range channels {
+		RemoveChannel(ch)
+	}
+}
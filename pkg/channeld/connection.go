@@ -7,6 +7,7 @@ import (
 	"net"
 	"os"
 	"path/filepath"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -55,10 +56,14 @@ type queuedMessagePackSender struct {
 }
 
 func (s *queuedMessagePackSender) Send(c *Connection, ctx MessageContext) {
-	msgBody, err := proto.Marshal(ctx.Msg)
-	if err != nil {
-		c.logger.Error("failed to marshal message", zap.Error(err), zap.Uint32("msgType", uint32(ctx.MsgType)))
-		return
+	msgBody := ctx.msgBody
+	if msgBody == nil {
+		var err error
+		msgBody, err = proto.Marshal(ctx.Msg)
+		if err != nil {
+			c.logger.Error("failed to marshal message", zap.Error(err), zap.Uint32("msgType", uint32(ctx.MsgType)))
+			return
+		}
 	}
 
 	c.sendQueue <- &channeldpb.MessagePack{
@@ -85,6 +90,10 @@ type Connection struct {
 	pit                  string
 	fsm                  *fsm.FiniteStateMachine
 	fsmDisallowedCounter int
+	// Nil if there's no rate limit rule for the connection type.
+	rateLimiter *rateLimiter
+	// The bandwidth budget of the channel data fan-outs to the connection.
+	fanOutBudget         *bandwidthBudget
 	logger               *Logger
 	state                int32 // Don't put the connection state into the FSM as 1) the FSM's states are user-defined. 2) the FSM is not goroutine-safe.
 	connTime             time.Time
@@ -98,6 +107,9 @@ var nextConnectionId uint32 = 0
 var serverFsm *fsm.FiniteStateMachine
 var clientFsm *fsm.FiniteStateMachine
 
+// Guards serverFsm and clientFsm, which are swapped by ReloadSettings.
+var fsmLock sync.RWMutex
+
 func InitConnections(serverFsmPath string, clientFsmPath string) {
 	if allConnections != nil {
 		return
@@ -105,10 +117,8 @@ func InitConnections(serverFsmPath string, clientFsmPath string) {
 
 	allConnections = xsync.NewTypedMapOf[ConnectionId, *Connection](UintIdHasher[ConnectionId]())
 
-	bytes, err := os.ReadFile(serverFsmPath)
-	if err == nil {
-		serverFsm, err = fsm.Load(bytes)
-	}
+	var err error
+	serverFsm, err = loadFSM(serverFsmPath)
 	if err != nil {
 		rootLogger.Panic("failed to read server FSM",
 			zap.Error(err),
@@ -120,10 +130,7 @@ func InitConnections(serverFsmPath string, clientFsmPath string) {
 		)
 	}
 
-	bytes, err = os.ReadFile(clientFsmPath)
-	if err == nil {
-		clientFsm, err = fsm.Load(bytes)
-	}
+	clientFsm, err = loadFSM(clientFsmPath)
 	if err != nil {
 		rootLogger.Panic("failed to read client FSM", zap.Error(err))
 	} else {
@@ -278,6 +285,8 @@ func AddConnection(c net.Conn, t channeldpb.ConnectionType) *Connection {
 		sender:               &queuedMessagePackSender{},
 		sendQueue:            make(chan *channeldpb.MessagePack, 128),
 		fsmDisallowedCounter: 0,
+		rateLimiter:          newRateLimiter(t),
+		fanOutBudget:         newBandwidthBudget(0),
 		logger: &Logger{rootLogger.With(
 			zap.String("connType", t.String()),
 			zap.Uint32("connId", nextConnectionId),
@@ -294,6 +303,7 @@ func AddConnection(c net.Conn, t channeldpb.ConnectionType) *Connection {
 		}
 	}
 
+	fsmLock.RLock()
 	switch t {
 	case channeldpb.ConnectionType_SERVER:
 		if serverFsm != nil {
@@ -308,6 +318,7 @@ func AddConnection(c net.Conn, t channeldpb.ConnectionType) *Connection {
 			connection.fsm = &fsm
 		}
 	}
+	fsmLock.RUnlock()
 
 	if connection.fsm == nil {
 		rootLogger.Panic("cannot set the FSM for connection", zap.String("connType", t.String()))
@@ -514,6 +525,34 @@ func (c *Connection) isPacketRecordingEnabled() bool {
 	return c.connectionType == channeldpb.ConnectionType_CLIENT && GlobalSettings.EnableRecordPacket
 }
 
+// Deserializes the message in the MessagePack sent by the connection, and finds the handler of the message.
+func (c *Connection) unpackMessage(mp *channeldpb.MessagePack) (common.Message, MessageHandlerFunc, error) {
+	entry := MessageMap[channeldpb.MessageType(mp.MsgType)]
+	if mp.MsgType >= uint32(channeldpb.MessageType_USER_SPACE_START) && entry == nil {
+		// client -> channeld -> server
+		if c.connectionType == channeldpb.ConnectionType_CLIENT {
+			// User-space message without handler won't be deserialized.
+			return &channeldpb.ServerForwardMessage{ClientConnId: uint32(c.id), Payload: mp.MsgBody}, handleClientToServerUserMessage, nil
+		}
+		// server -> channeld -> client/server
+		msg := &channeldpb.ServerForwardMessage{}
+		if err := proto.Unmarshal(mp.MsgBody, msg); err != nil {
+			return nil, nil, err
+		}
+		return msg, HandleServerToClientUserMessage, nil
+	}
+
+	if entry == nil {
+		return nil, nil, fmt.Errorf("undefined message type: %d", mp.MsgType)
+	}
+	// Always make a clone!
+	msg := proto.Clone(entry.msg)
+	if err := proto.Unmarshal(mp.MsgBody, msg); err != nil {
+		return nil, nil, err
+	}
+	return msg, entry.handler, nil
+}
+
 func (c *Connection) receiveMessage(mp *channeldpb.MessagePack) {
 	channel := GetChannel(common.ChannelId(mp.ChannelId))
 	if channel == nil {
@@ -539,33 +578,15 @@ func (c *Connection) receiveMessage(mp *channeldpb.MessagePack) {
 		return
 	}
 
-	var msg common.Message
-	var handler MessageHandlerFunc
-	if mp.MsgType >= uint32(channeldpb.MessageType_USER_SPACE_START) && entry == nil {
-		// client -> channeld -> server
-		if c.connectionType == channeldpb.ConnectionType_CLIENT {
-			// User-space message without handler won't be deserialized.
-			msg = &channeldpb.ServerForwardMessage{ClientConnId: uint32(c.id), Payload: mp.MsgBody}
-			handler = handleClientToServerUserMessage
-		} else {
-			// server -> channeld -> client/server
-			msg = &channeldpb.ServerForwardMessage{}
-			err := proto.Unmarshal(mp.MsgBody, msg)
-			if err != nil {
-				c.Logger().Error("unmarshalling ServerForwardMessage", zap.Error(err))
-				return
-			}
-			handler = HandleServerToClientUserMessage
-		}
-	} else {
-		handler = entry.handler
-		// Always make a clone!
-		msg = proto.Clone(entry.msg)
-		err := proto.Unmarshal(mp.MsgBody, msg)
-		if err != nil {
-			c.Logger().Error("unmarshalling message", zap.Error(err))
-			return
-		}
+	if c.rateLimiter != nil && !c.rateLimiter.allow(mp.MsgType, time.Now()) {
+		c.onRateLimitExceeded(mp.MsgType)
+		return
+	}
+
+	msg, handler, err := c.unpackMessage(mp)
+	if err != nil {
+		c.Logger().Error("unmarshalling message", zap.Uint32("msgType", mp.MsgType), zap.Error(err))
+		return
 	}
 
 	c.fsm.OnReceived(mp.MsgType)
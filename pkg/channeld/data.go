@@ -3,6 +3,10 @@ package channeld
 import (
 	"container/list"
 	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
 
 	"github.com/indiest/fmutils"
 	"github.com/metaworking/channeld/pkg/channeldpb"
@@ -10,7 +14,6 @@ import (
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/proto"
 
-	"google.golang.org/protobuf/reflect/protoreflect"
 	"google.golang.org/protobuf/types/known/anypb"
 )
 
@@ -22,6 +25,9 @@ type ChannelData struct {
 	updateMsgBuffer      *list.List
 	maxFanOutIntervalMs  uint32
 	msgIndex             uint64
+	// The write states of the top-level fields and the entries of the top-level map fields, by the field path.
+	// Only used when ChannelDataMergeOptions.ConflictPolicy is set.
+	fieldWrites map[string]*fieldWriteState
 }
 
 // Indicate that the channel data message should be initialized with default values.
@@ -39,6 +45,15 @@ type fanOutConnection struct {
 	hadFirstFanOut   bool
 	lastFanOutTime   ChannelTime
 	lastMessageIndex uint64
+	// The index of the latest update acknowledged by the subscriber. Only used by ChannelSubscriptionOptions.DeltaFanOut.
+	ackedMessageIndex uint64
+	// The fan-out states of ChannelSubscriptionOptions.FanOutTiers, in the same order.
+	tiers []fanOutTierState
+}
+
+type fanOutTierState struct {
+	lastFanOutTime   ChannelTime
+	lastMessageIndex uint64
 }
 
 type updateMsgBufferElement struct {
@@ -54,10 +69,16 @@ const (
 
 var channelDataTypeRegistery = make(map[channeldpb.ChannelType]proto.Message)
 
+// The types can be registered by ReloadSettings while the channels are reading them.
+var channelDataTypeRegisteryLock sync.RWMutex
+
 // Register a Protobuf message template as the channel data of a specific channel type.
 // This is needed when channeld doesn't know the package of the message is in,
 // as well as creating a ChannelData using ReflectChannelData()
 func RegisterChannelDataType(channelType channeldpb.ChannelType, msgTemplate proto.Message) {
+	channelDataTypeRegisteryLock.Lock()
+	defer channelDataTypeRegisteryLock.Unlock()
+
 	msg, exists := channelDataTypeRegistery[channelType]
 
 	if exists {
@@ -81,6 +102,14 @@ func RegisterChannelDataType(channelType channeldpb.ChannelType, msgTemplate pro
 
 }
 
+// Returns the message template registered for the channel type. Can be called in any goroutine.
+func getChannelDataType(channelType channeldpb.ChannelType) (proto.Message, bool) {
+	channelDataTypeRegisteryLock.RLock()
+	defer channelDataTypeRegisteryLock.RUnlock()
+	msg, exists := channelDataTypeRegistery[channelType]
+	return msg, exists
+}
+
 func ReflectChannelDataMessage(channelType channeldpb.ChannelType) (common.ChannelDataMessage, error) {
 	/*
 		channelTypeName := channelType.String()
@@ -91,7 +120,7 @@ func ReflectChannelDataMessage(channelType channeldpb.ChannelType) (common.Chann
 			return nil, fmt.Errorf("failed to create data for channel type %s: %w", channelTypeName, err)
 		}
 	*/
-	dataType, exists := channelDataTypeRegistery[channelType]
+	dataType, exists := getChannelDataType(channelType)
 	if !exists {
 		return nil, fmt.Errorf("no channel data type registered for channel type %s", channelType.String())
 	}
@@ -105,6 +134,8 @@ func (ch *Channel) InitData(dataMsg common.ChannelDataMessage, mergeOptions *cha
 		updateMsgBuffer: list.New(),
 		mergeOptions:    mergeOptions,
 	}
+	// The existing subscribers should get the first fan-out.
+	ch.markFanOutPending()
 
 	if dataMsg == nil {
 		var err error
@@ -150,6 +181,7 @@ func (d *ChannelData) OnUpdate(updateMsg common.ChannelDataMessage, t ChannelTim
 		)
 	} else {
 		mergeWithOptions(d.msg, updateMsg, d.mergeOptions, spatialNotifier)
+		d.pruneFieldWrites(updateMsg)
 	}
 	d.msgIndex = d.msgIndex + 1
 	d.updateMsgBuffer.PushBack(&updateMsgBufferElement{
@@ -169,9 +201,15 @@ func (d *ChannelData) OnUpdate(updateMsg common.ChannelDataMessage, t ChannelTim
 
 func (ch *Channel) tickData(t ChannelTime) {
 	if ch.data == nil || ch.data.msg == nil {
+		// Nothing to fan out until the data is initialized (see InitData), so don't keep the channel from being idle.
+		atomic.StoreInt32(&ch.fanOutPending, 0)
 		return
 	}
 
+	// The fan-outs encoded in this tick. The subscribers that receive the same content share the encoded message.
+	fanOuts := make(map[fanOutGroupKey]*encodedFanOut)
+	toMerge := make([]*updateMsgBufferElement, 0)
+
 	focp := ch.fanOutQueue.Front()
 
 	for focp != nil {
@@ -197,26 +235,46 @@ func (ch *Channel) tickData(t ChannelTime) {
 			   |------FanOutDelay------|---FanOutInterval---|
 			   subTime                 firstFanOutTime      secondFanOutTime
 		*/
-		nextFanOutTime := foc.lastFanOutTime.AddMs(*cs.options.FanOutIntervalMs)
+		fanOutInterval := ch.fanOutIntervalOf(conn, cs, t)
+		nextFanOutTime := foc.lastFanOutTime.AddMs(fanOutInterval)
+		// The channel may have skipped the ticks when it was idle (see channelScheduler), so the last fan-out could be far behind.
+		// As no update has arrived since then, extend the fan-out window to now.
+		if nextFanOutTime.AddMs(fanOutInterval) <= t {
+			nextFanOutTime = t
+		}
 		// latestFanoutTime := foc.lastFanOutTime
 		if t >= nextFanOutTime {
 			latestFanoutTime := nextFanOutTime
 			var lastUpdateTime ChannelTime
 			bufp := ch.data.updateMsgBuffer.Front()
-			if ch.data.accumulatedUpdateMsg == nil {
-				ch.data.accumulatedUpdateMsg = ch.data.msg.ProtoReflect().New().Interface()
-			} else {
-				proto.Reset(ch.data.accumulatedUpdateMsg)
-			}
-			hasEverMerged := false
+			key := fanOutGroupKey{masks: fanOutMasksKey(cs.options.DataFieldMasks), delta: *cs.options.DeltaFanOut}
 
 			//if foc.lastFanOutTime <= cs.subTime {
 			if !foc.hadFirstFanOut {
 				// Send the whole data for the first time
-				ch.fanOutDataUpdate(conn, cs, ch.data.msg)
+				key.fullState = true
+				ch.fanOutDataUpdate(conn, t, fanOuts, key, cs.options.DataFieldMasks, ch.cloneDataMessage)
 				foc.hadFirstFanOut = true
 				foc.lastMessageIndex = ch.data.msgIndex
 				latestFanoutTime = t
+			} else if len(cs.options.FanOutTiers) > 0 {
+				ch.fanOutTiers(conn, cs, foc, t, fanOuts)
+			} else if *cs.options.DeltaFanOut {
+				if foc.lastMessageIndex < ch.data.msgIndex {
+					ch.fanOutDelta(conn, cs, foc, t, fanOuts, key)
+				}
+			} else if bufp != nil && foc.lastMessageIndex < ch.data.msgIndex &&
+				bufp.Value.(*updateMsgBufferElement).messageIndex > foc.lastMessageIndex+1 {
+				// The stretched fan-out interval (see bandwidthBudget) can be longer than the buffer keeps the updates,
+				// so the updates since the last fan-out may have been removed. Send the full states instead.
+				ch.Logger().Debug("last fan-out of the subscriber is no longer buffered, will fan out the full states",
+					zap.Uint32("connId", uint32(conn.Id())),
+					zap.Uint64("lastMsgIndex", foc.lastMessageIndex),
+				)
+				key.fullState = true
+				ch.fanOutDataUpdate(conn, t, fanOuts, key, cs.options.DataFieldMasks, ch.cloneDataMessage)
+				foc.lastMessageIndex = ch.data.msgIndex
+				latestFanoutTime = t
 			} else if bufp != nil {
 				if foc.lastFanOutTime >= lastUpdateTime {
 					lastUpdateTime = foc.lastFanOutTime
@@ -234,18 +292,20 @@ func (ch *Channel) tickData(t ChannelTime) {
 						)
 					*/
 
+					inWindow := be.arrivalTime >= lastUpdateTime && be.arrivalTime <= nextFanOutTime
+
 					if be.senderConnId == conn.Id() && *cs.options.SkipSelfUpdateFanOut {
+						if inWindow {
+							// The content is specific to the subscriber.
+							key.connId = conn.Id()
+							foc.lastMessageIndex = be.messageIndex
+						}
 						bufp = bufp.Next()
 						continue
 					}
 
-					if be.arrivalTime >= lastUpdateTime && be.arrivalTime <= nextFanOutTime {
-						if !hasEverMerged {
-							proto.Merge(ch.data.accumulatedUpdateMsg, be.updateMsg)
-						} else {
-							mergeWithOptions(ch.data.accumulatedUpdateMsg, be.updateMsg, ch.data.mergeOptions, nil)
-						}
-						hasEverMerged = true
+					if inWindow {
+						toMerge = append(toMerge, be)
 						lastUpdateTime = be.arrivalTime
 						foc.lastMessageIndex = be.messageIndex
 					}
@@ -259,8 +319,13 @@ func (ch *Channel) tickData(t ChannelTime) {
 					bufp = bufp.Next()
 				}
 
-				if hasEverMerged {
-					ch.fanOutDataUpdate(conn, cs, ch.data.accumulatedUpdateMsg)
+				if len(toMerge) > 0 {
+					key.fromIndex = toMerge[0].messageIndex
+					key.toIndex = toMerge[len(toMerge)-1].messageIndex
+					ch.fanOutDataUpdate(conn, t, fanOuts, key, cs.options.DataFieldMasks, func() common.ChannelDataMessage {
+						return ch.accumulateUpdates(toMerge)
+					})
+					toMerge = toMerge[:0]
 				}
 			}
 			foc.lastFanOutTime = latestFanoutTime
@@ -283,23 +348,222 @@ func (ch *Channel) tickData(t ChannelTime) {
 			focp = focp.Next()
 		}
 	}
+
+	ch.data.resetTickWrites()
+	ch.updateFanOutPending()
 }
 
-func (ch *Channel) fanOutDataUpdate(conn ConnectionInChannel, cs *ChannelSubscription, updateMsg common.ChannelDataMessage) {
-	fmutils.Filter(updateMsg, cs.options.DataFieldMasks)
-	any, err := anypb.New(updateMsg)
-	if err != nil {
-		ch.Logger().Error("failed to marshal channel update data", zap.Error(err))
+// Checks if any subscriber hasn't received the latest channel data, so the scheduler won't skip the next tick.
+func (ch *Channel) updateFanOutPending() {
+	var latestUpdateTime ChannelTime
+	hasUpdate := false
+	if back := ch.data.updateMsgBuffer.Back(); back != nil {
+		latestUpdateTime = back.Value.(*updateMsgBufferElement).arrivalTime
+		hasUpdate = true
+	}
+
+	defer func() {
+		ch.connectionsLock.RUnlock()
+	}()
+	// New subscribers are added to the fanOutQueue with the lock held.
+	ch.connectionsLock.RLock()
+
+	var pending int32 = 0
+	for e := ch.fanOutQueue.Front(); e != nil; e = e.Next() {
+		foc := e.Value.(*fanOutConnection)
+		if !foc.hadFirstFanOut || (hasUpdate && foc.lastFanOutTime < latestUpdateTime) {
+			pending = 1
+			break
+		}
+		// The slower tiers may have not fanned-out the updates.
+		for _, tier := range foc.tiers {
+			if tier.lastMessageIndex < ch.data.msgIndex {
+				pending = 1
+				break
+			}
+		}
+	}
+	atomic.StoreInt32(&ch.fanOutPending, pending)
+}
+
+// Identifies the content of a fan-out. The subscribers that are due in the same tick and receive the same content
+// share the filtering and encoding of the fan-out.
+type fanOutGroupKey struct {
+	// The sorted DataFieldMasks of the subscription
+	masks string
+	// The range of the merged update messages. Not set for the full states.
+	fromIndex uint64
+	toIndex   uint64
+	fullState bool
+	// The fan-out of ChannelSubscriptionOptions.DeltaFanOut contains the message index.
+	delta bool
+	// Set if any update message in the range is skipped as it's sent by the subscriber, so the content can't be shared.
+	connId ConnectionId
+	// The fan-outs of ChannelSubscriptionOptions.FanOutTiers are not sent if the fields of the tier are not updated.
+	skipEmpty bool
+}
+
+type encodedFanOut struct {
+	msg  *channeldpb.ChannelDataUpdateMessage
+	body []byte
+}
+
+func fanOutMasksKey(masks []string) string {
+	switch len(masks) {
+	case 0:
+		return ""
+	case 1:
+		return masks[0]
+	}
+	sorted := make([]string, len(masks))
+	copy(sorted, masks)
+	sort.Strings(sorted)
+	return strings.Join(sorted, ",")
+}
+
+// The full states are filtered before the fan-out, so the channel data message should be cloned.
+func (ch *Channel) cloneDataMessage() common.ChannelDataMessage {
+	return proto.Clone(ch.data.msg)
+}
+
+// Merges the update messages into the accumulatedUpdateMsg.
+func (ch *Channel) accumulateUpdates(updates []*updateMsgBufferElement) common.ChannelDataMessage {
+	if ch.data.accumulatedUpdateMsg == nil {
+		ch.data.accumulatedUpdateMsg = ch.data.msg.ProtoReflect().New().Interface()
+	} else {
+		proto.Reset(ch.data.accumulatedUpdateMsg)
+	}
+	for i, be := range updates {
+		if i == 0 {
+			proto.Merge(ch.data.accumulatedUpdateMsg, be.updateMsg)
+		} else {
+			mergeWithOptions(ch.data.accumulatedUpdateMsg, be.updateMsg, ch.data.mergeOptions, nil)
+		}
+	}
+	return ch.data.accumulatedUpdateMsg
+}
+
+// Sends all the updates since the acknowledged baseline of the subscriber.
+// If the updates since the baseline have been removed from the buffer, sends the full states instead.
+func (ch *Channel) fanOutDelta(conn ConnectionInChannel, cs *ChannelSubscription, foc *fanOutConnection, t ChannelTime, fanOuts map[fanOutGroupKey]*encodedFanOut, key fanOutGroupKey) {
+	foc.lastMessageIndex = ch.data.msgIndex
+
+	front := ch.data.updateMsgBuffer.Front()
+	if front == nil || front.Value.(*updateMsgBufferElement).messageIndex > foc.ackedMessageIndex+1 {
+		ch.Logger().Debug("baseline of the subscriber is no longer buffered, will fan out the full states",
+			zap.Uint32("connId", uint32(conn.Id())),
+			zap.Uint64("ackedMsgIndex", foc.ackedMessageIndex),
+		)
+		key.fullState = true
+		ch.fanOutDataUpdate(conn, t, fanOuts, key, cs.options.DataFieldMasks, ch.cloneDataMessage)
+		return
+	}
+
+	toMerge := make([]*updateMsgBufferElement, 0)
+	for bufp := front; bufp != nil; bufp = bufp.Next() {
+		be := bufp.Value.(*updateMsgBufferElement)
+		if be.messageIndex <= foc.ackedMessageIndex {
+			continue
+		}
+		if be.senderConnId == conn.Id() && *cs.options.SkipSelfUpdateFanOut {
+			key.connId = conn.Id()
+			continue
+		}
+		toMerge = append(toMerge, be)
+	}
+
+	if len(toMerge) > 0 {
+		key.fromIndex = foc.ackedMessageIndex + 1
+		key.toIndex = ch.data.msgIndex
+		ch.fanOutDataUpdate(conn, t, fanOuts, key, cs.options.DataFieldMasks, func() common.ChannelDataMessage {
+			return ch.accumulateUpdates(toMerge)
+		})
+	}
+}
+
+// Fans out the updates of each tier in ChannelSubscriptionOptions.FanOutTiers that is due, filtered by the fields of the tier.
+func (ch *Channel) fanOutTiers(conn ConnectionInChannel, cs *ChannelSubscription, foc *fanOutConnection, t ChannelTime, fanOuts map[fanOutGroupKey]*encodedFanOut) {
+	tiers := cs.options.FanOutTiers
+	if len(foc.tiers) != len(tiers) {
+		// The tiers start from the last fan-out of the subscription.
+		foc.tiers = make([]fanOutTierState, len(tiers))
+		for i := range foc.tiers {
+			foc.tiers[i] = fanOutTierState{lastFanOutTime: foc.lastFanOutTime, lastMessageIndex: foc.lastMessageIndex}
+		}
+	}
+
+	toMerge := make([]*updateMsgBufferElement, 0)
+	for i, tier := range tiers {
+		state := &foc.tiers[i]
+		if t < state.lastFanOutTime.AddMs(ch.stretchFanOutInterval(conn, tier.FanOutIntervalMs, cs.options.GetPriority(), t)) {
+			continue
+		}
+		state.lastFanOutTime = t
+		if state.lastMessageIndex >= ch.data.msgIndex {
+			continue
+		}
+
+		front := ch.data.updateMsgBuffer.Front()
+		if front == nil || front.Value.(*updateMsgBufferElement).messageIndex > state.lastMessageIndex+1 {
+			// The updates since the last fan-out of the tier have been removed from the buffer - send the full states of the tier.
+			ch.Logger().Debug("last fan-out of the tier is no longer buffered, will fan out the full states",
+				zap.Uint32("connId", uint32(conn.Id())),
+				zap.Int("tier", i),
+				zap.Uint64("lastMsgIndex", state.lastMessageIndex),
+			)
+			state.lastMessageIndex = ch.data.msgIndex
+			key := fanOutGroupKey{masks: fanOutMasksKey(tier.DataFieldMasks), fullState: true}
+			ch.fanOutDataUpdate(conn, t, fanOuts, key, tier.DataFieldMasks, ch.cloneDataMessage)
+			continue
+		}
+
+		key := fanOutGroupKey{masks: fanOutMasksKey(tier.DataFieldMasks), skipEmpty: true}
+		for bufp := front; bufp != nil; bufp = bufp.Next() {
+			be := bufp.Value.(*updateMsgBufferElement)
+			if be.messageIndex <= state.lastMessageIndex {
+				continue
+			}
+			if be.senderConnId == conn.Id() && *cs.options.SkipSelfUpdateFanOut {
+				key.connId = conn.Id()
+				continue
+			}
+			toMerge = append(toMerge, be)
+		}
+		state.lastMessageIndex = ch.data.msgIndex
+
+		if len(toMerge) > 0 {
+			key.fromIndex = toMerge[0].messageIndex
+			key.toIndex = toMerge[len(toMerge)-1].messageIndex
+			ch.fanOutDataUpdate(conn, t, fanOuts, key, tier.DataFieldMasks, func() common.ChannelDataMessage {
+				return ch.accumulateUpdates(toMerge)
+			})
+			toMerge = toMerge[:0]
+		}
+	}
+}
+
+// Sends the fan-out of the key to the subscriber. The fan-out is filtered and encoded only once per tick (see fanOutGroupKey).
+func (ch *Channel) fanOutDataUpdate(conn ConnectionInChannel, t ChannelTime, fanOuts map[fanOutGroupKey]*encodedFanOut, key fanOutGroupKey, dataFieldMasks []string, getUpdateMsg func() common.ChannelDataMessage) {
+	encoded, exists := fanOuts[key]
+	if !exists {
+		encoded = ch.encodeFanOut(getUpdateMsg(), dataFieldMasks, key)
+		// Don't retry in the same tick if failed.
+		fanOuts[key] = encoded
+	}
+	if encoded == nil {
 		return
 	}
+
+	ch.recordFanOut(conn, len(encoded.body), t)
 	conn.Send(MessageContext{
 		MsgType:    channeldpb.MessageType_CHANNEL_DATA_UPDATE,
-		Msg:        &channeldpb.ChannelDataUpdateMessage{Data: any},
+		Msg:        encoded.msg,
 		Connection: nil,
 		Channel:    ch,
 		Broadcast:  0,
 		StubId:     0,
 		ChannelId:  uint32(ch.id),
+		msgBody:    encoded.body,
 	})
 	/*
 		conn.Logger().Trace("fan out",
@@ -312,6 +576,29 @@ func (ch *Channel) fanOutDataUpdate(conn ConnectionInChannel, cs *ChannelSubscri
 	// cs.fanOutDataMsg = nil
 }
 
+func (ch *Channel) encodeFanOut(updateMsg common.ChannelDataMessage, dataFieldMasks []string, key fanOutGroupKey) *encodedFanOut {
+	fmutils.Filter(updateMsg, dataFieldMasks)
+	if key.skipEmpty && proto.Size(updateMsg) == 0 {
+		return nil
+	}
+	any, err := anypb.New(updateMsg)
+	if err != nil {
+		ch.Logger().Error("failed to marshal channel update data", zap.Error(err))
+		return nil
+	}
+	updateDataMsg := &channeldpb.ChannelDataUpdateMessage{Data: any}
+	if key.delta {
+		updateDataMsg.MsgIndex = ch.data.msgIndex
+		updateDataMsg.FullState = key.fullState
+	}
+	body, err := proto.Marshal(updateDataMsg)
+	if err != nil {
+		ch.Logger().Error("failed to marshal channel update message", zap.Error(err))
+		return nil
+	}
+	return &encodedFanOut{msg: updateDataMsg, body: body}
+}
+
 // Implement this interface to manually merge the channel data. In most cases it can be MUCH more efficient than the default reflection-based merge.
 type MergeableChannelData interface {
 	common.Message
@@ -339,45 +626,3 @@ func mergeWithOptions(dst common.ChannelDataMessage, src common.ChannelDataMessa
 		ReflectMerge(dst, src, options)
 	}
 }
-
-// Use protoreflect to merge. No need to write custom merge code but less efficient.
-func ReflectMerge(dst common.ChannelDataMessage, src common.ChannelDataMessage, options *channeldpb.ChannelDataMergeOptions) {
-	proto.Merge(dst, src)
-
-	if options != nil {
-		//logger.Debug("merged with options", zap.Any("src", src), zap.Any("dst", dst))
-		defer func() {
-			recover()
-		}()
-
-		dst.ProtoReflect().Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
-			if fd.IsList() {
-				if options.ShouldReplaceList {
-					dst.ProtoReflect().Set(fd, src.ProtoReflect().Get(fd))
-				}
-				list := v.List()
-				offset := list.Len() - int(options.ListSizeLimit)
-				if options.ListSizeLimit > 0 && offset > 0 {
-					if options.TruncateTop {
-						for i := 0; i < int(options.ListSizeLimit); i++ {
-							list.Set(i, list.Get(i+offset))
-						}
-					}
-					list.Truncate(int(options.ListSizeLimit))
-				}
-			} else if fd.IsMap() {
-				if options.ShouldCheckRemovableMapField {
-					dstMap := v.Map()
-					dstMap.Range(func(mk protoreflect.MapKey, mv protoreflect.Value) bool {
-						removable, ok := mv.Message().Interface().(RemovableMapField)
-						if ok && removable.GetRemoved() {
-							dstMap.Clear(mk)
-						}
-						return true
-					})
-				}
-			}
-			return true
-		})
-	}
-}
@@ -0,0 +1,227 @@
+package channeld
+
+import (
+	"time"
+
+	"github.com/metaworking/channeld/pkg/channeldpb"
+	"github.com/metaworking/channeld/pkg/common"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// How far ChannelDataUpdateMessage.Timestamp can be ahead of the server time. The later timestamps are clamped,
+// so a writer can't hold a field forever under LAST_WRITER_WINS.
+const MaxDataUpdateClockSkewMs = 1000
+
+// The write state of a top-level field, or an entry of a top-level map field, of the channel data. See ChannelDataMergeOptions.ConflictPolicy.
+type fieldWriteState struct {
+	writerConnId ConnectionId
+	// Only used by LAST_WRITER_WINS.
+	timestamp int64
+	// Only used by VERSION_COUNTER.
+	version uint64
+	// The non-owner connections that wrote the field since the last fan-out. Only used by OWNER_WINS.
+	tickWriters []ConnectionInChannel
+	// Whether the owner wrote the field since the last fan-out. Only used by OWNER_WINS.
+	ownerWritten bool
+}
+
+func (d *ChannelData) conflictPolicy() channeldpb.ChannelDataMergeOptions_ConflictPolicy {
+	if d.mergeOptions == nil {
+		return channeldpb.ChannelDataMergeOptions_LAST_PROCESSED_WINS
+	}
+	return d.mergeOptions.ConflictPolicy
+}
+
+func (d *ChannelData) fieldWriteStateOf(path string) *fieldWriteState {
+	if d.fieldWrites == nil {
+		d.fieldWrites = make(map[string]*fieldWriteState)
+	}
+	state, exists := d.fieldWrites[path]
+	if !exists {
+		state = &fieldWriteState{}
+		d.fieldWrites[path] = state
+	}
+	return state
+}
+
+// Calls f with the path of each top-level field in the message, or of each entry if the field is a map, e.g. "players.1001".
+// If f returns false, the field (or the map entry) is removed from the message.
+func rangeConflictPaths(m protoreflect.Message, f func(path string) bool) {
+	fields := make([]protoreflect.FieldDescriptor, 0)
+	m.Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		fields = append(fields, fd)
+		return true
+	})
+
+	for _, fd := range fields {
+		if !fd.IsMap() {
+			if !f(string(fd.Name())) {
+				m.Clear(fd)
+			}
+			continue
+		}
+
+		entries := m.Mutable(fd).Map()
+		keys := make([]protoreflect.MapKey, 0, entries.Len())
+		entries.Range(func(key protoreflect.MapKey, _ protoreflect.Value) bool {
+			keys = append(keys, key)
+			return true
+		})
+		for _, key := range keys {
+			if !f(mapEntryPath(fd, key)) {
+				entries.Clear(key)
+			}
+		}
+		if entries.Len() == 0 {
+			m.Clear(fd)
+		}
+	}
+}
+
+func mapEntryPath(fd protoreflect.FieldDescriptor, key protoreflect.MapKey) string {
+	return string(fd.Name()) + "." + key.String()
+}
+
+// Removes the fields that lose the conflicts from the update message, according to the conflict policy of the channel data.
+// The losing writers are notified with ChannelDataConflictMessage. Should be called in the channel's goroutine, right before the update is merged.
+func (ch *Channel) resolveDataConflicts(updateMsg common.ChannelDataMessage, sender ConnectionInChannel, msg *channeldpb.ChannelDataUpdateMessage) {
+	policy := ch.data.conflictPolicy()
+	if policy == channeldpb.ChannelDataMergeOptions_LAST_PROCESSED_WINS {
+		return
+	}
+
+	timestamp := msg.Timestamp
+	if maxTimestamp := time.Now().UnixMilli() + MaxDataUpdateClockSkewMs; timestamp > maxTimestamp {
+		timestamp = maxTimestamp
+	}
+
+	isOwner := ch.ownerConnection == sender
+	conflicts := make([]*channeldpb.ChannelDataConflictMessage_FieldConflict, 0)
+	rangeConflictPaths(updateMsg.ProtoReflect(), func(path string) bool {
+		state := ch.data.fieldWriteStateOf(path)
+		lost := false
+		switch policy {
+		case channeldpb.ChannelDataMergeOptions_OWNER_WINS:
+			if isOwner {
+				// Overwrites the non-owners' writes since the last fan-out.
+				for _, loser := range state.tickWriters {
+					ch.sendDataConflict(loser, policy, []*channeldpb.ChannelDataConflictMessage_FieldConflict{{
+						Field:        path,
+						WinnerConnId: uint32(sender.Id()),
+					}})
+				}
+				state.tickWriters = nil
+				state.ownerWritten = true
+			} else if state.ownerWritten {
+				lost = true
+			} else if !containsConnection(state.tickWriters, sender) {
+				state.tickWriters = append(state.tickWriters, sender)
+			}
+		case channeldpb.ChannelDataMergeOptions_LAST_WRITER_WINS:
+			if timestamp < state.timestamp {
+				lost = true
+			} else {
+				state.timestamp = timestamp
+			}
+		case channeldpb.ChannelDataMergeOptions_VERSION_COUNTER:
+			if msg.FieldVersions[path] != state.version {
+				lost = true
+			} else {
+				state.version++
+			}
+		}
+
+		if lost {
+			conflict := &channeldpb.ChannelDataConflictMessage_FieldConflict{
+				Field:        path,
+				WinnerConnId: uint32(state.writerConnId),
+			}
+			if policy == channeldpb.ChannelDataMergeOptions_VERSION_COUNTER {
+				conflict.Version = state.version
+			}
+			conflicts = append(conflicts, conflict)
+			return false
+		}
+		state.writerConnId = sender.Id()
+		return true
+	})
+
+	if len(conflicts) > 0 {
+		ch.sendDataConflict(sender, policy, conflicts)
+	}
+}
+
+// Records the writes that bypass the conflict resolution, e.g. the committed transactions,
+// so the following writes based on the old versions are still detected as stale.
+func (ch *Channel) recordDataWrites(updateMsg common.ChannelDataMessage, sender ConnectionInChannel) {
+	if ch.data.conflictPolicy() == channeldpb.ChannelDataMergeOptions_LAST_PROCESSED_WINS {
+		return
+	}
+
+	rangeConflictPaths(updateMsg.ProtoReflect(), func(path string) bool {
+		state := ch.data.fieldWriteStateOf(path)
+		state.writerConnId = sender.Id()
+		state.version++
+		return true
+	})
+}
+
+// Removes the write states of the map entries that are removed from the channel data by the merged update message,
+// so the states of the short-lived keys don't pile up.
+func (d *ChannelData) pruneFieldWrites(updateMsg common.ChannelDataMessage) {
+	if len(d.fieldWrites) == 0 {
+		return
+	}
+	data := d.msg.ProtoReflect()
+	updateMsg.ProtoReflect().Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if !fd.IsMap() {
+			return true
+		}
+		entries := data.Get(fd).Map()
+		v.Map().Range(func(key protoreflect.MapKey, _ protoreflect.Value) bool {
+			if !entries.Has(key) {
+				delete(d.fieldWrites, mapEntryPath(fd, key))
+			}
+			return true
+		})
+		return true
+	})
+}
+
+// Starts a new conflict window for OWNER_WINS. Called after each fan-out.
+func (d *ChannelData) resetTickWrites() {
+	if d.conflictPolicy() != channeldpb.ChannelDataMergeOptions_OWNER_WINS {
+		return
+	}
+	for _, state := range d.fieldWrites {
+		state.tickWriters = nil
+		state.ownerWritten = false
+	}
+}
+
+func (ch *Channel) sendDataConflict(conn ConnectionInChannel, policy channeldpb.ChannelDataMergeOptions_ConflictPolicy, conflicts []*channeldpb.ChannelDataConflictMessage_FieldConflict) {
+	if conn.IsClosing() {
+		return
+	}
+	ch.Logger().Debug("channel data write lost the conflict",
+		zap.Uint32("connId", uint32(conn.Id())),
+		zap.String("policy", policy.String()),
+		zap.Int("fields", len(conflicts)),
+	)
+	conn.Send(MessageContext{
+		MsgType:   channeldpb.MessageType_CHANNEL_DATA_CONFLICT,
+		Msg:       &channeldpb.ChannelDataConflictMessage{Policy: policy, Conflicts: conflicts},
+		Channel:   ch,
+		ChannelId: uint32(ch.id),
+	})
+}
+
+func containsConnection(conns []ConnectionInChannel, conn ConnectionInChannel) bool {
+	for _, c := range conns {
+		if c == conn {
+			return true
+		}
+	}
+	return false
+}
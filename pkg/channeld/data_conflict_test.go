@@ -0,0 +1,135 @@
+package channeld
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/metaworking/channeld/internal/testpb"
+	"github.com/metaworking/channeld/pkg/channeldpb"
+	"github.com/metaworking/channeld/pkg/common"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+func TestDataConflictPolicies(t *testing.T) {
+	InitLogs()
+	InitChannels()
+	InitConnections("../../config/server_conn_fsm_test.json", "../../config/client_non_authoratative_fsm.json")
+
+	server := addTestConnection(channeldpb.ConnectionType_SERVER)
+	client1 := addTestConnection(channeldpb.ConnectionType_CLIENT)
+	client2 := addTestConnection(channeldpb.ConnectionType_CLIENT)
+
+	newChannel := func(policy channeldpb.ChannelDataMergeOptions_ConflictPolicy, data common.ChannelDataMessage) *Channel {
+		ch, _ := CreateChannel(channeldpb.ChannelType_TEST, server)
+		// Stop the channel from being ticked by the scheduler
+		scheduler.unschedule(ch)
+		ch.InitData(data, &channeldpb.ChannelDataMergeOptions{ConflictPolicy: policy})
+		for _, c := range []*Connection{client1, client2} {
+			c.SubscribeToChannel(ch, &channeldpb.ChannelSubscriptionOptions{
+				DataAccess: Pointer(channeldpb.ChannelDataAccess_WRITE_ACCESS),
+			})
+		}
+		return ch
+	}
+	updateData := func(ch *Channel, conn *Connection, data common.ChannelDataMessage, msg *channeldpb.ChannelDataUpdateMessage) {
+		msg.Data, _ = anypb.New(data)
+		handleChannelDataUpdate(MessageContext{
+			MsgType:    channeldpb.MessageType_CHANNEL_DATA_UPDATE,
+			Msg:        msg,
+			Connection: conn,
+			Channel:    ch,
+		})
+	}
+	latestConflict := func(conn *Connection) *channeldpb.ChannelDataConflictMessage {
+		msg, _ := conn.latestMsg().(*channeldpb.ChannelDataConflictMessage)
+		return msg
+	}
+	dataOf := func(ch *Channel) *testpb.TestChannelDataMessage {
+		return ch.Data().msg.(*testpb.TestChannelDataMessage)
+	}
+
+	/* OWNER_WINS */
+	ch := newChannel(channeldpb.ChannelDataMergeOptions_OWNER_WINS, &testpb.TestChannelDataMessage{})
+	updateData(ch, client1, &testpb.TestChannelDataMessage{Text: "client1", Num: 1}, &channeldpb.ChannelDataUpdateMessage{})
+	updateData(ch, server, &testpb.TestChannelDataMessage{Text: "server"}, &channeldpb.ChannelDataUpdateMessage{})
+	assert.Equal(t, "server", dataOf(ch).Text)
+	assert.EqualValues(t, 1, dataOf(ch).Num)
+	// The overwritten writer is notified
+	if conflict := latestConflict(client1); assert.NotNil(t, conflict) {
+		assert.Equal(t, "text", conflict.Conflicts[0].Field)
+		assert.EqualValues(t, server.Id(), conflict.Conflicts[0].WinnerConnId)
+	}
+	// The write after the owner's in the same tick loses
+	updateData(ch, client2, &testpb.TestChannelDataMessage{Text: "client2", Num: 2}, &channeldpb.ChannelDataUpdateMessage{})
+	assert.Equal(t, "server", dataOf(ch).Text)
+	assert.EqualValues(t, 2, dataOf(ch).Num)
+	assert.Len(t, latestConflict(client2).Conflicts, 1)
+	// A new tick
+	ch.tickData(ch.GetTime())
+	updateData(ch, client2, &testpb.TestChannelDataMessage{Text: "client2"}, &channeldpb.ChannelDataUpdateMessage{})
+	assert.Equal(t, "client2", dataOf(ch).Text)
+
+	/* LAST_WRITER_WINS */
+	ch = newChannel(channeldpb.ChannelDataMergeOptions_LAST_WRITER_WINS, &testpb.TestChannelDataMessage{})
+	updateData(ch, client1, &testpb.TestChannelDataMessage{Text: "client1"}, &channeldpb.ChannelDataUpdateMessage{Timestamp: 200})
+	updateData(ch, client2, &testpb.TestChannelDataMessage{Text: "client2", Num: 2}, &channeldpb.ChannelDataUpdateMessage{Timestamp: 100})
+	assert.Equal(t, "client1", dataOf(ch).Text)
+	assert.EqualValues(t, 2, dataOf(ch).Num)
+	if conflict := latestConflict(client2); assert.NotNil(t, conflict) {
+		assert.Equal(t, channeldpb.ChannelDataMergeOptions_LAST_WRITER_WINS, conflict.Policy)
+		assert.Equal(t, "text", conflict.Conflicts[0].Field)
+		assert.EqualValues(t, client1.Id(), conflict.Conflicts[0].WinnerConnId)
+	}
+	updateData(ch, client2, &testpb.TestChannelDataMessage{Text: "client2"}, &channeldpb.ChannelDataUpdateMessage{Timestamp: 300})
+	assert.Equal(t, "client2", dataOf(ch).Text)
+	// The timestamp far in the future is clamped to the server time, so the field can still be overwritten.
+	updateData(ch, client1, &testpb.TestChannelDataMessage{Text: "client1"}, &channeldpb.ChannelDataUpdateMessage{Timestamp: math.MaxInt64})
+	assert.Equal(t, "client1", dataOf(ch).Text)
+	updateData(ch, client2, &testpb.TestChannelDataMessage{Text: "client2"}, &channeldpb.ChannelDataUpdateMessage{
+		Timestamp: time.Now().UnixMilli() + MaxDataUpdateClockSkewMs,
+	})
+	assert.Equal(t, "client2", dataOf(ch).Text)
+
+	// The entries of a map field are checked separately.
+	ch = newChannel(channeldpb.ChannelDataMergeOptions_LAST_WRITER_WINS, &testpb.TestFieldMaskMessage{})
+	updateData(ch, client1, &testpb.TestFieldMaskMessage{Kv2: map[int64]string{1: "client1"}}, &channeldpb.ChannelDataUpdateMessage{Timestamp: 200})
+	updateData(ch, client2, &testpb.TestFieldMaskMessage{Kv2: map[int64]string{1: "client2", 2: "client2"}}, &channeldpb.ChannelDataUpdateMessage{Timestamp: 100})
+	assert.Equal(t, map[int64]string{1: "client1", 2: "client2"}, ch.Data().msg.(*testpb.TestFieldMaskMessage).Kv2)
+	if conflict := latestConflict(client2); assert.NotNil(t, conflict) {
+		assert.Len(t, conflict.Conflicts, 1)
+		assert.Equal(t, "kv2.1", conflict.Conflicts[0].Field)
+	}
+
+	// The write states of the removed map entries are dropped.
+	ch = newChannel(channeldpb.ChannelDataMergeOptions_LAST_WRITER_WINS, &testpb.TestMergeMessage{
+		Kv: map[int64]*testpb.TestMergeMessage_StringWrapper{},
+	})
+	ch.Data().mergeOptions.ShouldCheckRemovableMapField = true
+	updateData(ch, client1, &testpb.TestMergeMessage{Kv: map[int64]*testpb.TestMergeMessage_StringWrapper{
+		1: {Content: "a"},
+		2: {Content: "b"},
+	}}, &channeldpb.ChannelDataUpdateMessage{Timestamp: 200})
+	assert.Len(t, ch.Data().fieldWrites, 2)
+	updateData(ch, client1, &testpb.TestMergeMessage{Kv: map[int64]*testpb.TestMergeMessage_StringWrapper{
+		1: {Removed: true},
+	}}, &channeldpb.ChannelDataUpdateMessage{Timestamp: 300})
+	assert.NotContains(t, ch.Data().msg.(*testpb.TestMergeMessage).Kv, int64(1))
+	assert.NotContains(t, ch.Data().fieldWrites, "kv.1")
+	assert.Contains(t, ch.Data().fieldWrites, "kv.2")
+
+	/* VERSION_COUNTER */
+	ch = newChannel(channeldpb.ChannelDataMergeOptions_VERSION_COUNTER, &testpb.TestChannelDataMessage{})
+	updateData(ch, client1, &testpb.TestChannelDataMessage{Text: "client1"}, &channeldpb.ChannelDataUpdateMessage{})
+	// Based on the same version
+	updateData(ch, client2, &testpb.TestChannelDataMessage{Text: "client2"}, &channeldpb.ChannelDataUpdateMessage{})
+	assert.Equal(t, "client1", dataOf(ch).Text)
+	if conflict := latestConflict(client2); assert.NotNil(t, conflict) {
+		assert.EqualValues(t, 1, conflict.Conflicts[0].Version)
+	}
+	updateData(ch, client2, &testpb.TestChannelDataMessage{Text: "client2"}, &channeldpb.ChannelDataUpdateMessage{
+		FieldVersions: map[string]uint64{"text": 1},
+	})
+	assert.Equal(t, "client2", dataOf(ch).Text)
+}
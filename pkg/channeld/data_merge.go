@@ -0,0 +1,185 @@
+package channeld
+
+import (
+	"sync"
+
+	"github.com/metaworking/channeld/pkg/channeldpb"
+	"github.com/metaworking/channeld/pkg/common"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Use protoreflect to merge. No need to write custom merge code but less efficient.
+//
+// Follows the semantic of proto.Merge, except that the list and map fields at any depth are merged with their own options, which are
+// (from the lowest priority to the highest): the channel-wide options, the channeldpb.fieldMerge annotation of the field,
+// and ChannelDataMergeOptions.FieldOptions of the field path.
+func ReflectMerge(dst common.ChannelDataMessage, src common.ChannelDataMessage, options *channeldpb.ChannelDataMergeOptions) {
+	if !needsFieldMergeOptions(options) && !hasFieldMergeAnnotations(dst.ProtoReflect().Descriptor()) {
+		proto.Merge(dst, src)
+		return
+	}
+	reflectMergeMessage(dst.ProtoReflect(), src.ProtoReflect(), "", options)
+}
+
+func needsFieldMergeOptions(options *channeldpb.ChannelDataMergeOptions) bool {
+	return options != nil && (options.ShouldReplaceList || options.ListSizeLimit > 0 ||
+		options.ShouldCheckRemovableMapField || len(options.FieldOptions) > 0)
+}
+
+// Caches whether a message type has any field annotated with channeldpb.fieldMerge, at any depth.
+var fieldMergeAnnotatedMessages sync.Map
+
+func hasFieldMergeAnnotations(md protoreflect.MessageDescriptor) bool {
+	if annotated, exists := fieldMergeAnnotatedMessages.Load(md.FullName()); exists {
+		return annotated.(bool)
+	}
+	annotated := searchFieldMergeAnnotations(md, make(map[protoreflect.FullName]bool))
+	fieldMergeAnnotatedMessages.Store(md.FullName(), annotated)
+	return annotated
+}
+
+// Searches the message types reachable from md for the annotated fields. The visited types are skipped to break the recursion of
+// the (mutually) self-referencing types, so the result is only final for md itself, and the types in the middle are not cached.
+func searchFieldMergeAnnotations(md protoreflect.MessageDescriptor, visited map[protoreflect.FullName]bool) bool {
+	if annotated, exists := fieldMergeAnnotatedMessages.Load(md.FullName()); exists {
+		return annotated.(bool)
+	}
+	if visited[md.FullName()] {
+		return false
+	}
+	visited[md.FullName()] = true
+
+	fields := md.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if fieldMergeAnnotation(fd) != nil {
+			return true
+		}
+		if fd.IsMap() && fd.MapValue().Message() != nil {
+			if searchFieldMergeAnnotations(fd.MapValue().Message(), visited) {
+				return true
+			}
+		} else if !fd.IsMap() && fd.Message() != nil {
+			if searchFieldMergeAnnotations(fd.Message(), visited) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func fieldMergeAnnotation(fd protoreflect.FieldDescriptor) *channeldpb.FieldMergeOptions {
+	opts, _ := proto.GetExtension(fd.Options(), channeldpb.E_FieldMerge).(*channeldpb.FieldMergeOptions)
+	return opts
+}
+
+// The merge options that apply to the field at the path. See ReflectMerge.
+func fieldMergeOptionsOf(fd protoreflect.FieldDescriptor, path string, options *channeldpb.ChannelDataMergeOptions) *channeldpb.FieldMergeOptions {
+	if options != nil {
+		if opts, exists := options.FieldOptions[path]; exists && opts != nil {
+			return opts
+		}
+	}
+	if opts := fieldMergeAnnotation(fd); opts != nil {
+		return opts
+	}
+	if options == nil {
+		return &channeldpb.FieldMergeOptions{}
+	}
+	return &channeldpb.FieldMergeOptions{
+		ReplaceList:   options.ShouldReplaceList,
+		ListSizeLimit: options.ListSizeLimit,
+		TruncateTop:   options.TruncateTop,
+		Removable:     options.ShouldCheckRemovableMapField,
+	}
+}
+
+func reflectMergeMessage(dst protoreflect.Message, src protoreflect.Message, prefix string, options *channeldpb.ChannelDataMergeOptions) {
+	src.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		path := prefix + string(fd.Name())
+		switch {
+		case fd.IsList():
+			reflectMergeList(dst.Mutable(fd).List(), v.List(), fd, path, fieldMergeOptionsOf(fd, path, options), options)
+		case fd.IsMap():
+			reflectMergeMap(dst.Mutable(fd).Map(), v.Map(), fd, path, fieldMergeOptionsOf(fd, path, options), options)
+		case fd.Message() != nil:
+			reflectMergeMessage(dst.Mutable(fd).Message(), v.Message(), path+".", options)
+		case fd.Kind() == protoreflect.BytesKind:
+			dst.Set(fd, protoreflect.ValueOfBytes(append([]byte{}, v.Bytes()...)))
+		default:
+			dst.Set(fd, v)
+		}
+		return true
+	})
+
+	// An empty list can't be told apart from an unset one, so the list to replace is cleared even if it's not in src.
+	fields := dst.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if fd.IsList() && !src.Has(fd) && dst.Has(fd) {
+			if fieldMergeOptionsOf(fd, prefix+string(fd.Name()), options).ReplaceList {
+				dst.Clear(fd)
+			}
+		}
+	}
+
+	if len(src.GetUnknown()) > 0 {
+		dst.SetUnknown(append(dst.GetUnknown(), src.GetUnknown()...))
+	}
+}
+
+func reflectMergeList(dst protoreflect.List, src protoreflect.List, fd protoreflect.FieldDescriptor, path string,
+	fieldOptions *channeldpb.FieldMergeOptions, options *channeldpb.ChannelDataMergeOptions) {
+	if fieldOptions.ReplaceList {
+		dst.Truncate(0)
+	}
+
+	for i := 0; i < src.Len(); i++ {
+		v := src.Get(i)
+		switch {
+		case fd.Message() != nil:
+			element := dst.NewElement()
+			reflectMergeMessage(element.Message(), v.Message(), path+".", options)
+			dst.Append(element)
+		case fd.Kind() == protoreflect.BytesKind:
+			dst.Append(protoreflect.ValueOfBytes(append([]byte{}, v.Bytes()...)))
+		default:
+			dst.Append(v)
+		}
+	}
+
+	limit := int(fieldOptions.ListSizeLimit)
+	if offset := dst.Len() - limit; limit > 0 && offset > 0 {
+		if fieldOptions.TruncateTop {
+			for i := 0; i < limit; i++ {
+				dst.Set(i, dst.Get(i+offset))
+			}
+		}
+		dst.Truncate(limit)
+	}
+}
+
+func reflectMergeMap(dst protoreflect.Map, src protoreflect.Map, fd protoreflect.FieldDescriptor, path string,
+	fieldOptions *channeldpb.FieldMergeOptions, options *channeldpb.ChannelDataMergeOptions) {
+	src.Range(func(k protoreflect.MapKey, v protoreflect.Value) bool {
+		switch {
+		case fd.MapValue().Message() != nil:
+			if fieldOptions.Removable {
+				if removable, ok := v.Message().Interface().(RemovableMapField); ok && removable.GetRemoved() {
+					dst.Clear(k)
+					return true
+				}
+			}
+			// Same as proto.Merge, the message value is replaced rather than merged.
+			value := dst.NewValue()
+			reflectMergeMessage(value.Message(), v.Message(), path+".", options)
+			dst.Set(k, value)
+		case fd.MapValue().Kind() == protoreflect.BytesKind:
+			dst.Set(k, protoreflect.ValueOfBytes(append([]byte{}, v.Bytes()...)))
+		default:
+			dst.Set(k, v)
+		}
+		return true
+	})
+}
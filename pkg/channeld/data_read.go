@@ -0,0 +1,131 @@
+package channeld
+
+import (
+	"errors"
+	"time"
+
+	"github.com/indiest/fmutils"
+	"github.com/metaworking/channeld/pkg/channeldpb"
+	"github.com/metaworking/channeld/pkg/common"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+const DefaultGetChannelDataTimeoutMs = 1000
+
+var ErrGetChannelDataTimeout = errors.New("timed out reading the channel data")
+
+type channelDataReadResult struct {
+	// The index in GetChannelDataMessage.ChannelIds
+	index int
+	data  *anypb.Any
+	err   error
+}
+
+func handleGetChannelData(ctx MessageContext) {
+	if ctx.Channel != globalChannel {
+		ctx.Connection.Logger().Error("illegal attemp to get channel data outside the GLOBAL channel")
+		return
+	}
+
+	msg, ok := ctx.Msg.(*channeldpb.GetChannelDataMessage)
+	if !ok {
+		ctx.Connection.Logger().Error("message is not a GetChannelDataMessage, will not be handled.")
+		return
+	}
+
+	if len(msg.ChannelIds) == 0 {
+		ctx.SendErrorResult(channeldpb.ErrorResultMessage_INVALID_MESSAGE, errors.New("no channel to get the data from"))
+		return
+	}
+
+	timeout := GlobalSettings.multiChannelTimeout(msg.TimeoutMs, DefaultGetChannelDataTimeoutMs)
+
+	// Don't block the GLOBAL channel while waiting for the other channels.
+	go func() {
+		ctx.Msg = readChannelData(ctx.Connection, msg.ChannelIds, msg.DataFieldMasks, timeout)
+		ctx.Connection.Send(ctx)
+	}()
+}
+
+// Reads the data of the channels in their own goroutines, and waits for all of them until the timeout.
+func readChannelData(conn ConnectionInChannel, channelIds []uint32, dataFieldMasks []string, timeout time.Duration) *channeldpb.GetChannelDataResultMessage {
+	results := make([]*channeldpb.GetChannelDataResultMessage_ChannelDataResult, len(channelIds))
+	// Buffered with the number of the channels, so the channels never block after the timeout.
+	readResults := make(chan channelDataReadResult, len(channelIds))
+	pending := 0
+	for i, chId := range channelIds {
+		results[i] = &channeldpb.GetChannelDataResultMessage_ChannelDataResult{ChannelId: chId}
+		ch := GetChannel(common.ChannelId(chId))
+		if ch == nil || ch.IsRemoving() {
+			results[i].Error = "channel doesn't exist"
+			continue
+		}
+		index := i
+		if !ch.tryExecute(func(ch *Channel) {
+			data, err := ch.readData(conn, dataFieldMasks)
+			readResults <- channelDataReadResult{index: index, data: data, err: err}
+		}) {
+			results[i].Error = ErrChannelUnavailable.Error()
+			continue
+		}
+		pending++
+	}
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	for pending > 0 {
+		select {
+		case r := <-readResults:
+			pending--
+			if r.err != nil {
+				results[r.index].Error = r.err.Error()
+			} else {
+				results[r.index].Data = r.data
+			}
+		case <-timer.C:
+			for _, result := range results {
+				if result.Data == nil && result.Error == "" {
+					result.Error = ErrGetChannelDataTimeout.Error()
+				}
+			}
+			pending = 0
+		}
+	}
+
+	return &channeldpb.GetChannelDataResultMessage{Results: results}
+}
+
+// Returns the filtered copy of the channel data. Should be called in the channel's goroutine.
+func (ch *Channel) readData(conn ConnectionInChannel, dataFieldMasks []string) (*anypb.Any, error) {
+	if !ch.hasDataReadAccess(conn) {
+		if hasAccess, err := ch.CheckACL(conn, ChannelAccessType_GetData); !hasAccess {
+			conn.Logger().Warn("connection doesn't have access to get the channel data",
+				zap.String("channelType", ch.channelType.String()),
+				zap.Uint32("channelId", uint32(ch.id)),
+				zap.Error(err),
+			)
+			return nil, err
+		}
+	}
+
+	if ch.Data() == nil || ch.Data().msg == nil {
+		return nil, errors.New("channel data is not initialized")
+	}
+
+	dataMsg := proto.Clone(ch.Data().msg)
+	fmutils.Filter(dataMsg, dataFieldMasks)
+	return anypb.New(dataMsg)
+}
+
+// The owner and the subscribers with the read (or write) access can read the channel data without checking the ACL.
+func (ch *Channel) hasDataReadAccess(conn ConnectionInChannel) bool {
+	if ch.ownerConnection == conn {
+		return true
+	}
+	ch.connectionsLock.RLock()
+	defer ch.connectionsLock.RUnlock()
+	cs := ch.subscribedConnections[conn]
+	return cs != nil && cs.options.GetDataAccess() != channeldpb.ChannelDataAccess_NO_ACCESS
+}
@@ -0,0 +1,107 @@
+package channeld
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/metaworking/channeld/internal/testpb"
+	"github.com/metaworking/channeld/pkg/channeldpb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetChannelData(t *testing.T) {
+	InitLogs()
+	InitChannels()
+	InitConnections("../../config/server_conn_fsm_test.json", "../../config/client_non_authoratative_fsm.json")
+
+	settings := GlobalSettings.GetChannelSettings(channeldpb.ChannelType_SUBWORLD)
+	settings.ACLSettings.GetData = ChannelAccessLevel_OwnerAndGlobalOwner
+	GlobalSettings.ChannelSettings[channeldpb.ChannelType_SUBWORLD] = settings
+	defer delete(GlobalSettings.ChannelSettings, channeldpb.ChannelType_SUBWORLD)
+
+	server := addTestConnection(channeldpb.ConnectionType_SERVER)
+	client := addTestConnection(channeldpb.ConnectionType_CLIENT)
+	ch1, _ := CreateChannel(channeldpb.ChannelType_SUBWORLD, server)
+	ch2, _ := CreateChannel(channeldpb.ChannelType_SUBWORLD, server)
+	ch3, _ := CreateChannel(channeldpb.ChannelType_SUBWORLD, nil)
+	channels := []*Channel{ch1, ch2, ch3}
+	for i, ch := range channels {
+		ch.InitData(&testpb.TestChannelDataMessage{Text: "a", Num: uint32(i + 1)}, nil)
+		// Drop the channel from the scheduler, so the test can tick it manually.
+		scheduler.unschedule(ch)
+	}
+	client.SubscribeToChannel(ch2, nil)
+
+	// Reads the data while ticking the channels in the test goroutine.
+	readData := func(conn *Connection, channelIds []uint32, dataFieldMasks []string, timeout time.Duration, tickedChannels ...*Channel) *channeldpb.GetChannelDataResultMessage {
+		resultChan := make(chan *channeldpb.GetChannelDataResultMessage)
+		go func() {
+			resultChan <- readChannelData(conn, channelIds, dataFieldMasks, timeout)
+		}()
+		for {
+			select {
+			case result := <-resultChan:
+				return result
+			default:
+				for _, ch := range tickedChannels {
+					ch.tickMessages(time.Now())
+				}
+				time.Sleep(time.Millisecond)
+			}
+		}
+	}
+	numOf := func(result *channeldpb.GetChannelDataResultMessage_ChannelDataResult) uint32 {
+		msg, err := result.Data.UnmarshalNew()
+		assert.NoError(t, err)
+		return msg.(*testpb.TestChannelDataMessage).Num
+	}
+
+	// The owner reads all the fields. The unknown channel fails alone.
+	result := readData(server, []uint32{uint32(ch2.id), 12345, uint32(ch1.id)}, nil, time.Second, ch1, ch2)
+	assert.Equal(t, 3, len(result.Results))
+	assert.EqualValues(t, ch2.id, result.Results[0].ChannelId)
+	assert.Empty(t, result.Results[0].Error)
+	assert.EqualValues(t, 2, numOf(result.Results[0]))
+	assert.EqualValues(t, 12345, result.Results[1].ChannelId)
+	assert.Nil(t, result.Results[1].Data)
+	assert.NotEmpty(t, result.Results[1].Error)
+	assert.EqualValues(t, 1, numOf(result.Results[2]))
+
+	// The field masks
+	result = readData(server, []uint32{uint32(ch1.id)}, []string{"text"}, time.Second, ch1)
+	msg, _ := result.Results[0].Data.UnmarshalNew()
+	assert.Equal(t, "a", msg.(*testpb.TestChannelDataMessage).Text)
+	assert.EqualValues(t, 0, msg.(*testpb.TestChannelDataMessage).Num)
+
+	// The client can only read the subscribed channel.
+	result = readData(client, []uint32{uint32(ch1.id), uint32(ch2.id)}, nil, time.Second, ch1, ch2)
+	assert.Nil(t, result.Results[0].Data)
+	assert.Equal(t, ErrOwnerAndGlobalOwnerAccess.Error(), result.Results[0].Error)
+	assert.EqualValues(t, 2, numOf(result.Results[1]))
+
+	// ch3 is not ticked in time
+	result = readData(server, []uint32{uint32(ch1.id), uint32(ch3.id)}, nil, 50*time.Millisecond, ch1)
+	assert.EqualValues(t, 1, numOf(result.Results[0]))
+	assert.Equal(t, ErrGetChannelDataTimeout.Error(), result.Results[1].Error)
+	// The late read doesn't block the channel.
+	ch3.tickMessages(time.Now())
+
+	// The timeout in the message is capped, so the connections can't pile up the waiting goroutines.
+	assert.Equal(t, DefaultGetChannelDataTimeoutMs*time.Millisecond, GlobalSettings.multiChannelTimeout(0, DefaultGetChannelDataTimeoutMs))
+	assert.Equal(t, time.Duration(GlobalSettings.MaxMultiChannelTimeoutMs)*time.Millisecond,
+		GlobalSettings.multiChannelTimeout(math.MaxUint32, DefaultGetChannelDataTimeoutMs))
+
+	// No channel to read
+	handleGetChannelData(MessageContext{
+		MsgType:    channeldpb.MessageType_GET_CHANNEL_DATA,
+		Msg:        &channeldpb.GetChannelDataMessage{},
+		Connection: server,
+		Channel:    globalChannel,
+	})
+	assert.Equal(t, channeldpb.ErrorResultMessage_INVALID_MESSAGE, server.latestMsg().(*channeldpb.ErrorResultMessage).ErrorCode)
+
+	for _, ch := range channels {
+		RemoveChannel(ch)
+	}
+}
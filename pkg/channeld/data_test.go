@@ -6,6 +6,7 @@ import (
 	"math/rand"
 	"net"
 	"strconv"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -105,8 +106,8 @@ func TestFanOutChannelData(t *testing.T) {
 	c2 := addTestConnectionWithProcessor(channeldpb.ConnectionType_CLIENT, testChannelDataMessageProcessor)
 
 	testChannel, _ := CreateChannel(channeldpb.ChannelType_TEST, c0)
-	// Stop the channel.Tick() goroutine
-	testChannel.removing = 1
+	// Stop the channel from being ticked by the scheduler
+	scheduler.unschedule(testChannel)
 	dataMsg := &testpb.TestChannelDataMessage{
 		Text: "a",
 		Num:  1,
@@ -453,3 +454,439 @@ func TestProtobufMapMerge(t *testing.T) {
 	*/
 	assert.Equal(t, "", testMsg.Kv2[2].Content)
 }
+
+func TestDeltaFanOut(t *testing.T) {
+	InitLogs()
+	InitChannels()
+	InitConnections("../../config/server_conn_fsm_test.json", "../../config/client_non_authoratative_fsm.json")
+
+	c0 := addTestConnection(channeldpb.ConnectionType_SERVER)
+	c1 := addTestConnection(channeldpb.ConnectionType_CLIENT)
+
+	testChannel, _ := CreateChannel(channeldpb.ChannelType_TEST, c0)
+	// Stop the channel from being ticked by the scheduler
+	scheduler.unschedule(testChannel)
+	testChannel.InitData(&testpb.TestChannelDataMessage{Text: "a", Num: 1}, nil)
+
+	c0.SubscribeToChannel(testChannel, nil)
+	c1.SubscribeToChannel(testChannel, &channeldpb.ChannelSubscriptionOptions{
+		FanOutIntervalMs: proto.Uint32(50),
+		DeltaFanOut:      proto.Bool(true),
+	})
+
+	latestUpdate := func() (*channeldpb.ChannelDataUpdateMessage, *testpb.TestChannelDataMessage) {
+		updateMsg := c1.latestMsg().(*channeldpb.ChannelDataUpdateMessage)
+		dataMsg, err := updateMsg.Data.UnmarshalNew()
+		assert.NoError(t, err)
+		return updateMsg, dataMsg.(*testpb.TestChannelDataMessage)
+	}
+	ack := func(msgIndex uint64) {
+		handleChannelDataAck(MessageContext{
+			MsgType:    channeldpb.MessageType_CHANNEL_DATA_ACK,
+			Msg:        &channeldpb.ChannelDataAckMessage{MsgIndex: msgIndex},
+			Connection: c1,
+			Channel:    testChannel,
+		})
+	}
+
+	channelStartTime := ChannelTime(100 * int64(time.Millisecond))
+	// The full states for the first time
+	testChannel.tickData(channelStartTime)
+	assert.Equal(t, 1, len(c1.testQueue()))
+	updateMsg, dataMsg := latestUpdate()
+	assert.True(t, updateMsg.FullState)
+	assert.EqualValues(t, 0, updateMsg.MsgIndex)
+	assert.Equal(t, "a", dataMsg.Text)
+
+	testChannel.Data().OnUpdate(&testpb.TestChannelDataMessage{Text: "b"}, channelStartTime.AddMs(10), c0.Id(), nil)
+	testChannel.tickData(channelStartTime.AddMs(50))
+	assert.Equal(t, 2, len(c1.testQueue()))
+	updateMsg, dataMsg = latestUpdate()
+	assert.False(t, updateMsg.FullState)
+	assert.EqualValues(t, 1, updateMsg.MsgIndex)
+	assert.Equal(t, "b", dataMsg.Text)
+
+	// Not acknowledged - the delta still contains the updates since the beginning
+	testChannel.Data().OnUpdate(&testpb.TestChannelDataMessage{Num: 2}, channelStartTime.AddMs(60), c0.Id(), nil)
+	testChannel.tickData(channelStartTime.AddMs(100))
+	assert.Equal(t, 3, len(c1.testQueue()))
+	updateMsg, dataMsg = latestUpdate()
+	assert.EqualValues(t, 2, updateMsg.MsgIndex)
+	assert.Equal(t, "b", dataMsg.Text)
+	assert.EqualValues(t, 2, dataMsg.Num)
+
+	// Can't acknowledge the unsent update
+	ack(3)
+	assert.Zero(t, c1.fanOutConnectionOf(testChannel).ackedMessageIndex)
+
+	// Acknowledged - the delta only contains the updates since the baseline
+	ack(2)
+	testChannel.Data().OnUpdate(&testpb.TestChannelDataMessage{Text: "c"}, channelStartTime.AddMs(110), c0.Id(), nil)
+	testChannel.tickData(channelStartTime.AddMs(150))
+	assert.Equal(t, 4, len(c1.testQueue()))
+	updateMsg, dataMsg = latestUpdate()
+	assert.EqualValues(t, 3, updateMsg.MsgIndex)
+	assert.Equal(t, "c", dataMsg.Text)
+	assert.EqualValues(t, 0, dataMsg.Num)
+
+	// No new update - no fan-out
+	testChannel.tickData(channelStartTime.AddMs(200))
+	assert.Equal(t, 4, len(c1.testQueue()))
+
+	// The baseline is removed from the buffer - fall back to the full states
+	updateTime := channelStartTime.AddMs(200)
+	for i := 0; i < MaxUpdateMsgBufferSize+10; i++ {
+		updateTime = updateTime.AddMs(100)
+		testChannel.Data().OnUpdate(&testpb.TestChannelDataMessage{Num: uint32(i)}, updateTime, c0.Id(), nil)
+	}
+	testChannel.tickData(updateTime)
+	assert.Equal(t, 5, len(c1.testQueue()))
+	updateMsg, dataMsg = latestUpdate()
+	assert.True(t, updateMsg.FullState)
+	assert.Equal(t, "c", dataMsg.Text)
+	assert.EqualValues(t, MaxUpdateMsgBufferSize+9, dataMsg.Num)
+
+	// The subscriber without delta fan-out doesn't get the index
+	for _, msg := range c0.testQueue() {
+		if updateMsg, ok := msg.(*channeldpb.ChannelDataUpdateMessage); ok {
+			assert.Zero(t, updateMsg.MsgIndex)
+		}
+	}
+
+	RemoveChannel(testChannel)
+}
+
+func (c *Connection) fanOutConnectionOf(ch *Channel) *fanOutConnection {
+	return ch.subscribedConnections[c].fanOutElement.Value.(*fanOutConnection)
+}
+
+func TestSharedFanOutEncoding(t *testing.T) {
+	InitLogs()
+	InitChannels()
+	InitConnections("../../config/server_conn_fsm_test.json", "../../config/client_non_authoratative_fsm.json")
+
+	c0 := addTestConnection(channeldpb.ConnectionType_SERVER)
+	testChannel, _ := CreateChannel(channeldpb.ChannelType_TEST, c0)
+	// Stop the channel from being ticked by the scheduler
+	scheduler.unschedule(testChannel)
+	testChannel.InitData(&testpb.TestChannelDataMessage{Text: "a", Num: 1}, nil)
+
+	subscribe := func(connType channeldpb.ConnectionType, masks ...string) *Connection {
+		c := addTestConnection(connType)
+		c.SubscribeToChannel(testChannel, &channeldpb.ChannelSubscriptionOptions{
+			DataFieldMasks:   masks,
+			FanOutIntervalMs: proto.Uint32(50),
+		})
+		return c
+	}
+	c1 := subscribe(channeldpb.ConnectionType_CLIENT, "text", "num")
+	c2 := subscribe(channeldpb.ConnectionType_CLIENT, "num", "text")
+	c3 := subscribe(channeldpb.ConnectionType_CLIENT, "text")
+	// Also updates the data
+	c4 := subscribe(channeldpb.ConnectionType_SERVER, "text", "num")
+
+	channelStartTime := ChannelTime(100 * int64(time.Millisecond))
+	testChannel.tickData(channelStartTime)
+	// The full states are encoded once for the same mask set
+	assert.Same(t, c1.latestMsg(), c2.latestMsg())
+	assert.Same(t, c1.latestMsg(), c4.latestMsg())
+	assert.NotSame(t, c1.latestMsg(), c3.latestMsg())
+	// The channel data is not filtered
+	assert.EqualValues(t, 1, testChannel.Data().msg.(*testpb.TestChannelDataMessage).Num)
+
+	testChannel.Data().OnUpdate(&testpb.TestChannelDataMessage{Text: "b", Num: 2}, channelStartTime.AddMs(10), c0.Id(), nil)
+	testChannel.tickData(channelStartTime.AddMs(50))
+	assert.Same(t, c1.latestMsg(), c2.latestMsg())
+	assert.Same(t, c1.latestMsg(), c4.latestMsg())
+	assert.NotSame(t, c1.latestMsg(), c3.latestMsg())
+	dataMsg, _ := c3.latestMsg().(*channeldpb.ChannelDataUpdateMessage).Data.UnmarshalNew()
+	assert.Equal(t, "b", dataMsg.(*testpb.TestChannelDataMessage).Text)
+	assert.EqualValues(t, 0, dataMsg.(*testpb.TestChannelDataMessage).Num)
+
+	// The update of c4 is skipped in its fan-out, so the content is not shared
+	testChannel.Data().OnUpdate(&testpb.TestChannelDataMessage{Text: "c"}, channelStartTime.AddMs(60), c0.Id(), nil)
+	testChannel.Data().OnUpdate(&testpb.TestChannelDataMessage{Num: 3}, channelStartTime.AddMs(70), c4.Id(), nil)
+	testChannel.tickData(channelStartTime.AddMs(100))
+	assert.Same(t, c1.latestMsg(), c2.latestMsg())
+	assert.NotSame(t, c1.latestMsg(), c4.latestMsg())
+	dataMsg, _ = c4.latestMsg().(*channeldpb.ChannelDataUpdateMessage).Data.UnmarshalNew()
+	assert.Equal(t, "c", dataMsg.(*testpb.TestChannelDataMessage).Text)
+	assert.EqualValues(t, 0, dataMsg.(*testpb.TestChannelDataMessage).Num)
+
+	RemoveChannel(testChannel)
+}
+
+type testDiscardMessageSender struct {
+	MessageSender
+}
+
+func (s *testDiscardMessageSender) Send(c *Connection, ctx MessageContext) {}
+
+func benchmarkFanOut(b *testing.B, maskGroups [][]string, fullState bool) {
+	InitLogs()
+	InitChannels()
+	InitConnections("../../config/server_conn_fsm_test.json", "../../config/client_non_authoratative_fsm.json")
+
+	testChannel, _ := CreateChannel(channeldpb.ChannelType_TEST, nil)
+	// Stop the channel from being ticked by the scheduler
+	scheduler.unschedule(testChannel)
+	testChannel.InitData(&testpb.TestChannelDataMessage{Text: "a", Num: 1}, nil)
+
+	const subscriberNum = 1000
+	for i := 0; i < subscriberNum; i++ {
+		conn1, _ := net.Pipe()
+		c := AddConnection(conn1, channeldpb.ConnectionType_CLIENT)
+		c.sender = &testDiscardMessageSender{}
+		c.SubscribeToChannel(testChannel, &channeldpb.ChannelSubscriptionOptions{
+			DataFieldMasks:   maskGroups[i%len(maskGroups)],
+			FanOutIntervalMs: proto.Uint32(50),
+		})
+	}
+
+	t := ChannelTime(100 * int64(time.Millisecond))
+	testChannel.tickData(t)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if fullState {
+			for e := testChannel.fanOutQueue.Front(); e != nil; e = e.Next() {
+				e.Value.(*fanOutConnection).hadFirstFanOut = false
+			}
+		} else {
+			testChannel.Data().OnUpdate(&testpb.TestChannelDataMessage{Text: strconv.Itoa(i), Num: uint32(i)}, t.AddMs(10), 0, nil)
+		}
+		t = t.AddMs(50)
+		testChannel.tickData(t)
+	}
+	b.StopTimer()
+
+	RemoveChannel(testChannel)
+}
+
+func BenchmarkFanOut1KSubscribers(b *testing.B) {
+	b.Run("Update/OneMaskGroup", func(b *testing.B) {
+		benchmarkFanOut(b, [][]string{nil}, false)
+	})
+	b.Run("Update/FourMaskGroups", func(b *testing.B) {
+		benchmarkFanOut(b, [][]string{nil, {"text"}, {"num"}, {"text", "num"}}, false)
+	})
+	b.Run("FullState/OneMaskGroup", func(b *testing.B) {
+		benchmarkFanOut(b, [][]string{nil}, true)
+	})
+}
+
+func TestFanOutTiers(t *testing.T) {
+	InitLogs()
+	InitChannels()
+	InitConnections("../../config/server_conn_fsm_test.json", "../../config/client_non_authoratative_fsm.json")
+
+	c0 := addTestConnection(channeldpb.ConnectionType_SERVER)
+	c1 := addTestConnection(channeldpb.ConnectionType_CLIENT)
+
+	testChannel, _ := CreateChannel(channeldpb.ChannelType_TEST, c0)
+	// Stop the channel from being ticked by the scheduler
+	scheduler.unschedule(testChannel)
+	testChannel.InitData(&testpb.TestChannelDataMessage{Text: "a", Num: 1}, nil)
+
+	c0.SubscribeToChannel(testChannel, nil)
+	cs, _ := c1.SubscribeToChannel(testChannel, &channeldpb.ChannelSubscriptionOptions{
+		FanOutTiers: []*channeldpb.FanOutTier{
+			{DataFieldMasks: []string{"text"}, FanOutIntervalMs: 20},
+			{DataFieldMasks: []string{"num"}, FanOutIntervalMs: 100},
+		},
+	})
+	assert.EqualValues(t, 20, cs.minFanOutIntervalMs())
+	assert.EqualValues(t, 100, testChannel.data.maxFanOutIntervalMs)
+
+	latestData := func() *testpb.TestChannelDataMessage {
+		updateMsg := c1.latestMsg().(*channeldpb.ChannelDataUpdateMessage)
+		dataMsg, err := updateMsg.Data.UnmarshalNew()
+		assert.NoError(t, err)
+		return dataMsg.(*testpb.TestChannelDataMessage)
+	}
+
+	channelStartTime := ChannelTime(100 * int64(time.Millisecond))
+	// The full states for the first time
+	testChannel.tickData(channelStartTime)
+	assert.Equal(t, 1, len(c1.testQueue()))
+	assert.Equal(t, "a", latestData().Text)
+	assert.EqualValues(t, 1, latestData().Num)
+
+	testChannel.Data().OnUpdate(&testpb.TestChannelDataMessage{Text: "b", Num: 2}, channelStartTime.AddMs(10), c0.Id(), nil)
+	// Only the fast tier is due
+	testChannel.tickData(channelStartTime.AddMs(20))
+	assert.Equal(t, 2, len(c1.testQueue()))
+	assert.Equal(t, "b", latestData().Text)
+	assert.EqualValues(t, 0, latestData().Num)
+	// The slow tier is still pending
+	assert.EqualValues(t, 1, atomic.LoadInt32(&testChannel.fanOutPending))
+
+	testChannel.tickData(channelStartTime.AddMs(40))
+	assert.Equal(t, 2, len(c1.testQueue()))
+
+	// The slow tier is due
+	testChannel.tickData(channelStartTime.AddMs(100))
+	assert.Equal(t, 3, len(c1.testQueue()))
+	assert.Equal(t, "", latestData().Text)
+	assert.EqualValues(t, 2, latestData().Num)
+	assert.EqualValues(t, 0, atomic.LoadInt32(&testChannel.fanOutPending))
+
+	// The fast tier doesn't send the update without its fields
+	testChannel.Data().OnUpdate(&testpb.TestChannelDataMessage{Num: 3}, channelStartTime.AddMs(110), c0.Id(), nil)
+	testChannel.tickData(channelStartTime.AddMs(120))
+	assert.Equal(t, 3, len(c1.testQueue()))
+	testChannel.tickData(channelStartTime.AddMs(200))
+	assert.Equal(t, 4, len(c1.testQueue()))
+	assert.EqualValues(t, 3, latestData().Num)
+}
+
+func TestFanOutTiersBufferOverflow(t *testing.T) {
+	InitLogs()
+	InitChannels()
+	InitConnections("../../config/server_conn_fsm_test.json", "../../config/client_non_authoratative_fsm.json")
+
+	c0 := addTestConnection(channeldpb.ConnectionType_SERVER)
+	c1 := addTestConnection(channeldpb.ConnectionType_CLIENT)
+
+	testChannel, _ := CreateChannel(channeldpb.ChannelType_TEST, c0)
+	// Stop the channel from being ticked by the scheduler
+	scheduler.unschedule(testChannel)
+	testChannel.InitData(&testpb.TestChannelDataMessage{Text: "a", Num: 1}, nil)
+
+	c0.SubscribeToChannel(testChannel, nil)
+	c1.SubscribeToChannel(testChannel, &channeldpb.ChannelSubscriptionOptions{
+		FanOutTiers: []*channeldpb.FanOutTier{
+			{DataFieldMasks: []string{"text"}, FanOutIntervalMs: 20},
+			{DataFieldMasks: []string{"num"}, FanOutIntervalMs: 100},
+		},
+	})
+
+	latestData := func() *testpb.TestChannelDataMessage {
+		updateMsg := c1.latestMsg().(*channeldpb.ChannelDataUpdateMessage)
+		dataMsg, err := updateMsg.Data.UnmarshalNew()
+		assert.NoError(t, err)
+		return dataMsg.(*testpb.TestChannelDataMessage)
+	}
+
+	channelStartTime := ChannelTime(100 * int64(time.Millisecond))
+	testChannel.tickData(channelStartTime)
+	assert.Equal(t, 1, len(c1.testQueue()))
+
+	// The only update of the slow tier is followed by the updates of the fast tier.
+	testChannel.Data().OnUpdate(&testpb.TestChannelDataMessage{Num: 2}, channelStartTime.AddMs(10), c0.Id(), nil)
+	const updateCount = MaxUpdateMsgBufferSize + 100
+	for i := 1; i < updateCount; i++ {
+		arrivalTime := channelStartTime.AddMs(10)
+		if i >= updateCount/2 {
+			arrivalTime = channelStartTime.AddMs(115)
+		}
+		if i == updateCount/2 {
+			testChannel.tickData(channelStartTime.AddMs(20))
+			assert.Equal(t, 2, len(c1.testQueue()))
+			assert.Equal(t, "b", latestData().Text)
+		}
+		testChannel.Data().OnUpdate(&testpb.TestChannelDataMessage{Text: "b"}, arrivalTime, c0.Id(), nil)
+	}
+	// The update of the slow tier has been removed from the buffer.
+	assert.EqualValues(t, MaxUpdateMsgBufferSize, testChannel.data.updateMsgBuffer.Len())
+
+	// The fast tier fans out the buffered updates, and the slow tier fans out its full states.
+	testChannel.tickData(channelStartTime.AddMs(120))
+	assert.Equal(t, 4, len(c1.testQueue()))
+	assert.Equal(t, "", latestData().Text)
+	assert.EqualValues(t, 2, latestData().Num)
+	assert.EqualValues(t, 0, atomic.LoadInt32(&testChannel.fanOutPending))
+}
+
+func TestReflectMergeFieldOptions(t *testing.T) {
+	dst := &testpb.TestFieldMergeMessage{
+		Logs: []string{"a", "b"},
+		Rooms: map[uint32]*testpb.TestFieldMergeMessage_Room{
+			1: {ChatMessages: []string{"hi"}},
+		},
+		Lobby: &testpb.TestFieldMergeMessage_Room{
+			ChatMessages: []string{"hi"},
+			Members:      []*testpb.TestFieldMergeMessage_Member{{Name: "m1", Tags: []string{"t1"}}},
+			Guests: map[uint32]*testpb.TestFieldMergeMessage_Member{
+				1: {Name: "g1"},
+				2: {Name: "g2", Tags: []string{"t1"}},
+			},
+		},
+	}
+	src := &testpb.TestFieldMergeMessage{
+		Logs: []string{"c", "d"},
+		Rooms: map[uint32]*testpb.TestFieldMergeMessage_Room{
+			1: {ChatMessages: []string{"1", "2", "3"}},
+		},
+		Lobby: &testpb.TestFieldMergeMessage_Room{
+			ChatMessages: []string{"hello", "bye"},
+			Members:      []*testpb.TestFieldMergeMessage_Member{{Name: "m2", Tags: []string{"t2"}}},
+			Guests: map[uint32]*testpb.TestFieldMergeMessage_Member{
+				1: {Removed: true},
+				2: {Name: "g2", Tags: []string{"t2"}},
+			},
+		},
+	}
+
+	// The annotations work without the channel-wide options, at any depth.
+	merged := proto.Clone(dst).(*testpb.TestFieldMergeMessage)
+	ReflectMerge(merged, src, nil)
+	assert.Equal(t, []string{"a", "b", "c", "d"}, merged.Logs)
+	assert.Equal(t, []string{"2", "3"}, merged.Rooms[1].ChatMessages)
+	assert.Equal(t, []string{"hello", "bye"}, merged.Lobby.ChatMessages)
+	assert.Equal(t, 2, len(merged.Lobby.Members))
+	assert.Equal(t, []string{"t2"}, merged.Lobby.Members[1].Tags)
+	// Not removable without the options.
+	assert.True(t, merged.Lobby.Guests[1].Removed)
+	assert.Equal(t, []string{"t2"}, merged.Lobby.Guests[2].Tags)
+
+	// The channel-wide options apply to the fields that are not annotated.
+	merged = proto.Clone(dst).(*testpb.TestFieldMergeMessage)
+	ReflectMerge(merged, src, &channeldpb.ChannelDataMergeOptions{
+		ListSizeLimit:                3,
+		ShouldCheckRemovableMapField: true,
+	})
+	assert.Equal(t, []string{"a", "b", "c"}, merged.Logs)
+	assert.Equal(t, []string{"2", "3"}, merged.Rooms[1].ChatMessages)
+	assert.NotContains(t, merged.Lobby.Guests, uint32(1))
+	assert.Equal(t, "g2", merged.Lobby.Guests[2].Name)
+
+	// The options of the field path override both the channel-wide options and the annotations.
+	merged = proto.Clone(dst).(*testpb.TestFieldMergeMessage)
+	ReflectMerge(merged, src, &channeldpb.ChannelDataMergeOptions{
+		ShouldReplaceList: true,
+		FieldOptions: map[string]*channeldpb.FieldMergeOptions{
+			"logs":                   {ListSizeLimit: 3, TruncateTop: true},
+			"rooms.chatMessages":     {},
+			"lobby.members.tags":     {},
+			"lobby.guests":           {Removable: true},
+			"lobby.guests.tags":      {ReplaceList: true},
+			"lobby.nonexistentField": {ReplaceList: true},
+		},
+	})
+	assert.Equal(t, []string{"b", "c", "d"}, merged.Logs)
+	// Same as proto.Merge, the message value of the map is replaced, so only the limit matters.
+	assert.Equal(t, []string{"1", "2", "3"}, merged.Rooms[1].ChatMessages)
+	// The channel-wide ShouldReplaceList applies to the list that is not in the map.
+	assert.Equal(t, []string{"hello", "bye"}, merged.Lobby.ChatMessages)
+	assert.Equal(t, 1, len(merged.Lobby.Members))
+	assert.Equal(t, []string{"t2"}, merged.Lobby.Members[0].Tags)
+	assert.NotContains(t, merged.Lobby.Guests, uint32(1))
+	assert.Equal(t, []string{"t2"}, merged.Lobby.Guests[2].Tags)
+
+	// The replaced list is cleared even if it's not in the update.
+	merged = proto.Clone(dst).(*testpb.TestFieldMergeMessage)
+	ReflectMerge(merged, &testpb.TestFieldMergeMessage{Lobby: &testpb.TestFieldMergeMessage_Room{}}, &channeldpb.ChannelDataMergeOptions{ShouldReplaceList: true})
+	assert.Empty(t, merged.Logs)
+	assert.Empty(t, merged.Lobby.Members)
+	assert.Equal(t, []string{"hi"}, merged.Lobby.ChatMessages)
+
+	// The mutually recursive types are both annotated, regardless of which one is checked first.
+	parent := &testpb.TestRecursiveMergeMessage{}
+	assert.True(t, hasFieldMergeAnnotations(parent.ProtoReflect().Descriptor()))
+	assert.True(t, hasFieldMergeAnnotations((&testpb.TestRecursiveMergeMessage_Child{}).ProtoReflect().Descriptor()))
+	parent.Logs = []string{"a"}
+	ReflectMerge(parent, &testpb.TestRecursiveMergeMessage{Logs: []string{"b"}}, nil)
+	assert.Equal(t, []string{"b"}, parent.Logs)
+}
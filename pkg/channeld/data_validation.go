@@ -0,0 +1,199 @@
+package channeld
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/metaworking/channeld/pkg/common"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// The constraints of a field in the channel data update. See ChannelSettingsType.DataFieldValidators.
+type FieldValidatorSettings struct {
+	// The inclusive range of a numeric (or enum) field. Applies to each element of a list or map field.
+	Min *float64
+	Max *float64
+	// The max length of a string or bytes field, or the max number of elements of a list or map field. 0 = no limit.
+	// As an update only carries the changes of a list or map field, the number of elements is checked against the channel data
+	// with the update merged, which costs a copy of the channel data for each update of the field.
+	MaxLen int
+}
+
+// Checks the type and the fields of the update message against the channel data. Should be called in the channel's goroutine.
+func (ch *Channel) validateDataUpdate(updateMsg common.ChannelDataMessage) error {
+	var expected protoreflect.FullName
+	if ch.data != nil && ch.data.msg != nil {
+		expected = ch.data.msg.ProtoReflect().Descriptor().FullName()
+	} else if dataType, exists := getChannelDataType(ch.channelType); exists {
+		expected = dataType.ProtoReflect().Descriptor().FullName()
+	}
+	actual := updateMsg.ProtoReflect().Descriptor().FullName()
+	if expected != "" && actual != expected {
+		return fmt.Errorf("mismatched channel data type, expected: %s, actual: %s", expected, actual)
+	}
+
+	validators := GlobalSettings.GetChannelSettings(ch.channelType).DataFieldValidators
+	if len(validators) == 0 {
+		return nil
+	}
+	// Validate in the same order every time, so the same update always gets the same error.
+	paths := make([]string, 0, len(validators))
+	for path := range validators {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	sizePaths := make([]string, 0)
+	for _, path := range paths {
+		v := validators[path]
+		if v.MaxLen > 0 && updatesCollectionField(updateMsg.ProtoReflect(), strings.Split(path, ".")) {
+			sizePaths = append(sizePaths, path)
+			v.MaxLen = 0
+		}
+		if err := validateField(updateMsg.ProtoReflect(), strings.Split(path, "."), path, &v); err != nil {
+			return err
+		}
+	}
+	if len(sizePaths) == 0 {
+		return nil
+	}
+
+	merged := updateMsg
+	if ch.data != nil && ch.data.msg != nil {
+		merged = proto.Clone(ch.data.msg)
+		mergeWithOptions(merged, updateMsg, ch.data.mergeOptions, nil)
+	}
+	for _, path := range sizePaths {
+		v := FieldValidatorSettings{MaxLen: validators[path].MaxLen}
+		if err := validateField(merged.ProtoReflect(), strings.Split(path, "."), path, &v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Returns true if the field at the path is a list or map field, and the top-level field of the path is set in the message.
+func updatesCollectionField(msg protoreflect.Message, path []string) bool {
+	md := msg.Descriptor()
+	var fd protoreflect.FieldDescriptor
+	for i, name := range path {
+		if md == nil {
+			return false
+		}
+		fd = md.Fields().ByName(protoreflect.Name(name))
+		if fd == nil || (i == 0 && !msg.Has(fd)) {
+			return false
+		}
+		if fd.IsMap() {
+			md = fd.MapValue().Message()
+		} else {
+			md = fd.Message()
+		}
+	}
+	return fd.IsList() || fd.IsMap()
+}
+
+// Validates the field at the path, if it's set in the message.
+// The path goes through the nested messages, as well as each element of the list and map fields of message type.
+func validateField(msg protoreflect.Message, path []string, fullPath string, v *FieldValidatorSettings) error {
+	fd := msg.Descriptor().Fields().ByName(protoreflect.Name(path[0]))
+	if fd == nil || !msg.Has(fd) {
+		return nil
+	}
+	value := msg.Get(fd)
+
+	if len(path) > 1 {
+		if fd.Message() == nil {
+			return nil
+		}
+		var err error
+		switch {
+		case fd.IsList():
+			list := value.List()
+			for i := 0; i < list.Len() && err == nil; i++ {
+				err = validateField(list.Get(i).Message(), path[1:], fullPath, v)
+			}
+		case fd.IsMap():
+			if fd.MapValue().Message() == nil {
+				return nil
+			}
+			value.Map().Range(func(_ protoreflect.MapKey, mv protoreflect.Value) bool {
+				err = validateField(mv.Message(), path[1:], fullPath, v)
+				return err == nil
+			})
+		default:
+			err = validateField(value.Message(), path[1:], fullPath, v)
+		}
+		return err
+	}
+
+	switch {
+	case fd.IsList():
+		list := value.List()
+		if v.MaxLen > 0 && list.Len() > v.MaxLen {
+			return fmt.Errorf("field '%s' has %d elements, exceeds the max %d", fullPath, list.Len(), v.MaxLen)
+		}
+		for i := 0; i < list.Len(); i++ {
+			if err := validateScalar(fd, list.Get(i), fullPath, v); err != nil {
+				return err
+			}
+		}
+	case fd.IsMap():
+		m := value.Map()
+		if v.MaxLen > 0 && m.Len() > v.MaxLen {
+			return fmt.Errorf("field '%s' has %d entries, exceeds the max %d", fullPath, m.Len(), v.MaxLen)
+		}
+		var err error
+		m.Range(func(_ protoreflect.MapKey, mv protoreflect.Value) bool {
+			err = validateScalar(fd.MapValue(), mv, fullPath, v)
+			return err == nil
+		})
+		return err
+	default:
+		if v.MaxLen > 0 {
+			switch fd.Kind() {
+			case protoreflect.StringKind:
+				if len(value.String()) > v.MaxLen {
+					return fmt.Errorf("field '%s' has %d bytes, exceeds the max %d", fullPath, len(value.String()), v.MaxLen)
+				}
+			case protoreflect.BytesKind:
+				if len(value.Bytes()) > v.MaxLen {
+					return fmt.Errorf("field '%s' has %d bytes, exceeds the max %d", fullPath, len(value.Bytes()), v.MaxLen)
+				}
+			}
+		}
+		return validateScalar(fd, value, fullPath, v)
+	}
+	return nil
+}
+
+// Checks the range of a numeric value. The non-numeric values are ignored.
+func validateScalar(fd protoreflect.FieldDescriptor, value protoreflect.Value, fullPath string, v *FieldValidatorSettings) error {
+	if v.Min == nil && v.Max == nil {
+		return nil
+	}
+
+	var num float64
+	switch fd.Kind() {
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		num = float64(value.Int())
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		num = float64(value.Uint())
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		num = value.Float()
+	case protoreflect.EnumKind:
+		num = float64(value.Enum())
+	default:
+		return nil
+	}
+
+	if v.Min != nil && num < *v.Min {
+		return fmt.Errorf("field '%s' is %v, less than the min %v", fullPath, num, *v.Min)
+	}
+	if v.Max != nil && num > *v.Max {
+		return fmt.Errorf("field '%s' is %v, greater than the max %v", fullPath, num, *v.Max)
+	}
+	return nil
+}
@@ -0,0 +1,142 @@
+package channeld
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/metaworking/channeld/internal/testpb"
+	"github.com/metaworking/channeld/pkg/channeldpb"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+func TestValidateField(t *testing.T) {
+	validate := func(msg proto.Message, path string, v FieldValidatorSettings) error {
+		return validateField(msg.ProtoReflect(), strings.Split(path, "."), path, &v)
+	}
+	ptr := func(f float64) *float64 {
+		return &f
+	}
+
+	msg := &testpb.TestFieldMaskMessage{
+		Name: "abcd",
+		Msg:  &testpb.TestFieldMaskMessage_NestedMessage{P1: -5, P2: 10},
+		List: []*testpb.TestFieldMaskMessage_NestedMessage{{P2: 1}, {P2: 20}},
+		Kv1:  map[int64]*testpb.TestFieldMaskMessage_NestedMessage{1: {P1: 100}},
+		Kv2:  map[int64]string{1: "a", 2: "b", 3: "c"},
+	}
+
+	assert.NoError(t, validate(msg, "name", FieldValidatorSettings{MaxLen: 4}))
+	assert.Error(t, validate(msg, "name", FieldValidatorSettings{MaxLen: 3}))
+
+	assert.NoError(t, validate(msg, "msg.p1", FieldValidatorSettings{Min: ptr(-5)}))
+	assert.Error(t, validate(msg, "msg.p1", FieldValidatorSettings{Min: ptr(0)}))
+	assert.Error(t, validate(msg, "msg.p2", FieldValidatorSettings{Max: ptr(5)}))
+
+	// Each element of the list
+	assert.NoError(t, validate(msg, "list.p2", FieldValidatorSettings{Max: ptr(20)}))
+	assert.Error(t, validate(msg, "list.p2", FieldValidatorSettings{Max: ptr(10)}))
+	assert.Error(t, validate(msg, "list", FieldValidatorSettings{MaxLen: 1}))
+
+	// Each value of the map
+	assert.Error(t, validate(msg, "kv1.p1", FieldValidatorSettings{Max: ptr(50)}))
+	assert.NoError(t, validate(msg, "kv2", FieldValidatorSettings{MaxLen: 3}))
+	assert.Error(t, validate(msg, "kv2", FieldValidatorSettings{MaxLen: 2}))
+
+	// The unset and unknown fields are ignored
+	assert.NoError(t, validate(&testpb.TestFieldMaskMessage{}, "msg.p1", FieldValidatorSettings{Min: ptr(0)}))
+	assert.NoError(t, validate(msg, "unknown", FieldValidatorSettings{MaxLen: 1}))
+}
+
+func TestValidateDataUpdate(t *testing.T) {
+	InitLogs()
+	InitChannels()
+	InitConnections("../../config/server_conn_fsm_test.json", "../../config/client_non_authoratative_fsm.json")
+
+	settings := GlobalSettings.ChannelSettings[channeldpb.ChannelType_TEST]
+	defer func() {
+		GlobalSettings.ChannelSettings[channeldpb.ChannelType_TEST] = settings
+	}()
+	validatedSettings := settings
+	maxNum := 100.0
+	validatedSettings.DataFieldValidators = map[string]FieldValidatorSettings{
+		"text": {MaxLen: 8},
+		"num":  {Max: &maxNum},
+		"list": {MaxLen: 3},
+		"kv":   {MaxLen: 2},
+	}
+	GlobalSettings.ChannelSettings[channeldpb.ChannelType_TEST] = validatedSettings
+
+	server := addTestConnection(channeldpb.ConnectionType_SERVER)
+	ch, _ := CreateChannel(channeldpb.ChannelType_TEST, server)
+	ch.InitData(&testpb.TestChannelDataMessage{Text: "a", Num: 1}, nil)
+
+	updateData := func(data proto.Message) {
+		any, _ := anypb.New(data)
+		handleChannelDataUpdate(MessageContext{
+			MsgType:    channeldpb.MessageType_CHANNEL_DATA_UPDATE,
+			Msg:        &channeldpb.ChannelDataUpdateMessage{Data: any},
+			Connection: server,
+			Channel:    ch,
+		})
+	}
+	assertRejected := func() {
+		errMsg, ok := server.latestMsg().(*channeldpb.ErrorResultMessage)
+		if assert.True(t, ok) {
+			assert.Equal(t, channeldpb.ErrorResultMessage_INVALID_MESSAGE, errMsg.ErrorCode)
+			assert.EqualValues(t, channeldpb.MessageType_CHANNEL_DATA_UPDATE, errMsg.MsgType)
+		}
+		assert.Equal(t, "a", ch.Data().msg.(*testpb.TestChannelDataMessage).Text)
+		assert.EqualValues(t, 1, ch.Data().msg.(*testpb.TestChannelDataMessage).Num)
+	}
+
+	// Mismatched data type
+	updateData(&testpb.TestFieldMaskMessage{Name: "b"})
+	assertRejected()
+
+	updateData(&testpb.TestChannelDataMessage{Text: "too long text"})
+	assertRejected()
+
+	updateData(&testpb.TestChannelDataMessage{Num: 101})
+	assertRejected()
+
+	msgCount := len(server.testQueue())
+	updateData(&testpb.TestChannelDataMessage{Text: "b", Num: 100})
+	assert.Equal(t, msgCount, len(server.testQueue()))
+	assert.Equal(t, "b", ch.Data().msg.(*testpb.TestChannelDataMessage).Text)
+	assert.EqualValues(t, 100, ch.Data().msg.(*testpb.TestChannelDataMessage).Num)
+
+	// The sizes of the list and map fields are checked after the update is merged.
+	ch, _ = CreateChannel(channeldpb.ChannelType_TEST, server)
+	ch.InitData(&testpb.TestMergeMessage{Kv: map[int64]*testpb.TestMergeMessage_StringWrapper{}},
+		&channeldpb.ChannelDataMergeOptions{ShouldCheckRemovableMapField: true})
+	dataOf := func() *testpb.TestMergeMessage {
+		return ch.Data().msg.(*testpb.TestMergeMessage)
+	}
+	assertSizeRejected := func(listLen int, kvLen int) {
+		errMsg, ok := server.latestMsg().(*channeldpb.ErrorResultMessage)
+		if assert.True(t, ok) {
+			assert.Equal(t, channeldpb.ErrorResultMessage_INVALID_MESSAGE, errMsg.ErrorCode)
+		}
+		assert.Len(t, dataOf().List, listLen)
+		assert.Len(t, dataOf().Kv, kvLen)
+	}
+
+	updateData(&testpb.TestMergeMessage{List: []string{"a", "b"}})
+	assert.Equal(t, []string{"a", "b"}, dataOf().List)
+	// The list is appended
+	updateData(&testpb.TestMergeMessage{List: []string{"c", "d"}})
+	assertSizeRejected(2, 0)
+
+	updateData(&testpb.TestMergeMessage{Kv: map[int64]*testpb.TestMergeMessage_StringWrapper{1: {Content: "a"}, 2: {Content: "b"}}})
+	assert.Len(t, dataOf().Kv, 2)
+	updateData(&testpb.TestMergeMessage{Kv: map[int64]*testpb.TestMergeMessage_StringWrapper{3: {Content: "c"}}})
+	assertSizeRejected(2, 2)
+	// The removed entries make room for the new ones.
+	msgCount = len(server.testQueue())
+	updateData(&testpb.TestMergeMessage{Kv: map[int64]*testpb.TestMergeMessage_StringWrapper{1: {Removed: true}, 3: {Content: "c"}}})
+	assert.Equal(t, msgCount, len(server.testQueue()))
+	assert.Len(t, dataOf().Kv, 2)
+	assert.Contains(t, dataOf().Kv, int64(3))
+}
@@ -0,0 +1,165 @@
+package channeld
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/metaworking/channeld/pkg/channeldpb"
+	"github.com/metaworking/channeld/pkg/common"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const writeFieldMaskConnIdPlaceholder = "{connId}"
+
+// The tree of ChannelSubscriptionOptions.WriteFieldMasks. A node without children allows the whole field.
+type writeMaskNode struct {
+	children map[string]*writeMaskNode
+}
+
+func newWriteMaskTree(masks []string, connId ConnectionId) *writeMaskNode {
+	root := &writeMaskNode{children: make(map[string]*writeMaskNode)}
+	for _, mask := range masks {
+		mask = strings.ReplaceAll(mask, writeFieldMaskConnIdPlaceholder, strconv.FormatUint(uint64(connId), 10))
+		node := root
+		for _, seg := range strings.Split(mask, ".") {
+			if node.children == nil {
+				// A shorter mask already allows the whole field.
+				break
+			}
+			child, exists := node.children[seg]
+			if !exists {
+				child = &writeMaskNode{children: make(map[string]*writeMaskNode)}
+				node.children[seg] = child
+			}
+			node = child
+		}
+		node.children = nil
+	}
+	return root
+}
+
+// Removes the fields (and the map entries) that are not in the tree from the message. Returns the paths of the removed fields.
+func (node *writeMaskNode) strip(msg protoreflect.Message, prefix string, stripped []string) []string {
+	fields := make([]protoreflect.FieldDescriptor, 0)
+	msg.Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		fields = append(fields, fd)
+		return true
+	})
+
+	for _, fd := range fields {
+		path := prefix + string(fd.Name())
+		child := node.children[string(fd.Name())]
+		if child == nil {
+			msg.Clear(fd)
+			stripped = append(stripped, path)
+			continue
+		}
+		if child.children == nil {
+			continue
+		}
+
+		switch {
+		case fd.IsMap():
+			m := msg.Mutable(fd).Map()
+			keys := make([]protoreflect.MapKey, 0, m.Len())
+			m.Range(func(key protoreflect.MapKey, _ protoreflect.Value) bool {
+				keys = append(keys, key)
+				return true
+			})
+			for _, key := range keys {
+				keyPath := path + "." + key.String()
+				keyNode := child.children[key.String()]
+				if keyNode == nil || (keyNode.children != nil && fd.MapValue().Message() == nil) {
+					m.Clear(key)
+					stripped = append(stripped, keyPath)
+				} else if keyNode.children != nil {
+					stripped = keyNode.strip(m.Mutable(key).Message(), keyPath+".", stripped)
+				}
+			}
+		case fd.IsList() && fd.Message() != nil:
+			list := msg.Mutable(fd).List()
+			for i := 0; i < list.Len(); i++ {
+				stripped = child.strip(list.Get(i).Message(), path+".", stripped)
+			}
+		case fd.Message() != nil:
+			stripped = child.strip(msg.Mutable(fd).Message(), path+".", stripped)
+		default:
+			// The mask goes deeper than the field can, so only part of the field is allowed - which is impossible.
+			msg.Clear(fd)
+			stripped = append(stripped, path)
+		}
+	}
+	return stripped
+}
+
+// Strips the fields that the connection is not allowed to update from the update message, according to its
+// ChannelSubscriptionOptions.WriteFieldMasks. The violations are logged to the security logger.
+// Should be called in the channel's goroutine.
+func (ch *Channel) stripDisallowedFields(conn ConnectionInChannel, updateMsg common.ChannelDataMessage) {
+	if ch.ownerConnection == conn {
+		return
+	}
+
+	ch.connectionsLock.RLock()
+	cs := ch.subscribedConnections[conn]
+	ch.connectionsLock.RUnlock()
+	if cs == nil || len(cs.options.WriteFieldMasks) == 0 {
+		return
+	}
+
+	stripped := newWriteMaskTree(cs.options.WriteFieldMasks, conn.Id()).strip(updateMsg.ProtoReflect(), "", nil)
+	if len(stripped) > 0 {
+		securityLogger.Info("stripped the channel data fields that the connection has no write access to",
+			zap.Uint32("connId", uint32(conn.Id())),
+			zap.String("channelType", ch.channelType.String()),
+			zap.Uint32("channelId", uint32(ch.id)),
+			zap.Strings("fields", stripped),
+		)
+	}
+}
+
+// Only the channel owner or a server connection can change the DataAccess and WriteFieldMasks of an existing subscription.
+// Otherwise a client could grant itself the write access by resending its own sub options.
+func (ch *Channel) canChangeDataAccess(sender ConnectionInChannel) bool {
+	return sender.GetConnectionType() == channeldpb.ConnectionType_SERVER || ch.ownerConnection == sender
+}
+
+// Returns the options that keep the DataAccess and WriteFieldMasks of the connection's existing subscription, if the sender
+// can't change them. If replacing is false, the options are going to be merged, so the fields are cleared; otherwise they
+// are set to the ones of the subscription. The attempts to change them are logged to the security logger.
+// Should be called in the channel's goroutine.
+func (ch *Channel) keepDataAccess(sender ConnectionInChannel, conn *Connection, options *channeldpb.ChannelSubscriptionOptions, replacing bool) *channeldpb.ChannelSubscriptionOptions {
+	if options == nil || ch.canChangeDataAccess(sender) {
+		return options
+	}
+
+	ch.connectionsLock.RLock()
+	defer ch.connectionsLock.RUnlock()
+	cs, exists := ch.subscribedConnections[conn]
+	if !exists {
+		return options
+	}
+
+	if (options.DataAccess != nil && options.GetDataAccess() != cs.options.GetDataAccess()) ||
+		(len(options.WriteFieldMasks) > 0 && fanOutMasksKey(options.WriteFieldMasks) != fanOutMasksKey(cs.options.WriteFieldMasks)) {
+		securityLogger.Info("ignored the data access change of the subscription as the sender is not the channel owner or a server",
+			zap.Uint32("senderConnId", uint32(sender.Id())),
+			zap.Uint32("subConnId", uint32(conn.Id())),
+			zap.String("channelType", ch.channelType.String()),
+			zap.Uint32("channelId", uint32(ch.id)),
+			zap.Strings("writeFieldMasks", options.WriteFieldMasks),
+		)
+	}
+
+	options = proto.Clone(options).(*channeldpb.ChannelSubscriptionOptions)
+	if replacing {
+		options.DataAccess = Pointer(cs.options.GetDataAccess())
+		options.WriteFieldMasks = append([]string(nil), cs.options.WriteFieldMasks...)
+	} else {
+		options.DataAccess = nil
+		options.WriteFieldMasks = nil
+	}
+	return options
+}
@@ -0,0 +1,124 @@
+package channeld
+
+import (
+	"testing"
+
+	"github.com/metaworking/channeld/internal/testpb"
+	"github.com/metaworking/channeld/pkg/channeldpb"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+func TestWriteMaskStrip(t *testing.T) {
+	newMsg := func() *testpb.TestFieldMaskMessage {
+		return &testpb.TestFieldMaskMessage{
+			Name: "a",
+			Msg:  &testpb.TestFieldMaskMessage_NestedMessage{P1: 1, P2: 2},
+			List: []*testpb.TestFieldMaskMessage_NestedMessage{{P1: 1, P2: 2}},
+			Kv1: map[int64]*testpb.TestFieldMaskMessage_NestedMessage{
+				1: {P1: 1, P2: 2},
+				2: {P1: 1, P2: 2},
+			},
+			Kv2: map[int64]string{1: "a", 2: "b"},
+		}
+	}
+
+	msg := newMsg()
+	stripped := newWriteMaskTree([]string{"name", "msg.p1", "kv1.{connId}.p2", "kv2.{connId}"}, 2).strip(msg.ProtoReflect(), "", nil)
+	assert.ElementsMatch(t, []string{"msg.p2", "list", "kv1.1", "kv1.2.p1", "kv2.1"}, stripped)
+	assert.True(t, proto.Equal(&testpb.TestFieldMaskMessage{
+		Name: "a",
+		Msg:  &testpb.TestFieldMaskMessage_NestedMessage{P1: 1},
+		Kv1:  map[int64]*testpb.TestFieldMaskMessage_NestedMessage{2: {P2: 2}},
+		Kv2:  map[int64]string{2: "b"},
+	}, msg))
+
+	// The shorter mask allows the whole field
+	msg = newMsg()
+	stripped = newWriteMaskTree([]string{"list.p1", "list", "msg", "kv1", "kv2"}, 1).strip(msg.ProtoReflect(), "", nil)
+	assert.Equal(t, []string{"name"}, stripped)
+
+	// The mask of the list field applies to each element
+	msg = newMsg()
+	newWriteMaskTree([]string{"list.p2"}, 1).strip(msg.ProtoReflect(), "", nil)
+	assert.True(t, proto.Equal(&testpb.TestFieldMaskMessage{
+		List: []*testpb.TestFieldMaskMessage_NestedMessage{{P2: 2}},
+	}, msg))
+}
+
+func TestWriteFieldMasks(t *testing.T) {
+	InitLogs()
+	InitChannels()
+	InitConnections("../../config/server_conn_fsm_test.json", "../../config/client_non_authoratative_fsm.json")
+
+	settings := GlobalSettings.GetChannelSettings(channeldpb.ChannelType_TEST)
+	settings.ACLSettings.Sub = ChannelAccessLevel_OwnerOnly
+	GlobalSettings.ChannelSettings[channeldpb.ChannelType_TEST] = settings
+	defer delete(GlobalSettings.ChannelSettings, channeldpb.ChannelType_TEST)
+
+	server := addTestConnection(channeldpb.ConnectionType_SERVER)
+	client := addTestConnection(channeldpb.ConnectionType_CLIENT)
+	ch, _ := CreateChannel(channeldpb.ChannelType_TEST, server)
+	ch.InitData(&testpb.TestFieldMaskMessage{}, nil)
+
+	client.SubscribeToChannel(ch, &channeldpb.ChannelSubscriptionOptions{
+		DataAccess:      Pointer(channeldpb.ChannelDataAccess_WRITE_ACCESS),
+		WriteFieldMasks: []string{"kv1.{connId}.p1"},
+	})
+
+	updateData := func(conn *Connection, data proto.Message) {
+		any, _ := anypb.New(data)
+		handleChannelDataUpdate(MessageContext{
+			MsgType:    channeldpb.MessageType_CHANNEL_DATA_UPDATE,
+			Msg:        &channeldpb.ChannelDataUpdateMessage{Data: any},
+			Connection: conn,
+			Channel:    ch,
+		})
+	}
+
+	ownKey := int64(client.Id())
+	updateData(client, &testpb.TestFieldMaskMessage{
+		Name: "hacked",
+		Kv1: map[int64]*testpb.TestFieldMaskMessage_NestedMessage{
+			ownKey:     {P1: 1, P2: 2},
+			ownKey + 1: {P1: 3},
+		},
+	})
+	assert.True(t, proto.Equal(&testpb.TestFieldMaskMessage{
+		Kv1: map[int64]*testpb.TestFieldMaskMessage_NestedMessage{ownKey: {P1: 1}},
+	}, ch.Data().msg))
+
+	// The client can't widen its own masks by resubscribing
+	handleSubToChannel(MessageContext{
+		MsgType: channeldpb.MessageType_SUB_TO_CHANNEL,
+		Msg: &channeldpb.SubscribedToChannelMessage{
+			ConnId:     uint32(client.Id()),
+			SubOptions: &channeldpb.ChannelSubscriptionOptions{WriteFieldMasks: []string{"name"}, FanOutIntervalMs: proto.Uint32(100)},
+		},
+		Connection: client,
+		Channel:    ch,
+	})
+	assert.Equal(t, []string{"kv1.{connId}.p1"}, ch.subscribedConnections[client].options.WriteFieldMasks)
+	// ... but the other options are still merged
+	assert.EqualValues(t, 100, ch.subscribedConnections[client].options.GetFanOutIntervalMs())
+	updateData(client, &testpb.TestFieldMaskMessage{Name: "hacked"})
+	assert.Empty(t, ch.Data().msg.(*testpb.TestFieldMaskMessage).Name)
+
+	// The owner is not restricted
+	updateData(server, &testpb.TestFieldMaskMessage{Name: "server"})
+	assert.Equal(t, "server", ch.Data().msg.(*testpb.TestFieldMaskMessage).Name)
+
+	// ... and can widen the masks of the client
+	handleSubToChannel(MessageContext{
+		MsgType: channeldpb.MessageType_SUB_TO_CHANNEL,
+		Msg: &channeldpb.SubscribedToChannelMessage{
+			ConnId:     uint32(client.Id()),
+			SubOptions: &channeldpb.ChannelSubscriptionOptions{WriteFieldMasks: []string{"name"}},
+		},
+		Connection: server,
+		Channel:    ch,
+	})
+	updateData(client, &testpb.TestFieldMaskMessage{Name: "client"})
+	assert.Equal(t, "client", ch.Data().msg.(*testpb.TestFieldMaskMessage).Name)
+}
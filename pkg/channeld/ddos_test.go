@@ -186,3 +186,75 @@ func checkConnClosed(conn net.Conn) bool {
 	_, err := conn.Read(buff)
 	return err != nil
 }
+
+func TestRateLimit(t *testing.T) {
+	InitLogs()
+	InitChannels()
+	InitConnections("../../config/server_conn_fsm_test.json", "../../config/client_non_authoratative_fsm.json")
+
+	GlobalSettings.RateLimitSettings = RateLimitSettingsType{
+		Rules: map[channeldpb.ConnectionType][]*RateLimitRule{
+			channeldpb.ConnectionType_CLIENT: {
+				{MsgTypes: "1", Rate: 0, Burst: 1},
+				{MsgTypes: "8, 100-65535", Rate: 10, Burst: 2},
+			},
+		},
+		MaxExceeded: 3,
+		Escalation:  RateLimitEscalation_Disconnect,
+	}
+	defer func() {
+		GlobalSettings.RateLimitSettings = RateLimitSettingsType{}
+	}()
+	assert.NoError(t, GlobalSettings.RateLimitSettings.Init())
+
+	// No rule for the server connections
+	assert.Nil(t, addTestConnection(channeldpb.ConnectionType_SERVER).rateLimiter)
+
+	c := addTestConnection(channeldpb.ConnectionType_CLIENT)
+	now := time.Now()
+	assert.True(t, c.rateLimiter.allow(100, now))
+	assert.True(t, c.rateLimiter.allow(8, now))
+	// The bucket is empty
+	assert.False(t, c.rateLimiter.allow(200, now))
+	// The bucket is refilled with 1 token after 100ms
+	assert.True(t, c.rateLimiter.allow(200, now.Add(100*time.Millisecond)))
+	assert.False(t, c.rateLimiter.allow(200, now.Add(100*time.Millisecond)))
+	// No rule for the message type
+	assert.True(t, c.rateLimiter.allow(7, now))
+
+	authMsg, _ := proto.Marshal(&channeldpb.AuthMessage{PlayerIdentifierToken: "test", LoginToken: "test"})
+	authPack := &channeldpb.MessagePack{ChannelId: uint32(GlobalChannelId), MsgType: uint32(channeldpb.MessageType_AUTH), MsgBody: authMsg}
+	c.receiveMessage(authPack)
+	assert.Equal(t, 0, c.rateLimiter.buckets[0].exceeded)
+	c.receiveMessage(authPack)
+	c.receiveMessage(authPack)
+	assert.Equal(t, 2, c.rateLimiter.buckets[0].exceeded)
+	assert.False(t, c.IsClosing())
+	// Escalate after MaxExceeded
+	c.receiveMessage(authPack)
+	assert.True(t, c.IsClosing())
+
+	// The exceeded count is reset once the bucket is refilled.
+	c = addTestConnection(channeldpb.ConnectionType_CLIENT)
+	now = time.Now()
+	for i := 0; i < 4; i++ {
+		c.rateLimiter.allow(100, now)
+	}
+	assert.Equal(t, 2, c.rateLimiter.exceededCount)
+	assert.True(t, c.rateLimiter.allow(100, now.Add(time.Second)))
+	assert.True(t, c.rateLimiter.allow(100, now.Add(time.Second)))
+	assert.False(t, c.rateLimiter.allow(100, now.Add(time.Second)))
+	assert.Equal(t, 1, c.rateLimiter.exceededCount)
+
+	// The unauthenticated connection is disconnected instead of blacklisted.
+	GlobalSettings.RateLimitSettings.Escalation = RateLimitEscalation_Blacklist
+	c = addTestConnection(channeldpb.ConnectionType_CLIENT)
+	c.pit = ""
+	for i := 0; i < 4; i++ {
+		c.rateLimiter.allow(uint32(channeldpb.MessageType_AUTH), now)
+	}
+	c.onRateLimitExceeded(uint32(channeldpb.MessageType_AUTH))
+	assert.True(t, c.IsClosing())
+	_, blacklisted := pitBlacklist[""]
+	assert.False(t, blacklisted)
+}
@@ -1,11 +1,15 @@
 package channeld
 
 import (
+	"errors"
+	"fmt"
 	"strings"
+	"time"
 
 	"github.com/metaworking/channeld/pkg/channeldpb"
 	"github.com/metaworking/channeld/pkg/common"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
 )
 
 // The context of a message for both sending and receiving
@@ -25,6 +29,8 @@ type MessageContext struct {
 	Channel *Channel
 	// Internally used for receiving
 	arrivalTime ChannelTime
+	// Internally used for sending. The encoded Msg shared by multiple connections, so it won't be marshalled again.
+	msgBody []byte
 }
 
 func (ctx *MessageContext) HasConnection() bool {
@@ -32,6 +38,21 @@ func (ctx *MessageContext) HasConnection() bool {
 	return ok && conn != nil && !conn.IsClosing()
 }
 
+// Sends the ErrorResultMessage to the sender of the message. The StubId is kept for the RPC callback.
+func (ctx MessageContext) SendErrorResult(errorCode channeldpb.ErrorResultMessage_ErrorCode, err error) {
+	if !ctx.HasConnection() {
+		return
+	}
+	msgType := ctx.MsgType
+	ctx.MsgType = channeldpb.MessageType_ERROR_RESULT
+	ctx.Msg = &channeldpb.ErrorResultMessage{
+		MsgType:      uint32(msgType),
+		ErrorCode:    errorCode,
+		ErrorMessage: err.Error(),
+	}
+	ctx.Connection.Send(ctx)
+}
+
 type MessageHandlerFunc func(ctx MessageContext)
 type messageMapEntry struct {
 	msg     common.Message
@@ -55,6 +76,16 @@ var MessageMap = map[channeldpb.MessageType]*messageMapEntry{
 	channeldpb.MessageType_CREATE_ENTITY_CHANNEL:     {&channeldpb.CreateEntityChannelMessage{}, handleCreateEntityChannel},
 	channeldpb.MessageType_ENTITY_GROUP_ADD:          {&channeldpb.AddEntityGroupMessage{}, handleAddEntityGroup},
 	channeldpb.MessageType_ENTITY_GROUP_REMOVE:       {&channeldpb.RemoveEntityGroupMessage{}, handleRemoveEntityGroup},
+	channeldpb.MessageType_RESERVE_SUB_SLOTS:         {&channeldpb.ReserveSubscriberSlotsMessage{}, handleReserveSubSlots},
+	channeldpb.MessageType_UPDATE_CHANNEL_ATTRIBUTES: {&channeldpb.UpdateChannelAttributesMessage{}, handleUpdateChannelAttributes},
+	channeldpb.MessageType_GET_CHANNEL_INFO:          {&channeldpb.GetChannelInfoMessage{}, handleGetChannelInfo},
+	channeldpb.MessageType_CHANNEL_DATA_TRANSACTION:  {&channeldpb.ChannelDataTransactionMessage{}, handleChannelDataTransaction},
+	channeldpb.MessageType_CHANNEL_DATA_ACK:          {&channeldpb.ChannelDataAckMessage{}, handleChannelDataAck},
+	channeldpb.MessageType_GET_CHANNEL_DATA:          {&channeldpb.GetChannelDataMessage{}, handleGetChannelData},
+	channeldpb.MessageType_UPDATE_SUB_OPTIONS:        {&channeldpb.UpdateSubOptionsMessage{}, handleUpdateSubOptions},
+	channeldpb.MessageType_BATCH_SUBSCRIBE:           {&channeldpb.BatchSubscribeMessage{}, handleBatchSubscribe},
+	channeldpb.MessageType_AUTO_SUBSCRIBE:            {&channeldpb.AutoSubscribeMessage{}, handleAutoSubscribe},
+	channeldpb.MessageType_REMOVE_AUTO_SUBSCRIBE:     {&channeldpb.RemoveAutoSubscribeMessage{}, handleRemoveAutoSubscribe},
 }
 
 func RegisterMessageHandler(msgType uint32, msg common.Message, handler MessageHandlerFunc) {
@@ -68,6 +99,10 @@ func handleClientToServerUserMessage(ctx MessageContext) {
 		return
 	}
 
+	if !ctx.Channel.allowUserMessage(ctx) {
+		return
+	}
+
 	var channelOwnerConnId uint32 = 0
 	if ctx.Channel.HasOwner() {
 		ctx.Channel.ownerConnection.Send(ctx)
@@ -119,6 +154,10 @@ func HandleServerToClientUserMessage(ctx MessageContext) {
 		return
 	}
 
+	if !ctx.Channel.allowUserMessage(ctx) {
+		return
+	}
+
 	if len(msg.Payload) < 128 {
 		ctx.Connection.Logger().Verbose("forward user-space message from server to client/server",
 			zap.Uint32("msgType", uint32(ctx.MsgType)),
@@ -255,6 +294,12 @@ func handleAuth(ctx MessageContext) {
 		return
 	}
 
+	if msg.BandwidthBudget > 0 {
+		if conn, ok := ctx.Connection.(*Connection); ok {
+			conn.fanOutBudget.setLimit(msg.BandwidthBudget)
+		}
+	}
+
 	if authProvider == nil && !GlobalSettings.Development {
 		rootLogger.Panic("no auth provider")
 		return
@@ -340,6 +385,31 @@ func handleCreateChannel(ctx MessageContext) {
 		handleCreateSpatialChannel(ctx, msg)
 		return
 	} else {
+		if err := validateAttributes(msg.Attributes); err != nil {
+			ctx.Connection.Logger().Error("failed to create channel as the attributes are invalid", zap.Error(err))
+			ctx.SendErrorResult(channeldpb.ErrorResultMessage_INVALID_MESSAGE, err)
+			return
+		}
+
+		var parent *Channel
+		if msg.ParentChannelId != 0 {
+			parent = GetChannel(common.ChannelId(msg.ParentChannelId))
+			if parent == nil || parent.IsRemoving() {
+				ctx.Connection.Logger().Error("failed to create channel as the parent channel doesn't exist",
+					zap.Uint32("parentChannelId", msg.ParentChannelId),
+				)
+				ctx.SendErrorResult(channeldpb.ErrorResultMessage_INVALID_MESSAGE, fmt.Errorf("parent channel %d doesn't exist", msg.ParentChannelId))
+				return
+			}
+			if !ctx.Connection.HasAuthorityOver(parent) {
+				ctx.Connection.Logger().Error("illegal attempt to create channel as the connection has no authority over the parent channel",
+					zap.Uint32("parentChannelId", msg.ParentChannelId),
+				)
+				ctx.SendErrorResult(channeldpb.ErrorResultMessage_NO_ACCESS, ErrOwnerAndGlobalOwnerAccess)
+				return
+			}
+		}
+
 		newChannel, err = CreateChannel(msg.ChannelType, ctx.Connection)
 		if err != nil {
 			ctx.Connection.Logger().Error("failed to create channel",
@@ -348,10 +418,26 @@ func handleCreateChannel(ctx MessageContext) {
 			)
 			return
 		}
-		newChannel.Logger().Info("created channel with owner", zap.Uint32("ownerConnId", uint32(newChannel.ownerConnection.Id())))
+		if parent != nil {
+			newChannel.SetParent(parent)
+		}
+		newChannel.UpdateAttributes(msg.Attributes, nil)
+		newChannel.Logger().Info("created channel with owner",
+			zap.Uint32("ownerConnId", uint32(newChannel.ownerConnection.Id())),
+			zap.Uint32("parentChannelId", uint32(newChannel.parentId)),
+		)
 	}
 
 	newChannel.metadata = msg.Metadata
+	if msg.EnablePresence != nil {
+		newChannel.enablePresence = *msg.EnablePresence
+	}
+	if msg.MaxSubscribers != nil {
+		newChannel.maxSubscribers = MaxSubscribersType{
+			Client: msg.MaxSubscribers.MaxClients,
+			Server: msg.MaxSubscribers.MaxServers,
+		}
+	}
 	if msg.Data != nil {
 		dataMsg, err := msg.Data.UnmarshalNew()
 		if err != nil {
@@ -365,11 +451,16 @@ func handleCreateChannel(ctx MessageContext) {
 		newChannel.InitData(nil, msg.MergeOptions)
 	}
 
+	if newChannel != globalChannel {
+		newChannel.onCreated(ctx.Connection)
+	}
+
 	ctx.Msg = &channeldpb.CreateChannelResultMessage{
-		ChannelType: newChannel.channelType,
-		Metadata:    newChannel.metadata,
-		OwnerConnId: uint32(ctx.Connection.Id()),
-		ChannelId:   uint32(newChannel.id),
+		ChannelType:     newChannel.channelType,
+		Metadata:        newChannel.metadata,
+		OwnerConnId:     uint32(ctx.Connection.Id()),
+		ChannelId:       uint32(newChannel.id),
+		ParentChannelId: uint32(newChannel.parentId),
 	}
 	ctx.Connection.Send(ctx)
 	// Also send the response to the GLOBAL channel owner.
@@ -422,6 +513,7 @@ func handleCreateSpatialChannel(ctx MessageContext, msg *channeldpb.CreateChanne
 	}
 
 	for _, newChannel := range channels {
+		newChannel.onCreated(ctx.Connection)
 		// Subscribe to channel after creation
 		cs, _ := ctx.Connection.SubscribeToChannel(newChannel, msg.SubOptions)
 		if cs != nil {
@@ -476,6 +568,8 @@ func handleCreateEntityChannel(ctx MessageContext) {
 		newChannel.InitData(nil, msg.MergeOptions)
 	}
 
+	newChannel.onCreated(ctx.Connection)
+
 	ctx.Msg = &channeldpb.CreateChannelResultMessage{
 		ChannelType: newChannel.channelType,
 		Metadata:    newChannel.metadata,
@@ -590,6 +684,19 @@ func handleRemoveChannel(ctx MessageContext) {
 	)
 }
 
+// A channel matches if its metadata contains any of the filters, or there's no filter.
+func matchMetadataFilters(metadata string, filters []string) bool {
+	if len(filters) == 0 {
+		return true
+	}
+	for _, keyword := range filters {
+		if strings.Contains(metadata, keyword) {
+			return true
+		}
+	}
+	return false
+}
+
 func handleListChannel(ctx MessageContext) {
 	if ctx.Channel != globalChannel {
 		ctx.Connection.Logger().Error("illegal attemp to list channel outside the GLOBAL channel")
@@ -603,29 +710,49 @@ func handleListChannel(ctx MessageContext) {
 	}
 
 	result := make([]*channeldpb.ListChannelResultMessage_ChannelInfo, 0)
-	allChannels.Range(func(_ common.ChannelId, channel *Channel) bool {
+	rangeFunc := func(channel *Channel) bool {
 		if msg.TypeFilter != channeldpb.ChannelType_UNKNOWN && msg.TypeFilter != channel.channelType {
 			return true
 		}
-		matched := len(msg.MetadataFilters) == 0
-		for _, keyword := range msg.MetadataFilters {
-			if strings.Contains(channel.metadata, keyword) {
-				matched = true
-				break
-			}
+		if !matchMetadataFilters(channel.metadata, msg.MetadataFilters) {
+			return true
 		}
-		if matched {
+		attributes := channel.GetAttributes()
+		if matchAttributeFilter(attributes, msg.AttributeFilter) {
 			result = append(result, &channeldpb.ListChannelResultMessage_ChannelInfo{
-				ChannelId:   uint32(channel.id),
-				ChannelType: channel.channelType,
-				Metadata:    channel.metadata,
+				ChannelId:       uint32(channel.id),
+				ChannelType:     channel.channelType,
+				Metadata:        channel.metadata,
+				ParentChannelId: uint32(channel.parentId),
+				Attributes:      attributes,
 			})
 		}
 		return true
-	})
+	}
+
+	if msg.SubtreeRootId != 0 {
+		root := GetChannel(common.ChannelId(msg.SubtreeRootId))
+		if root == nil {
+			ctx.Connection.Logger().Warn("invalid subtree root for listing channels", zap.Uint32("subtreeRootId", msg.SubtreeRootId))
+		} else if rangeFunc(root) {
+			root.RangeDescendants(rangeFunc)
+		}
+	} else {
+		allChannels.Range(func(_ common.ChannelId, channel *Channel) bool {
+			return rangeFunc(channel)
+		})
+	}
+
+	result, nextCursor, err := paginateChannelList(result, msg)
+	if err != nil {
+		ctx.Connection.Logger().Warn("failed to paginate the channel list", zap.Error(err))
+		ctx.SendErrorResult(channeldpb.ErrorResultMessage_INVALID_MESSAGE, err)
+		return
+	}
 
 	ctx.Msg = &channeldpb.ListChannelResultMessage{
-		Channels: result,
+		Channels:   result,
+		NextCursor: nextCursor,
 	}
 	ctx.Connection.Send(ctx)
 }
@@ -650,17 +777,6 @@ func handleSubToChannel(ctx MessageContext) {
 		return
 	}
 
-	hasAccess, err := ctx.Channel.CheckACL(ctx.Connection, ChannelAccessType_Sub)
-	if connToSub.Id() != ctx.Connection.Id() && !hasAccess {
-		ctx.Connection.Logger().Warn("connection doesn't have access to sub connection to this channel",
-			zap.Uint32("subConnId", msg.ConnId),
-			zap.String("channelType", ctx.Channel.channelType.String()),
-			zap.Uint32("channelId", uint32(ctx.Channel.id)),
-			zap.Error(err),
-		)
-		return
-	}
-
 	/*
 		cs, exists := ctx.Channel.subscribedConnections[connToSub]
 		if exists {
@@ -677,22 +793,96 @@ func handleSubToChannel(ctx MessageContext) {
 		}
 	*/
 
-	cs, alreadySubed := connToSub.SubscribeToChannel(ctx.Channel, msg.SubOptions)
-	if cs == nil {
+	cs, subErr := ctx.Channel.subscribeConnection(ctx.Connection, connToSub, msg.SubOptions)
+	if subErr != nil {
+		ctx.SendErrorResult(subErr.code, subErr.err)
+		// Also notify the subscribed if it's not the sender.
+		if subErr.code == channeldpb.ErrorResultMessage_CHANNEL_FULL && connToSub != ctx.Connection {
+			ctx.StubId = 0
+			ctx.Connection = connToSub
+			ctx.SendErrorResult(subErr.code, subErr.err)
+		}
 		return
 	}
 
 	// Always notify the sender - may need to update the sub options.
 	ctx.Connection.sendSubscribed(ctx, ctx.Channel, connToSub, ctx.StubId, &cs.options)
 
-	// Notify the subscribed if it's not the sender.
-	if connToSub != ctx.Connection {
-		connToSub.sendSubscribed(ctx, ctx.Channel, connToSub, 0, &cs.options)
+	if msg.SubToChildren {
+		// Handle the subscription in each child channel's goroutine. The grandchildren will be handled recursively.
+		ctx.Channel.children.Range(func(_ common.ChannelId, child *Channel) bool {
+			childCtx := ctx
+			childCtx.Channel = child
+			childCtx.ChannelId = uint32(child.id)
+			childCtx.StubId = 0
+			child.PutMessageContext(childCtx, handleSubToChannel)
+			return true
+		})
+	}
+}
+
+func handleUpdateSubOptions(ctx MessageContext) {
+	msg, ok := ctx.Msg.(*channeldpb.UpdateSubOptionsMessage)
+	if !ok {
+		ctx.Connection.Logger().Error("message is not an UpdateSubOptionsMessage, will not be handled.")
+		return
+	}
+
+	var connToUpdate *Connection
+	if ctx.Connection.GetConnectionType() == channeldpb.ConnectionType_CLIENT {
+		connToUpdate = ctx.Connection.(*Connection)
+	} else {
+		// Only the server can specify a ConnId.
+		connToUpdate = GetConnection(ConnectionId(msg.ConnId))
 	}
 
-	// Notify the channel owner if not already subed and it's not the sender.
-	if !alreadySubed && ctx.Channel.HasOwner() && ctx.Channel.ownerConnection != ctx.Connection {
-		ctx.Channel.ownerConnection.sendSubscribed(ctx, ctx.Channel, connToSub, 0, &cs.options)
+	if connToUpdate == nil {
+		ctx.Connection.Logger().Error("invalid ConnectionId for updating sub options", zap.Uint32("connIdInMsg", msg.ConnId))
+		ctx.SendErrorResult(channeldpb.ErrorResultMessage_INVALID_MESSAGE, fmt.Errorf("invalid connId: %d", msg.ConnId))
+		return
+	}
+
+	hasAccess, err := ctx.Channel.CheckACL(ctx.Connection, ChannelAccessType_Sub)
+	if connToUpdate.Id() != ctx.Connection.Id() && !hasAccess {
+		ctx.Connection.Logger().Warn("connection doesn't have access to update the sub options of another connection",
+			zap.Uint32("subConnId", msg.ConnId),
+			zap.String("channelType", ctx.Channel.channelType.String()),
+			zap.Uint32("channelId", uint32(ctx.Channel.id)),
+			zap.Error(err),
+		)
+		ctx.SendErrorResult(channeldpb.ErrorResultMessage_NO_ACCESS, err)
+		return
+	}
+
+	subOptions := ctx.Channel.keepDataAccess(ctx.Connection, connToUpdate, msg.SubOptions, true)
+
+	if ctx.Channel.behavior != nil {
+		if err := ctx.Channel.behavior.OnSubscribe(ctx.Channel, connToUpdate, subOptions); err != nil {
+			ctx.Channel.Logger().Info("sub options update is vetoed by the channel behavior",
+				zap.Uint32("subConnId", uint32(connToUpdate.Id())),
+				zap.Error(err),
+			)
+			ctx.SendErrorResult(channeldpb.ErrorResultMessage_NO_ACCESS, err)
+			return
+		}
+	}
+
+	cs, err := connToUpdate.UpdateSubscriptionOptions(ctx.Channel, subOptions)
+	if err != nil {
+		ctx.SendErrorResult(channeldpb.ErrorResultMessage_INVALID_MESSAGE, err)
+		return
+	}
+
+	ctx.Msg = &channeldpb.UpdateSubOptionsResultMessage{
+		ConnId:     uint32(connToUpdate.Id()),
+		SubOptions: proto.Clone(&cs.options).(*channeldpb.ChannelSubscriptionOptions),
+	}
+	ctx.Connection.Send(ctx)
+
+	// Notify the subscribed if it's not the sender.
+	if connToUpdate != ctx.Connection {
+		ctx.StubId = 0
+		connToUpdate.Send(ctx)
 	}
 }
 
@@ -710,56 +900,240 @@ func handleUnsubFromChannel(ctx MessageContext) {
 		return
 	}
 
-	hasAccess, accessErr := ctx.Channel.CheckACL(ctx.Connection, ChannelAccessType_Unsub)
-	if connToUnsub.id != ctx.Connection.Id() && !hasAccess {
-		ctx.Connection.Logger().Error("connection dosen't have access to unsub connection from this channel",
-			zap.Uint32("unsubConnId", msg.ConnId),
+	if ctx.Channel.unsubscribeConnection(ctx.Connection, connToUnsub) != nil {
+		return
+	}
+
+	// Notify the sender.
+	ctx.Connection.sendUnsubscribed(ctx, ctx.Channel, connToUnsub, ctx.StubId)
+}
+
+func handleReserveSubSlots(ctx MessageContext) {
+	msg, ok := ctx.Msg.(*channeldpb.ReserveSubscriberSlotsMessage)
+	if !ok {
+		ctx.Connection.Logger().Error("message is not a ReserveSubscriberSlotsMessage, will not be handled.")
+		return
+	}
+
+	if ctx.Channel.ownerConnection != ctx.Connection {
+		ctx.Connection.Logger().Warn("illegal attempt to reserve subscriber slots as the connection is not the channel owner",
 			zap.String("channelType", ctx.Channel.channelType.String()),
 			zap.Uint32("channelId", uint32(ctx.Channel.id)),
-			zap.Error(accessErr),
 		)
+		ctx.SendErrorResult(channeldpb.ErrorResultMessage_NO_ACCESS, ErrOwnerOnlyAccess)
 		return
 	}
 
-	_, err := connToUnsub.UnsubscribeFromChannel(ctx.Channel)
-	if err != nil {
-		ctx.Connection.Logger().Warn("failed to unsub from channel",
+	defer func() {
+		ctx.Channel.connectionsLock.Unlock()
+	}()
+	ctx.Channel.connectionsLock.Lock()
+
+	for _, connId := range msg.ConnIdsToRelease {
+		delete(ctx.Channel.reservedSubSlots, ConnectionId(connId))
+	}
+
+	failedConnIds := make([]uint32, 0)
+	for _, connId := range msg.ConnIdsToReserve {
+		conn := GetConnection(ConnectionId(connId))
+		if conn == nil {
+			failedConnIds = append(failedConnIds, connId)
+			continue
+		}
+		if _, subed := ctx.Channel.subscribedConnections[conn]; subed {
+			continue
+		}
+		if err := ctx.Channel.checkSubscriberCapacity(conn); err != nil {
+			failedConnIds = append(failedConnIds, connId)
+			continue
+		}
+		ctx.Channel.reservedSubSlots[conn.id] = conn.connectionType
+	}
+
+	if len(failedConnIds) > 0 {
+		ctx.Channel.Logger().Info("failed to reserve subscriber slots", zap.Uint32s("connIds", failedConnIds))
+		ctx.SendErrorResult(channeldpb.ErrorResultMessage_CHANNEL_FULL,
+			fmt.Errorf("failed to reserve subscriber slots for connections %v: invalid connection or %w", failedConnIds, ErrChannelFull))
+	}
+}
+
+func handleUpdateChannelAttributes(ctx MessageContext) {
+	msg, ok := ctx.Msg.(*channeldpb.UpdateChannelAttributesMessage)
+	if !ok {
+		ctx.Connection.Logger().Error("message is not an UpdateChannelAttributesMessage, will not be handled.")
+		return
+	}
+
+	if ctx.Channel.ownerConnection != ctx.Connection {
+		ctx.Connection.Logger().Warn("illegal attempt to update channel attributes as the connection is not the channel owner",
 			zap.String("channelType", ctx.Channel.channelType.String()),
 			zap.Uint32("channelId", uint32(ctx.Channel.id)),
-			zap.Error(err),
 		)
+		ctx.SendErrorResult(channeldpb.ErrorResultMessage_NO_ACCESS, ErrOwnerOnlyAccess)
 		return
 	}
 
-	// Notify the sender.
-	ctx.Connection.sendUnsubscribed(ctx, ctx.Channel, connToUnsub, ctx.StubId)
+	if err := validateAttributes(msg.Attributes); err != nil {
+		ctx.Connection.Logger().Warn("failed to update channel attributes", zap.Error(err))
+		ctx.SendErrorResult(channeldpb.ErrorResultMessage_INVALID_MESSAGE, err)
+		return
+	}
+
+	ctx.Channel.UpdateAttributes(msg.Attributes, msg.KeysToRemove)
 
-	// Notify the unsubscribed.
-	if connToUnsub != ctx.Connection {
-		connToUnsub.sendUnsubscribed(ctx, ctx.Channel, connToUnsub, 0)
+	ctx.Msg = &channeldpb.UpdateChannelAttributesResultMessage{
+		Attributes: ctx.Channel.GetAttributes(),
 	}
-	// Notify the channel owner.
+	ctx.Connection.Send(ctx)
+}
+
+func handleGetChannelInfo(ctx MessageContext) {
+	_, ok := ctx.Msg.(*channeldpb.GetChannelInfoMessage)
+	if !ok {
+		ctx.Connection.Logger().Error("message is not a GetChannelInfoMessage, will not be handled.")
+		return
+	}
+
+	hasAccess, err := ctx.Channel.CheckACL(ctx.Connection, ChannelAccessType_GetInfo)
+	if !hasAccess {
+		ctx.Connection.Logger().Warn("connection doesn't have access to get the channel info",
+			zap.String("channelType", ctx.Channel.channelType.String()),
+			zap.Uint32("channelId", uint32(ctx.Channel.id)),
+			zap.Error(err),
+		)
+		ctx.SendErrorResult(channeldpb.ErrorResultMessage_NO_ACCESS, err)
+		return
+	}
+
+	resultMsg := &channeldpb.GetChannelInfoResultMessage{
+		ChannelId:       uint32(ctx.Channel.id),
+		ChannelType:     ctx.Channel.channelType,
+		Metadata:        ctx.Channel.metadata,
+		State:           channeldpb.GetChannelInfoResultMessage_ChannelState(ctx.Channel.state),
+		CreationTime:    ctx.Channel.startTime.UnixMilli(),
+		ParentChannelId: uint32(ctx.Channel.parentId),
+		Attributes:      ctx.Channel.GetAttributes(),
+	}
+
 	if ctx.Channel.HasOwner() {
-		if ctx.Channel.ownerConnection != ctx.Connection && ctx.Channel.ownerConnection != connToUnsub {
-			ctx.Channel.ownerConnection.sendUnsubscribed(ctx, ctx.Channel, connToUnsub, 0)
-		} else if ctx.Channel.ownerConnection == connToUnsub {
-			// Reset the owner if it unsubscribed itself
-			ctx.Channel.ownerConnection = nil
-		}
+		resultMsg.OwnerConnId = uint32(ctx.Channel.ownerConnection.Id())
+	}
+
+	if ctx.Channel.Data() != nil && ctx.Channel.Data().msg != nil {
+		resultMsg.DataMsgTypeName = string(ctx.Channel.Data().msg.ProtoReflect().Descriptor().FullName())
+		resultMsg.DataSize = uint32(proto.Size(ctx.Channel.Data().msg))
+	}
+
+	ctx.Channel.connectionsLock.RLock()
+	resultMsg.Subscribers = make([]*channeldpb.GetChannelInfoResultMessage_SubscriberInfo, 0, len(ctx.Channel.subscribedConnections))
+	for conn, cs := range ctx.Channel.subscribedConnections {
+		resultMsg.Subscribers = append(resultMsg.Subscribers, &channeldpb.GetChannelInfoResultMessage_SubscriberInfo{
+			ConnId:   uint32(conn.Id()),
+			ConnType: conn.GetConnectionType(),
+			Options:  proto.Clone(&cs.options).(*channeldpb.ChannelSubscriptionOptions),
+			SubTime:  time.Duration(cs.subTime).Milliseconds(),
+		})
+	}
+	ctx.Channel.connectionsLock.RUnlock()
+
+	ctx.Msg = resultMsg
+	ctx.Connection.Send(ctx)
+}
+
+func handleScheduleMessage(ctx MessageContext) {
+	msg, ok := ctx.Msg.(*channeldpb.ScheduleMessageMessage)
+	if !ok {
+		ctx.Connection.Logger().Error("message is not a ScheduleMessageMessage, will not be handled.")
+		return
+	}
+
+	conn, ok := ctx.Connection.(*Connection)
+	if !ok || conn.GetConnectionType() != channeldpb.ConnectionType_SERVER {
+		ctx.Connection.Logger().Warn("illegal attempt to schedule message from non-server connection")
+		ctx.SendErrorResult(channeldpb.ErrorResultMessage_NO_ACCESS, errors.New("only the server connection can schedule messages"))
+		return
+	}
+
+	mp := msg.Message
+	if mp == nil || (msg.ChannelTimeMs <= 0 && msg.IntervalMs == 0) ||
+		mp.MsgType == uint32(channeldpb.MessageType_SCHEDULE_MESSAGE) || mp.MsgType == uint32(channeldpb.MessageType_CANCEL_SCHEDULED) {
+		ctx.SendErrorResult(channeldpb.ErrorResultMessage_INVALID_MESSAGE, errors.New("invalid message to schedule"))
+		return
+	}
+
+	if !conn.fsm.IsAllowed(mp.MsgType) {
+		ctx.SendErrorResult(channeldpb.ErrorResultMessage_NO_ACCESS, fmt.Errorf("message type %d is not allowed for current state", mp.MsgType))
+		return
+	}
+
+	scheduledMsg, handler, err := conn.unpackMessage(mp)
+	if err != nil {
+		ctx.SendErrorResult(channeldpb.ErrorResultMessage_INVALID_MESSAGE, err)
+		return
+	}
+
+	interval := msToChannelTime(int64(msg.IntervalMs))
+	nextTime := msToChannelTime(msg.ChannelTimeMs)
+	if msg.ChannelTimeMs <= 0 {
+		nextTime = ctx.Channel.GetTime() + interval
+	}
+
+	id := ctx.Channel.scheduleMessage(MessageContext{
+		MsgType:    channeldpb.MessageType(mp.MsgType),
+		Msg:        scheduledMsg,
+		Connection: ctx.Connection,
+		Channel:    ctx.Channel,
+		Broadcast:  mp.Broadcast,
+		StubId:     0,
+		ChannelId:  uint32(ctx.Channel.id),
+	}, handler, nextTime, interval)
+
+	ctx.Channel.Logger().Debug("scheduled message",
+		zap.Uint32("scheduleId", id),
+		zap.Uint32("msgType", mp.MsgType),
+		zap.Int64("channelTimeMs", channelTimeToMs(nextTime)),
+		zap.Uint32("intervalMs", msg.IntervalMs),
+	)
+
+	ctx.Msg = &channeldpb.ScheduleMessageResultMessage{
+		ScheduleId:    id,
+		ChannelTimeMs: channelTimeToMs(nextTime),
+	}
+	ctx.Connection.Send(ctx)
+}
+
+func handleCancelScheduled(ctx MessageContext) {
+	msg, ok := ctx.Msg.(*channeldpb.CancelScheduledMessage)
+	if !ok {
+		ctx.Connection.Logger().Error("message is not a CancelScheduledMessage, will not be handled.")
+		return
+	}
+
+	if ctx.Connection.GetConnectionType() != channeldpb.ConnectionType_SERVER {
+		ctx.Connection.Logger().Warn("illegal attempt to cancel scheduled message from non-server connection")
+		ctx.SendErrorResult(channeldpb.ErrorResultMessage_NO_ACCESS, errors.New("only the server connection can cancel scheduled messages"))
+		return
+	}
+
+	if !ctx.Channel.cancelScheduledMessage(msg.ScheduleId, ctx.Connection) {
+		ctx.SendErrorResult(channeldpb.ErrorResultMessage_INVALID_MESSAGE, fmt.Errorf("scheduled message %d doesn't exist", msg.ScheduleId))
 	}
 }
 
 func handleChannelDataUpdate(ctx MessageContext) {
 	// Only channel owner or writable subsciptors can update the data
-	if ctx.Channel.ownerConnection != ctx.Connection {
-		cs := ctx.Channel.subscribedConnections[ctx.Connection]
-		if cs == nil || *cs.options.DataAccess != channeldpb.ChannelDataAccess_WRITE_ACCESS {
-			ctx.Connection.Logger().Warn("attempt to update channel data but has no access",
-				zap.String("channelType", ctx.Channel.channelType.String()),
-				zap.Uint32("channelId", uint32(ctx.Channel.id)),
-			)
-			return
-		}
+	if !ctx.Channel.hasDataWriteAccess(ctx.Connection) {
+		ctx.Connection.Logger().Warn("attempt to update channel data but has no access",
+			zap.String("channelType", ctx.Channel.channelType.String()),
+			zap.Uint32("channelId", uint32(ctx.Channel.id)),
+		)
+		return
+	}
+
+	// The update can't be applied in the middle of a transaction.
+	if ctx.Channel.lockedBy != nil {
+		ctx.SendErrorResult(channeldpb.ErrorResultMessage_CHANNEL_BUSY, ErrChannelLocked)
+		return
 	}
 
 	if ctx.Channel.Data() == nil {
@@ -778,9 +1152,32 @@ func handleChannelDataUpdate(ctx MessageContext) {
 		ctx.Connection.Logger().Error("failed to unmarshal channel update data", zap.Error(err),
 			zap.String("channelType", ctx.Channel.channelType.String()),
 			zap.String("typeUrl", msg.Data.TypeUrl))
+		ctx.SendErrorResult(channeldpb.ErrorResultMessage_INVALID_MESSAGE, err)
+		return
+	}
+
+	if err := ctx.Channel.validateDataUpdate(updateMsg); err != nil {
+		ctx.Channel.Logger().Info("invalid channel data update",
+			zap.Uint32("connId", uint32(ctx.Connection.Id())),
+			zap.Error(err),
+		)
+		ctx.SendErrorResult(channeldpb.ErrorResultMessage_INVALID_MESSAGE, err)
 		return
 	}
 
+	ctx.Channel.stripDisallowedFields(ctx.Connection, updateMsg)
+
+	if ctx.Channel.behavior != nil {
+		if err := ctx.Channel.behavior.OnDataUpdate(ctx.Channel, updateMsg, ctx.Connection); err != nil {
+			ctx.Channel.Logger().Info("channel data update is rejected by the channel behavior",
+				zap.Uint32("connId", uint32(ctx.Connection.Id())),
+				zap.Error(err),
+			)
+			ctx.SendErrorResult(channeldpb.ErrorResultMessage_INVALID_MESSAGE, err)
+			return
+		}
+	}
+
 	if ctx.Channel.spatialNotifier != nil {
 		if ctx.Connection.GetConnectionType() == channeldpb.ConnectionType_CLIENT {
 			ctx.Channel.SetDataUpdateConnId(ctx.Connection.Id())
@@ -788,9 +1185,46 @@ func handleChannelDataUpdate(ctx MessageContext) {
 			ctx.Channel.SetDataUpdateConnId(ConnectionId(msg.ContextConnId))
 		}
 	}
+	ctx.Channel.resolveDataConflicts(updateMsg, ctx.Connection, msg)
 	ctx.Channel.Data().OnUpdate(updateMsg, ctx.arrivalTime, ctx.Connection.Id(), ctx.Channel.spatialNotifier)
 }
 
+func handleChannelDataAck(ctx MessageContext) {
+	msg, ok := ctx.Msg.(*channeldpb.ChannelDataAckMessage)
+	if !ok {
+		ctx.Connection.Logger().Error("message is not a ChannelDataAckMessage, will not be handled.")
+		return
+	}
+
+	if ctx.Channel.Data() == nil {
+		return
+	}
+
+	ctx.Channel.connectionsLock.RLock()
+	cs := ctx.Channel.subscribedConnections[ctx.Connection]
+	ctx.Channel.connectionsLock.RUnlock()
+	if cs == nil || !*cs.options.DeltaFanOut {
+		ctx.Connection.Logger().Debug("ignored the ack as the connection is not subscribed to the channel with delta fan-out",
+			zap.Uint32("channelId", uint32(ctx.Channel.id)),
+		)
+		return
+	}
+
+	foc := cs.fanOutElement.Value.(*fanOutConnection)
+	// Can't acknowledge the update that hasn't been sent.
+	if msg.MsgIndex > foc.lastMessageIndex {
+		ctx.Connection.Logger().Warn("illegal attempt to acknowledge the unsent channel data update",
+			zap.Uint32("channelId", uint32(ctx.Channel.id)),
+			zap.Uint64("msgIndex", msg.MsgIndex),
+			zap.Uint64("lastMsgIndex", foc.lastMessageIndex),
+		)
+		return
+	}
+	if msg.MsgIndex > foc.ackedMessageIndex {
+		foc.ackedMessageIndex = msg.MsgIndex
+	}
+}
+
 func handleDisconnect(ctx MessageContext) {
 	if ctx.Channel != globalChannel {
 		ctx.Connection.Logger().Error("illegal attemp to disconnect another connection outside the GLOBAL channel")
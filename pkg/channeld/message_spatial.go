@@ -68,12 +68,14 @@ func handleUpdateSpatialInterest(ctx MessageContext) {
 			channelsToSub[chId] = &channeldpb.ChannelSubscriptionOptions{
 				// DataAccess:       Pointer(channeldpb.ChannelDataAccess_NO_ACCESS),
 				FanOutIntervalMs: proto.Uint32(GlobalSettings.GetChannelSettings(channeldpb.ChannelType_SPATIAL).DefaultFanOutIntervalMs),
+				Priority:         proto.Uint32(uint32(dist)),
 			}
 		} else {
 			channelsToSub[chId] = &channeldpb.ChannelSubscriptionOptions{
 				// DataAccess:       Pointer(channeldpb.ChannelDataAccess_NO_ACCESS),
 				FanOutIntervalMs: proto.Uint32(dampSettings.FanOutIntervalMs),
 				DataFieldMasks:   dampSettings.DataFieldMasks,
+				Priority:         proto.Uint32(uint32(dist)),
 			}
 		}
 	}
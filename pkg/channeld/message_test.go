@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/metaworking/channeld/internal/testpb"
 	"github.com/metaworking/channeld/pkg/channeldpb"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/protobuf/proto"
@@ -98,6 +99,9 @@ func TestMessageHandlers(t *testing.T) {
 		case msgType == channeldpb.MessageType_INVALID:
 		case msgType == channeldpb.MessageType_CHANNEL_DATA_HANDOVER:
 		case msgType == channeldpb.MessageType_SPATIAL_REGIONS_UPDATE:
+		case msgType == channeldpb.MessageType_ERROR_RESULT:
+		case msgType == channeldpb.MessageType_CHANNEL_PRESENCE:
+		case msgType == channeldpb.MessageType_CHANNEL_DATA_CONFLICT:
 		case value >= int32(channeldpb.MessageType_USER_SPACE_START):
 			continue
 		default:
@@ -178,3 +182,59 @@ func TestMessageCopy(t *testing.T) {
 	proto.Reset(msgCopy)
 	assert.Equal(t, channeldpb.ChannelType_UNKNOWN, msgCopy.ChannelType)
 }
+
+func TestGetChannelInfo(t *testing.T) {
+	InitLogs()
+	InitChannels()
+	InitConnections("../../config/server_conn_fsm_test.json", "../../config/client_non_authoratative_fsm.json")
+
+	settings := GlobalSettings.GetChannelSettings(channeldpb.ChannelType_SUBWORLD)
+	settings.ACLSettings.GetInfo = ChannelAccessLevel_OwnerOnly
+	GlobalSettings.ChannelSettings[channeldpb.ChannelType_SUBWORLD] = settings
+	defer delete(GlobalSettings.ChannelSettings, channeldpb.ChannelType_SUBWORLD)
+
+	server := addTestConnection(channeldpb.ConnectionType_SERVER)
+	ch, _ := CreateChannel(channeldpb.ChannelType_SUBWORLD, server)
+	// Stop the channel from being ticked by the scheduler
+	scheduler.unschedule(ch)
+	ch.InitData(&testpb.TestChannelDataMessage{Text: "hello"}, nil)
+	ch.metadata = "room"
+
+	client := addTestConnection(channeldpb.ConnectionType_CLIENT)
+	server.SubscribeToChannel(ch, nil)
+	client.SubscribeToChannel(ch, &channeldpb.ChannelSubscriptionOptions{FanOutIntervalMs: proto.Uint32(100)})
+
+	// The client has no access
+	handleGetChannelInfo(MessageContext{
+		MsgType:    channeldpb.MessageType_GET_CHANNEL_INFO,
+		Msg:        &channeldpb.GetChannelInfoMessage{},
+		Connection: client,
+		Channel:    ch,
+	})
+	assert.Equal(t, channeldpb.ErrorResultMessage_NO_ACCESS, client.latestMsg().(*channeldpb.ErrorResultMessage).ErrorCode)
+
+	handleGetChannelInfo(MessageContext{
+		MsgType:    channeldpb.MessageType_GET_CHANNEL_INFO,
+		Msg:        &channeldpb.GetChannelInfoMessage{},
+		Connection: server,
+		Channel:    ch,
+	})
+	info, ok := server.latestMsg().(*channeldpb.GetChannelInfoResultMessage)
+	assert.True(t, ok)
+	assert.EqualValues(t, ch.id, info.ChannelId)
+	assert.Equal(t, "room", info.Metadata)
+	assert.EqualValues(t, server.id, info.OwnerConnId)
+	assert.Equal(t, channeldpb.GetChannelInfoResultMessage_OPEN, info.State)
+	assert.Equal(t, "testpb.TestChannelDataMessage", info.DataMsgTypeName)
+	assert.EqualValues(t, proto.Size(ch.Data().msg), info.DataSize)
+	assert.Equal(t, 2, len(info.Subscribers))
+	for _, sub := range info.Subscribers {
+		if sub.ConnId == uint32(client.id) {
+			assert.Equal(t, channeldpb.ConnectionType_CLIENT, sub.ConnType)
+			assert.EqualValues(t, 100, sub.Options.GetFanOutIntervalMs())
+		} else {
+			assert.EqualValues(t, server.id, sub.ConnId)
+			assert.Equal(t, channeldpb.ConnectionType_SERVER, sub.ConnType)
+		}
+	}
+}
@@ -27,6 +27,14 @@ var msgSent = prometheus.NewCounterVec(
 	},
 	[]string{"connType" /*, "channel", "msgType"*/},
 )
+var msgRateLimited = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "messages_rate_limited",
+		Help: "Dropped messages due to the rate limit",
+	},
+	[]string{"connType"},
+)
+
 var packetReceived = prometheus.NewCounterVec(
 	prometheus.CounterOpts{
 		Name: "packets_in",
@@ -106,6 +114,30 @@ var channelTickDuration = prometheus.NewGaugeVec(
 	},
 	[]string{"type"},
 )
+var channelTickSkipped = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "channel_tick_skipped",
+		Help: "Skipped ticks of the idle channels",
+	},
+	[]string{"type"},
+)
+
+var channelInMsgQueueDepth = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Name: "channel_inbox_depth",
+		Help: "Number of messages waiting in the channels' inbox",
+	},
+	[]string{"type"},
+)
+
+var channelInMsgQueueOverflow = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "channel_inbox_overflow",
+		Help: "Dropped messages as the channel's inbox is full",
+	},
+	[]string{"type", "policy"},
+)
+
 var connectionClosed = prometheus.NewCounterVec(
 	prometheus.CounterOpts{
 		Name: "connection_closed",
@@ -118,6 +150,7 @@ func InitMetrics() {
 	prometheus.MustRegister(logNum)
 	prometheus.MustRegister(msgReceived)
 	prometheus.MustRegister(msgSent)
+	prometheus.MustRegister(msgRateLimited)
 	prometheus.MustRegister(packetReceived)
 	prometheus.MustRegister(packetSent)
 	prometheus.MustRegister(packetDropped)
@@ -128,5 +161,8 @@ func InitMetrics() {
 	prometheus.MustRegister(connectionNum)
 	prometheus.MustRegister(channelNum)
 	prometheus.MustRegister(channelTickDuration)
+	prometheus.MustRegister(channelTickSkipped)
+	prometheus.MustRegister(channelInMsgQueueDepth)
+	prometheus.MustRegister(channelInMsgQueueOverflow)
 	prometheus.MustRegister(connectionClosed)
 }
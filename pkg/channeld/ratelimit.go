@@ -0,0 +1,156 @@
+package channeld
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/metaworking/channeld/pkg/channeldpb"
+	"github.com/metaworking/channeld/pkg/fsm"
+	"go.uber.org/zap"
+)
+
+type RateLimitEscalation uint8
+
+const (
+	// Only drop the messages that exceed the limit.
+	RateLimitEscalation_None RateLimitEscalation = 0
+	// Close the connection.
+	RateLimitEscalation_Disconnect RateLimitEscalation = 1
+	// Close the connection and blacklist its PIT.
+	RateLimitEscalation_Blacklist RateLimitEscalation = 2
+)
+
+// Token-bucket limit of the message types. The bucket is filled at Rate tokens per second, up to Burst tokens.
+// Each received message of the message types takes a token. The message is dropped if there's no token left.
+type RateLimitRule struct {
+	// In the same format as the MsgTypeWhitelist of the FSM, e.g. "8, 100-65535"
+	MsgTypes string
+	Rate     float64
+	Burst    float64
+
+	msgTypeRanges []fsm.MsgTypeRange
+}
+
+type RateLimitSettingsType struct {
+	// The rules of each connection type. For a received message, the first rule that matches the message type applies.
+	Rules map[channeldpb.ConnectionType][]*RateLimitRule
+	// The number of dropped messages of a rule before the escalation. The count is reset once the bucket of the rule is refilled,
+	// so only the connections that keep exceeding the limit are escalated. 0 = never escalate.
+	MaxExceeded int
+	Escalation  RateLimitEscalation
+}
+
+// Parses the message types of the rules. Should be called after the settings are loaded.
+func (s *RateLimitSettingsType) Init() error {
+	for connType, rules := range s.Rules {
+		for _, rule := range rules {
+			ranges, err := fsm.ParseMsgTypeRanges(rule.MsgTypes)
+			if err != nil {
+				return fmt.Errorf("invalid rate limit rule of %s: %w", connType, err)
+			}
+			rule.msgTypeRanges = ranges
+		}
+	}
+	return nil
+}
+
+func (rule *RateLimitRule) matches(msgType uint32) bool {
+	for _, r := range rule.msgTypeRanges {
+		if r.Contains(msgType) {
+			return true
+		}
+	}
+	return false
+}
+
+type tokenBucket struct {
+	tokens     float64
+	lastRefill time.Time
+	// The number of dropped messages since the bucket was full last time.
+	exceeded int
+}
+
+// The rate limiter of a connection. Should only be accessed in the connection's receiving goroutine.
+type rateLimiter struct {
+	rules   []*RateLimitRule
+	buckets []tokenBucket
+	// The exceeded count of the bucket that dropped the last message.
+	exceededCount int
+}
+
+// Returns nil if there's no rate limit rule for the connection type.
+func newRateLimiter(connType channeldpb.ConnectionType) *rateLimiter {
+	rules := GlobalSettings.RateLimitSettings.Rules[connType]
+	if len(rules) == 0 {
+		return nil
+	}
+
+	l := &rateLimiter{
+		rules:   rules,
+		buckets: make([]tokenBucket, len(rules)),
+	}
+	now := time.Now()
+	for i, rule := range rules {
+		l.buckets[i] = tokenBucket{tokens: rule.Burst, lastRefill: now}
+	}
+	return l
+}
+
+// Takes a token of the first rule that matches the message type. Returns false if the limit is exceeded.
+func (l *rateLimiter) allow(msgType uint32, now time.Time) bool {
+	for i, rule := range l.rules {
+		if !rule.matches(msgType) {
+			continue
+		}
+
+		bucket := &l.buckets[i]
+		bucket.tokens += now.Sub(bucket.lastRefill).Seconds() * rule.Rate
+		if bucket.tokens >= rule.Burst {
+			bucket.tokens = rule.Burst
+			bucket.exceeded = 0
+		}
+		bucket.lastRefill = now
+
+		if bucket.tokens < 1 {
+			bucket.exceeded++
+			l.exceededCount = bucket.exceeded
+			return false
+		}
+		bucket.tokens--
+		return true
+	}
+	return true
+}
+
+func (c *Connection) onRateLimitExceeded(msgType uint32) {
+	msgRateLimited.WithLabelValues(c.connectionType.String()).Inc()
+	c.Logger().Debug("dropped message due to rate limit", zap.Uint32("msgType", msgType))
+
+	settings := GlobalSettings.RateLimitSettings
+	if settings.MaxExceeded <= 0 || c.rateLimiter.exceededCount < settings.MaxExceeded {
+		return
+	}
+
+	escalation := settings.Escalation
+	// The connection has not been authenticated yet, so there's no PIT to blacklist.
+	if escalation == RateLimitEscalation_Blacklist && c.pit == "" {
+		escalation = RateLimitEscalation_Disconnect
+	}
+	switch escalation {
+	case RateLimitEscalation_Disconnect:
+		securityLogger.Info("closed connection due to too many rate-limited messages",
+			zap.Uint32("connId", uint32(c.id)),
+			zap.String("connType", c.connectionType.String()),
+			zap.String("pit", c.pit),
+		)
+		c.Close()
+	case RateLimitEscalation_Blacklist:
+		pitBlacklist[c.pit] = time.Now()
+		securityLogger.Info("blacklisted PIT due to too many rate-limited messages",
+			zap.Uint32("connId", uint32(c.id)),
+			zap.String("connType", c.connectionType.String()),
+			zap.String("pit", c.pit),
+		)
+		c.Close()
+	}
+}
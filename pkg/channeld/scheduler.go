@@ -0,0 +1,248 @@
+package channeld
+
+import (
+	"math"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/metaworking/channeld/pkg/channeldpb"
+	"go.uber.org/zap"
+)
+
+const (
+	// The time span of a slot in the timer wheel.
+	SchedulerWheelResolution = 2 * time.Millisecond
+	// The number of slots in the timer wheel. The channels scheduled beyond the span of the wheel wait for more rounds.
+	SchedulerWheelSize = 1024
+	// An idle channel is still ticked at this interval, to detect the disconnected subscribers, etc.
+	IdleChannelTickInterval = time.Second
+)
+
+type schedulerEntry struct {
+	ch *Channel
+	// The number of remaining rounds of the wheel before the entry is due.
+	rounds int
+	// The time when the channel was ticked last time.
+	lastTickTime time.Time
+	// The slot that the entry is put in.
+	slot int
+	// Set to 1 if the channel is idle and put in the wheel at IdleChannelTickInterval. Accessed atomically.
+	parked int32
+}
+
+// Schedules the ticks of all channels with a timer wheel, and runs the ticks in a bounded pool of workers.
+//
+// A channel is put back to the wheel only after its tick is done, so the handlers of a channel always run serially.
+// When a channel is due but has nothing to do (no message, no pending fan-out), its tick is skipped and it's parked
+// until IdleChannelTickInterval, or until it's woken up by the new work (see Channel.wake).
+type channelScheduler struct {
+	lock       sync.Mutex
+	slots      [][]*schedulerEntry
+	cursor     int
+	startTime  time.Time
+	slotsTaken int64
+	jobs       chan *schedulerEntry
+}
+
+var scheduler *channelScheduler
+
+func newChannelScheduler(workerNum int) *channelScheduler {
+	if workerNum <= 0 {
+		workerNum = runtime.NumCPU()
+	}
+
+	s := &channelScheduler{
+		slots:     make([][]*schedulerEntry, SchedulerWheelSize),
+		startTime: time.Now(),
+		jobs:      make(chan *schedulerEntry, 1024),
+	}
+
+	for i := 0; i < workerNum; i++ {
+		go s.work()
+	}
+	go s.run()
+
+	rootLogger.Info("started channel scheduler", zap.Int("workers", workerNum))
+	return s
+}
+
+// Puts the channel into the wheel to be ticked after the delay.
+func (s *channelScheduler) schedule(entry *schedulerEntry, delay time.Duration) {
+	defer func() {
+		s.lock.Unlock()
+	}()
+	s.lock.Lock()
+
+	s.put(entry, delay)
+}
+
+// The caller should hold the lock.
+func (s *channelScheduler) put(entry *schedulerEntry, delay time.Duration) {
+	ticks := int((delay + SchedulerWheelResolution - 1) / SchedulerWheelResolution)
+	// Never put the entry in the current slot, as it has been taken.
+	if ticks < 1 {
+		ticks = 1
+	}
+
+	entry.rounds = (ticks - 1) / SchedulerWheelSize
+	entry.slot = (s.cursor + ticks) % SchedulerWheelSize
+	s.slots[entry.slot] = append(s.slots[entry.slot], entry)
+}
+
+// Puts the idle channel into the wheel to be ticked at IdleChannelTickInterval, or when its next scheduled message is due.
+func (s *channelScheduler) park(entry *schedulerEntry) {
+	delay := IdleChannelTickInterval - time.Since(entry.lastTickTime)
+	if next := ChannelTime(atomic.LoadInt64((*int64)(&entry.ch.nextScheduledTime))); next != math.MaxInt64 {
+		if untilNext := time.Duration(next - entry.ch.GetTime()); untilNext < delay {
+			delay = untilNext
+		}
+	}
+
+	defer func() {
+		s.lock.Unlock()
+	}()
+	s.lock.Lock()
+
+	s.put(entry, delay)
+	atomic.StoreInt32(&entry.parked, 1)
+}
+
+// Moves the parked channel in the wheel, to be ticked as soon as its tick interval allows.
+func (s *channelScheduler) wake(entry *schedulerEntry) {
+	if atomic.LoadInt32(&entry.parked) == 0 {
+		return
+	}
+
+	defer func() {
+		s.lock.Unlock()
+	}()
+	s.lock.Lock()
+
+	// Woken up by another goroutine, or due in the wheel.
+	if !atomic.CompareAndSwapInt32(&entry.parked, 1, 0) {
+		return
+	}
+	entries := s.slots[entry.slot]
+	for i, e := range entries {
+		if e == entry {
+			s.slots[entry.slot] = append(entries[:i], entries[i+1:]...)
+			break
+		}
+	}
+	s.put(entry, entry.ch.tickInterval-time.Since(entry.lastTickTime))
+}
+
+func (s *channelScheduler) run() {
+	ticker := time.NewTicker(SchedulerWheelResolution)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		// Catch up the slots if the ticker has fallen behind.
+		for s.slotsTaken < int64(time.Since(s.startTime)/SchedulerWheelResolution) {
+			s.slotsTaken++
+			for _, entry := range s.advance() {
+				s.dispatch(entry)
+			}
+		}
+	}
+}
+
+// Moves the cursor to the next slot, and returns the due entries in the slot.
+func (s *channelScheduler) advance() []*schedulerEntry {
+	defer func() {
+		s.lock.Unlock()
+	}()
+	s.lock.Lock()
+
+	s.cursor = (s.cursor + 1) % SchedulerWheelSize
+	entries := s.slots[s.cursor]
+	if len(entries) == 0 {
+		return nil
+	}
+
+	due := make([]*schedulerEntry, 0, len(entries))
+	remaining := entries[:0]
+	for _, entry := range entries {
+		if entry.rounds > 0 {
+			entry.rounds--
+			remaining = append(remaining, entry)
+		} else {
+			atomic.StoreInt32(&entry.parked, 0)
+			due = append(due, entry)
+		}
+	}
+	s.slots[s.cursor] = remaining
+	return due
+}
+
+func (s *channelScheduler) dispatch(entry *schedulerEntry) {
+	ch := entry.ch
+	// The removed channel is dropped from the scheduler.
+	if ch.IsRemoving() {
+		return
+	}
+
+	if !ch.needsTick(entry.lastTickTime) {
+		channelTickSkipped.WithLabelValues(ch.channelType.String()).Inc()
+		s.park(entry)
+		// The channel may have got new work before being parked.
+		if ch.needsTick(entry.lastTickTime) {
+			s.wake(entry)
+		}
+		return
+	}
+
+	s.jobs <- entry
+}
+
+func (s *channelScheduler) work() {
+	for entry := range s.jobs {
+		ch := entry.ch
+		if ch.IsRemoving() {
+			continue
+		}
+
+		tickStart := time.Now()
+		ch.tick(tickStart)
+		entry.lastTickTime = tickStart
+
+		if ch.IsRemoving() {
+			continue
+		}
+		s.schedule(entry, ch.tickInterval-time.Since(tickStart))
+	}
+}
+
+// Wakes up the channel if it's parked by the scheduler for being idle.
+// Should be called after the channel gets new work to do outside its goroutine.
+func (ch *Channel) wake() {
+	if ch.schedulerEntry != nil {
+		scheduler.wake(ch.schedulerEntry)
+	}
+}
+
+// Sets the pending fan-out of the channel, and wakes up the channel.
+func (ch *Channel) markFanOutPending() {
+	atomic.StoreInt32(&ch.fanOutPending, 1)
+	ch.wake()
+}
+
+// Returns true if the channel has any work to do in the next tick.
+func (ch *Channel) needsTick(lastTickTime time.Time) bool {
+	if len(ch.inMsgQueue) > 0 {
+		return true
+	}
+	if atomic.LoadInt32(&ch.fanOutPending) > 0 {
+		return true
+	}
+	if ch.hasDueScheduledMessage(ch.GetTime()) {
+		return true
+	}
+	// Run the code of SpatialController in every tick of the GLOBAL channel.
+	if ch.channelType == channeldpb.ChannelType_GLOBAL && spatialController != nil {
+		return true
+	}
+	return time.Since(lastTickTime) >= IdleChannelTickInterval
+}
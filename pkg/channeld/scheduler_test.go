@@ -0,0 +1,168 @@
+package channeld
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/metaworking/channeld/internal/testpb"
+	"github.com/metaworking/channeld/pkg/channeldpb"
+	"github.com/stretchr/testify/assert"
+)
+
+// Drops the channel from the scheduler, so the test can tick it manually.
+// Returns after the channel is no longer going to be ticked, including the tick in progress.
+func (s *channelScheduler) unschedule(ch *Channel) {
+	entry := ch.schedulerEntry
+	for !ch.IsRemoving() {
+		s.lock.Lock()
+		entries := s.slots[entry.slot]
+		for i, e := range entries {
+			if e == entry {
+				s.slots[entry.slot] = append(entries[:i], entries[i+1:]...)
+				// Never woken up again
+				atomic.StoreInt32(&entry.parked, 0)
+				s.lock.Unlock()
+				return
+			}
+		}
+		s.lock.Unlock()
+		// The entry is being dispatched or ticked. Wait for it to be put back in the wheel.
+		time.Sleep(SchedulerWheelResolution)
+	}
+}
+
+func TestSchedulerRunsHandlersSerially(t *testing.T) {
+	InitLogs()
+	InitChannels()
+
+	channels := make([]*Channel, 10)
+	for i := range channels {
+		channels[i], _ = CreateChannel(channeldpb.ChannelType_TEST, nil)
+	}
+
+	var executed int32
+	wg := sync.WaitGroup{}
+	for _, ch := range channels {
+		var running int32
+		for i := 0; i < 100; i++ {
+			wg.Add(1)
+			go func(ch *Channel) {
+				ch.Execute(func(ch *Channel) {
+					assert.EqualValues(t, 1, atomic.AddInt32(&running, 1))
+					time.Sleep(10 * time.Microsecond)
+					atomic.AddInt32(&running, -1)
+					atomic.AddInt32(&executed, 1)
+				})
+				wg.Done()
+			}(ch)
+		}
+	}
+	wg.Wait()
+
+	assert.Eventually(t, func() bool {
+		return atomic.LoadInt32(&executed) == int32(len(channels)*100)
+	}, 5*time.Second, 10*time.Millisecond)
+
+	for _, ch := range channels {
+		RemoveChannel(ch)
+	}
+}
+
+func TestSchedulerSkipsIdleChannel(t *testing.T) {
+	InitLogs()
+	InitChannels()
+	InitConnections("../../config/server_conn_fsm_test.json", "../../config/client_non_authoratative_fsm.json")
+
+	ch, _ := CreateChannel(channeldpb.ChannelType_TEST, nil)
+	// Stop the channel from being ticked by the scheduler
+	scheduler.unschedule(ch)
+
+	assert.False(t, ch.needsTick(time.Now()))
+	// Idle channel is still ticked periodically
+	assert.True(t, ch.needsTick(time.Now().Add(-IdleChannelTickInterval)))
+
+	ch.inMsgQueue <- channelMessage{handler: func(_ MessageContext) {}}
+	assert.True(t, ch.needsTick(time.Now()))
+	ch.tickMessages(time.Now())
+	assert.False(t, ch.needsTick(time.Now()))
+
+	// The new subscriber needs the first fan-out
+	ch.InitData(&testpb.TestChannelDataMessage{Text: "a"}, nil)
+	c := addTestConnectionWithProcessor(channeldpb.ConnectionType_CLIENT, testChannelDataMessageProcessor)
+	c.SubscribeToChannel(ch, nil)
+	assert.True(t, ch.needsTick(time.Now()))
+	ch.tickData(ch.GetTime().AddMs(100))
+	assert.False(t, ch.needsTick(time.Now()))
+
+	// The subscriber hasn't received the update yet
+	ch.Data().OnUpdate(&testpb.TestChannelDataMessage{Text: "b"}, ch.GetTime().AddMs(160), 0, nil)
+	ch.tickData(ch.GetTime().AddMs(150))
+	assert.True(t, ch.needsTick(time.Now()))
+	ch.tickData(ch.GetTime().AddMs(200))
+	assert.False(t, ch.needsTick(time.Now()))
+	assert.EqualValues(t, "b", c.latestMsg().(*testpb.TestChannelDataMessage).Text)
+}
+
+func TestSchedulerSkipsDataLessChannel(t *testing.T) {
+	InitLogs()
+	InitChannels()
+	InitConnections("../../config/server_conn_fsm_test.json", "../../config/client_non_authoratative_fsm.json")
+
+	ch, _ := CreateChannel(channeldpb.ChannelType_TEST, nil)
+	// Stop the channel from being ticked by the scheduler
+	scheduler.unschedule(ch)
+
+	c := addTestConnection(channeldpb.ConnectionType_CLIENT)
+	c.SubscribeToChannel(ch, nil)
+	assert.True(t, ch.needsTick(time.Now()))
+	// The channel has no data to fan out, so it becomes idle after the tick.
+	ch.tick(time.Now())
+	assert.False(t, ch.needsTick(time.Now()))
+
+	// The subscriber needs the first fan-out once the data is initialized.
+	ch.InitData(&testpb.TestChannelDataMessage{Text: "a"}, nil)
+	assert.True(t, ch.needsTick(time.Now()))
+	ch.tickData(ch.GetTime().AddMs(100))
+	assert.False(t, ch.needsTick(time.Now()))
+	assert.NotEmpty(t, c.testQueue())
+
+	RemoveChannel(ch)
+}
+
+func TestSchedulerParksIdleChannel(t *testing.T) {
+	InitLogs()
+	InitChannels()
+
+	ch, _ := CreateChannel(channeldpb.ChannelType_TEST, nil)
+	entry := ch.schedulerEntry
+	assert.Eventually(t, func() bool {
+		return atomic.LoadInt32(&entry.parked) == 1
+	}, time.Second, SchedulerWheelResolution)
+
+	// The parked channel is not dispatched in every tick interval.
+	scheduler.lock.Lock()
+	slot := entry.slot
+	scheduler.lock.Unlock()
+	time.Sleep(100 * time.Millisecond)
+	scheduler.lock.Lock()
+	assert.Equal(t, slot, entry.slot)
+	scheduler.lock.Unlock()
+	assert.EqualValues(t, 1, atomic.LoadInt32(&entry.parked))
+
+	// The new message wakes up the channel.
+	executed := make(chan struct{})
+	start := time.Now()
+	ch.Execute(func(ch *Channel) {
+		close(executed)
+	})
+	select {
+	case <-executed:
+		assert.Less(t, time.Since(start), IdleChannelTickInterval/2)
+	case <-time.After(IdleChannelTickInterval):
+		assert.Fail(t, "the parked channel is not woken up")
+	}
+
+	RemoveChannel(ch)
+}
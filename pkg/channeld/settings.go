@@ -7,6 +7,8 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/metaworking/channeld/pkg/channeldpb"
 	"github.com/metaworking/channeld/pkg/common"
@@ -40,12 +42,26 @@ type GlobalSettingsType struct {
 	ConnectionAuthTimeoutMs int64
 	MaxFailedAuthAttempts   int
 	MaxFsmDisallowed        int
+	RateLimitSettings       RateLimitSettingsType
 
 	SpatialControllerConfig NullableString
 	SpatialChannelIdStart   common.ChannelId
 	EntityChannelIdStart    common.ChannelId
 
+	// Swapped as a whole by ReloadSettings. Use GetChannelSettings and SetChannelSettings to access it from the other goroutines.
 	ChannelSettings map[channeldpb.ChannelType]ChannelSettingsType
+	// The path to the channel settings file. Reloaded along with the FSMs, see ReloadSettings.
+	ChannelSettingsPath string
+	// Moves the existing connections to the reloaded FSMs by the names of their current states.
+	// Otherwise, the reloaded FSMs only apply to the new connections.
+	MigrateFSMsOnReload bool
+	// The number of workers to run the channel ticks. 0 = the number of CPUs.
+	TickWorkers int
+	// The address to serve the admin HTTP endpoints, e.g. /reload. The endpoints are not authenticated,
+	// so the address should not be reachable from the public network. Empty = disabled.
+	AdminAddress string
+	// The max timeout of the messages that wait for multiple channels, e.g. GetChannelDataMessage. 0 = no limit.
+	MaxMultiChannelTimeoutMs uint32
 
 	EnableRecordPacket bool
 
@@ -53,9 +69,17 @@ type GlobalSettingsType struct {
 }
 
 type ACLSettingsType struct {
-	Sub    ChannelAccessLevel
-	Unsub  ChannelAccessLevel
-	Remove ChannelAccessLevel
+	Sub     ChannelAccessLevel
+	Unsub   ChannelAccessLevel
+	Remove  ChannelAccessLevel
+	GetInfo ChannelAccessLevel
+	GetData ChannelAccessLevel
+}
+
+// The max number of subscribers of a channel. The clients and servers are counted separately. 0 = no limit.
+type MaxSubscribersType struct {
+	Client uint32
+	Server uint32
 }
 
 type ChannelSettingsType struct {
@@ -64,8 +88,18 @@ type ChannelSettingsType struct {
 	DefaultFanOutDelayMs           int32
 	RemoveChannelAfterOwnerRemoved bool
 	ACLSettings                    ACLSettingsType
+	MaxSubscribers                 MaxSubscribersType
+	// Broadcast the join/leave events to all subscribers. Can be overridden by CreateChannelMessage.
+	EnablePresence bool
+	// The capacity of the channel's inbox. 0 = DefaultInMsgQueueCapacity.
+	InMsgQueueCapacity int
+	// What to do with the message from a connection when the channel's inbox is full.
+	InMsgQueueOverflowPolicy InMsgQueueOverflowPolicy
 	// Optinal. The full name of the Protobuf message type for the channel data (including the package name)
 	DataMsgFullName string
+	// Optional. The constraints of the fields in the ChannelDataUpdateMessage, keyed by the field path, e.g. "players.hp".
+	// The update that violates any constraint is rejected.
+	DataFieldValidators map[string]FieldValidatorSettings
 }
 
 var GlobalSettings = GlobalSettingsType{
@@ -79,20 +113,26 @@ var GlobalSettings = GlobalSettingsType{
 	ClientFSM:             "config/client_non_authoratative_fsm.json",
 	CompressionType:       channeldpb.CompressionType_NO_COMPRESSION,
 	// Mirror uses int32 as the connId
-	MaxConnectionIdBits:     31,
-	ConnectionAuthTimeoutMs: 5000,
-	MaxFailedAuthAttempts:   5,
-	MaxFsmDisallowed:        10,
-	SpatialChannelIdStart:   0x00010000,
-	EntityChannelIdStart:    0x00080000,
-	ChannelSettings: map[channeldpb.ChannelType]ChannelSettingsType{
+	MaxConnectionIdBits:      31,
+	ConnectionAuthTimeoutMs:  5000,
+	MaxFailedAuthAttempts:    5,
+	MaxFsmDisallowed:         10,
+	SpatialChannelIdStart:    0x00010000,
+	EntityChannelIdStart:     0x00080000,
+	ChannelSettings:          defaultChannelSettings(),
+	MaxMultiChannelTimeoutMs: 5000,
+}
+
+// The channel settings before the channel settings file is loaded.
+func defaultChannelSettings() map[channeldpb.ChannelType]ChannelSettingsType {
+	return map[channeldpb.ChannelType]ChannelSettingsType{
 		channeldpb.ChannelType_GLOBAL: {
 			TickIntervalMs:                 10,
 			DefaultFanOutIntervalMs:        20,
 			DefaultFanOutDelayMs:           0,
 			RemoveChannelAfterOwnerRemoved: false,
 		},
-	},
+	}
 }
 
 type NullableInt struct {
@@ -178,7 +218,13 @@ func (s *GlobalSettingsType) ParseFlag() error {
 	mfaa := flag.Int("mfaa", s.MaxFailedAuthAttempts, "the max number of failed authentication attempts before closing the connection. Default is 5. (0 = no limit)")
 	mfd := flag.Int("mfd", s.MaxFsmDisallowed, "the max number of disallowed FSM transitions before closing the connection. Default is 10. (0 = no limit)")
 
-	chs := flag.String("chs", "config/channel_settings_hifi.json", "the path to the channel settings file")
+	flag.IntVar(&s.TickWorkers, "tw", 0, "the number of workers to run the channel ticks. Default is the number of CPUs.")
+	mmct := flag.Uint("mmct", uint(s.MaxMultiChannelTimeoutMs), "the max timeout of the messages that wait for multiple channels. Default is 5000. (0 = no limit)")
+
+	flag.StringVar(&s.ChannelSettingsPath, "chs", "config/channel_settings_hifi.json", "the path to the channel settings file")
+	flag.BoolVar(&s.MigrateFSMsOnReload, "fsmm", false, "move the existing connections to the reloaded FSMs by the names of their current states?")
+	flag.StringVar(&s.AdminAddress, "aa", "", "the address to serve the admin HTTP endpoints, which are not authenticated, e.g. 127.0.0.1:8081. Empty = disabled.")
+	rls := flag.String("rls", "", "the path to the rate limit settings file. Default is no rate limit.")
 
 	flag.Parse()
 
@@ -210,19 +256,74 @@ func (s *GlobalSettingsType) ParseFlag() error {
 		s.MaxFsmDisallowed = int(*mfd)
 	}
 
-	chsData, err := os.ReadFile(*chs)
-	if err == nil {
-		if err := json.Unmarshal(chsData, &GlobalSettings.ChannelSettings); err != nil {
-			return fmt.Errorf("failed to unmarshall channel settings: %v", err)
+	if mmct != nil {
+		s.MaxMultiChannelTimeoutMs = uint32(*mmct)
+	}
+
+	channelSettings, err := loadChannelSettings(s.ChannelSettingsPath)
+	if err != nil {
+		return err
+	}
+	s.SetChannelSettings(channelSettings)
+
+	if *rls != "" {
+		rlsData, err := os.ReadFile(*rls)
+		if err != nil {
+			return fmt.Errorf("failed to read rate limit settings: %v", err)
+		}
+		if err := json.Unmarshal(rlsData, &s.RateLimitSettings); err != nil {
+			return fmt.Errorf("failed to unmarshall rate limit settings: %v", err)
+		}
+		if err := s.RateLimitSettings.Init(); err != nil {
+			return err
 		}
-	} else {
-		return fmt.Errorf("failed to read channel settings: %v", err)
 	}
 
 	return nil
 }
 
-func (s GlobalSettingsType) GetChannelSettings(t channeldpb.ChannelType) ChannelSettingsType {
+// Returns the timeout of the message that waits for multiple channels. 0 = the default timeout.
+// The timeout is capped by MaxMultiChannelTimeoutMs.
+func (s *GlobalSettingsType) multiChannelTimeout(timeoutMs uint32, defaultTimeoutMs uint32) time.Duration {
+	if timeoutMs == 0 {
+		timeoutMs = defaultTimeoutMs
+	}
+	if s.MaxMultiChannelTimeoutMs > 0 && timeoutMs > s.MaxMultiChannelTimeoutMs {
+		timeoutMs = s.MaxMultiChannelTimeoutMs
+	}
+	return time.Duration(timeoutMs) * time.Millisecond
+}
+
+// Guards the swap of GlobalSettings.ChannelSettings.
+var channelSettingsLock sync.RWMutex
+
+// Swaps the channel settings as a whole. The map should not be modified after the swap, so the readers in the other goroutines
+// get either the old or the new settings, never a mix of them.
+func (s *GlobalSettingsType) SetChannelSettings(settings map[channeldpb.ChannelType]ChannelSettingsType) {
+	channelSettingsLock.Lock()
+	defer channelSettingsLock.Unlock()
+	s.ChannelSettings = settings
+}
+
+// Returns the settings of all the channel types. The map should not be modified.
+func (s *GlobalSettingsType) allChannelSettings() map[channeldpb.ChannelType]ChannelSettingsType {
+	channelSettingsLock.RLock()
+	defer channelSettingsLock.RUnlock()
+	return s.ChannelSettings
+}
+
+// Same as GetChannelSettings, but doesn't fall back to the settings of the GLOBAL channel type.
+func (s *GlobalSettingsType) lookupChannelSettings(t channeldpb.ChannelType) (ChannelSettingsType, bool) {
+	channelSettingsLock.RLock()
+	defer channelSettingsLock.RUnlock()
+	settings, exists := s.ChannelSettings[t]
+	return settings, exists
+}
+
+// Returns the settings of the channel type, or the settings of the GLOBAL channel type if not set. Can be called in any goroutine.
+func (s *GlobalSettingsType) GetChannelSettings(t channeldpb.ChannelType) ChannelSettingsType {
+	channelSettingsLock.RLock()
+	defer channelSettingsLock.RUnlock()
 	settings, exists := s.ChannelSettings[t]
 	if !exists {
 		settings = s.ChannelSettings[channeldpb.ChannelType_GLOBAL]
@@ -0,0 +1,197 @@
+package channeld
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+
+	"github.com/metaworking/channeld/pkg/channeldpb"
+	"github.com/metaworking/channeld/pkg/fsm"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// Prevents the reloads from interleaving.
+var settingsReloadLock sync.Mutex
+
+// Reads the channel settings file on top of the default channel settings, and validates it.
+func loadChannelSettings(path string) (map[channeldpb.ChannelType]ChannelSettingsType, error) {
+	chsData, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read channel settings: %v", err)
+	}
+	settings := defaultChannelSettings()
+	if err := json.Unmarshal(chsData, &settings); err != nil {
+		return nil, fmt.Errorf("failed to unmarshall channel settings: %v", err)
+	}
+	if err := validateChannelSettings(settings); err != nil {
+		return nil, fmt.Errorf("invalid channel settings: %v", err)
+	}
+	return settings, nil
+}
+
+func validateChannelSettings(settings map[channeldpb.ChannelType]ChannelSettingsType) error {
+	for chType, s := range settings {
+		acl := s.ACLSettings
+		for _, level := range []ChannelAccessLevel{acl.Sub, acl.Unsub, acl.Remove, acl.GetInfo, acl.GetData} {
+			if level > ChannelAccessLevel_Any {
+				return fmt.Errorf("%s: %v: %d", chType, ErrIllegalAccessLevel, level)
+			}
+		}
+		if s.InMsgQueueCapacity < 0 {
+			return fmt.Errorf("%s: negative InMsgQueueCapacity: %d", chType, s.InMsgQueueCapacity)
+		}
+		if s.InMsgQueueOverflowPolicy > InMsgQueueOverflowPolicy_Disconnect {
+			return fmt.Errorf("%s: illegal InMsgQueueOverflowPolicy: %d", chType, s.InMsgQueueOverflowPolicy)
+		}
+		for path, v := range s.DataFieldValidators {
+			if v.Min != nil && v.Max != nil && *v.Min > *v.Max {
+				return fmt.Errorf("%s: the min of the validator '%s' is greater than the max", chType, path)
+			}
+			if v.MaxLen < 0 {
+				return fmt.Errorf("%s: negative MaxLen of the validator '%s': %d", chType, path, v.MaxLen)
+			}
+		}
+	}
+	return nil
+}
+
+// Returns the channel data types to register for the channel types that don't have one yet.
+// The registered types can't be changed, as the existing channels hold the data of the old types.
+func resolveNewChannelDataTypes(settings map[channeldpb.ChannelType]ChannelSettingsType) (map[channeldpb.ChannelType]proto.Message, error) {
+	newTypes := make(map[channeldpb.ChannelType]proto.Message)
+	for chType, s := range settings {
+		if s.DataMsgFullName == "" {
+			continue
+		}
+		if registered, exists := getChannelDataType(chType); exists {
+			if name := registered.ProtoReflect().Descriptor().FullName(); string(name) != s.DataMsgFullName {
+				return nil, fmt.Errorf("%s: the channel data type can't be changed from %s to %s without restarting", chType, name, s.DataMsgFullName)
+			}
+			continue
+		}
+		msgType, err := protoregistry.GlobalTypes.FindMessageByName(protoreflect.FullName(s.DataMsgFullName))
+		if err != nil {
+			return nil, fmt.Errorf("%s: failed to find message type %s for channel data: %v", chType, s.DataMsgFullName, err)
+		}
+		newTypes[chType] = msgType.New().Interface()
+	}
+	return newTypes, nil
+}
+
+func loadFSM(path string) (*fsm.FiniteStateMachine, error) {
+	bytes, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	f, err := fsm.Load(bytes)
+	if err != nil {
+		return nil, err
+	}
+	if len(f.States) == 0 {
+		return nil, errors.New("the FSM has no state")
+	}
+	return f, nil
+}
+
+// Reloads the channel settings and the FSMs from the files in GlobalSettings, without restarting channeld.
+// All the files are validated before applying, so nothing changes if any of them is invalid.
+//
+// The channel settings are swapped as a whole. The settings that are read upon use (e.g. ACLSettings, DefaultFanOutIntervalMs)
+// apply immediately; the ones that are read upon the creation of the channel (e.g. TickIntervalMs, InMsgQueueCapacity)
+// only apply to the new channels. The FSMs apply to the new connections, and also to the existing ones if
+// GlobalSettings.MigrateFSMsOnReload is set.
+func ReloadSettings() error {
+	settingsReloadLock.Lock()
+	defer settingsReloadLock.Unlock()
+
+	channelSettings, err := loadChannelSettings(GlobalSettings.ChannelSettingsPath)
+	if err != nil {
+		return err
+	}
+	newDataTypes, err := resolveNewChannelDataTypes(channelSettings)
+	if err != nil {
+		return fmt.Errorf("invalid channel settings: %v", err)
+	}
+	newServerFsm, err := loadFSM(GlobalSettings.ServerFSM)
+	if err != nil {
+		return fmt.Errorf("failed to load server FSM: %v", err)
+	}
+	newClientFsm, err := loadFSM(GlobalSettings.ClientFSM)
+	if err != nil {
+		return fmt.Errorf("failed to load client FSM: %v", err)
+	}
+
+	GlobalSettings.SetChannelSettings(channelSettings)
+	for chType, msg := range newDataTypes {
+		RegisterChannelDataType(chType, msg)
+	}
+
+	fsmLock.Lock()
+	serverFsm, clientFsm = newServerFsm, newClientFsm
+	fsmLock.Unlock()
+
+	migrated, failed := 0, 0
+	if GlobalSettings.MigrateFSMsOnReload {
+		allConnections.Range(func(_ ConnectionId, c *Connection) bool {
+			if c.IsClosing() {
+				return true
+			}
+			template := newClientFsm
+			if c.connectionType == channeldpb.ConnectionType_SERVER {
+				template = newServerFsm
+			}
+			if err := c.fsm.Migrate(template); err != nil {
+				c.Logger().Warn("failed to migrate the connection to the reloaded FSM, the old one is kept", zap.Error(err))
+				failed++
+			} else {
+				migrated++
+			}
+			return true
+		})
+	}
+
+	rootLogger.Info("reloaded settings",
+		zap.String("channelSettings", GlobalSettings.ChannelSettingsPath),
+		zap.String("serverFsm", GlobalSettings.ServerFSM),
+		zap.String("clientFsm", GlobalSettings.ClientFSM),
+		zap.Int("migratedConns", migrated),
+		zap.Int("failedConns", failed),
+	)
+	return nil
+}
+
+// Reloads the settings upon SIGHUP. See ReloadSettings.
+func StartSettingsReloader() {
+	go func() {
+		c := make(chan os.Signal, 1)
+		signal.Notify(c, syscall.SIGHUP)
+		for range c {
+			if err := ReloadSettings(); err != nil {
+				rootLogger.Error("failed to reload settings", zap.Error(err))
+			}
+		}
+	}()
+}
+
+// The admin HTTP endpoint to reload the settings. Only accepts POST. See ReloadSettings.
+// It's not authenticated, so it should only be served at GlobalSettings.AdminAddress, not along with the public metrics.
+func HandleReloadSettings(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if err := ReloadSettings(); err != nil {
+		rootLogger.Error("failed to reload settings", zap.Error(err))
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
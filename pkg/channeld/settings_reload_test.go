@@ -0,0 +1,78 @@
+package channeld
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/metaworking/channeld/pkg/channeldpb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReloadSettings(t *testing.T) {
+	InitLogs()
+	InitChannels()
+	InitConnections("../../config/server_conn_fsm_test.json", "../../config/client_non_authoratative_fsm.json")
+
+	oldSettings := GlobalSettings
+	oldServerFsm, oldClientFsm := serverFsm, clientFsm
+	defer func() {
+		GlobalSettings = oldSettings
+		serverFsm, clientFsm = oldServerFsm, oldClientFsm
+		delete(channelDataTypeRegistery, channeldpb.ChannelType_TEST4)
+	}()
+
+	dir := t.TempDir()
+	writeFile := func(name string, content string) string {
+		path := filepath.Join(dir, name)
+		assert.NoError(t, os.WriteFile(path, []byte(content), 0644))
+		return path
+	}
+	GlobalSettings.ChannelSettingsPath = writeFile("chs.json", `{"3": {"ACLSettings": {"GetInfo": 3}}}`)
+	GlobalSettings.ServerFSM = "../../config/server_conn_fsm_test.json"
+	GlobalSettings.ClientFSM = writeFile("cfsm.json", `{"States": [{"Name": "INIT", "MsgTypeWhitelist": "1"}, {"Name": "OPEN", "MsgTypeWhitelist": "7,21,26,99-65535"}]}`)
+
+	existingConn := addTestConnection(channeldpb.ConnectionType_CLIENT)
+	existingConn.fsm.ChangeState("OPEN")
+	assert.False(t, existingConn.fsm.IsAllowed(uint32(channeldpb.MessageType_GET_CHANNEL_INFO)))
+
+	assert.NoError(t, ReloadSettings())
+	assert.Equal(t, ChannelAccessLevel_Any, GlobalSettings.GetChannelSettings(channeldpb.ChannelType_SUBWORLD).ACLSettings.GetInfo)
+	// The default GLOBAL settings are kept.
+	assert.EqualValues(t, 10, GlobalSettings.GetChannelSettings(channeldpb.ChannelType_GLOBAL).TickIntervalMs)
+	// The existing connection is not migrated.
+	assert.False(t, existingConn.fsm.IsAllowed(uint32(channeldpb.MessageType_GET_CHANNEL_INFO)))
+	// The new connection uses the new FSM.
+	newConn := addTestConnection(channeldpb.ConnectionType_CLIENT)
+	newConn.fsm.ChangeState("OPEN")
+	assert.True(t, newConn.fsm.IsAllowed(uint32(channeldpb.MessageType_GET_CHANNEL_INFO)))
+
+	// Nothing changes if any file is invalid.
+	writeFile("chs.json", `{"3": {"ACLSettings": {"GetInfo": 5}}}`)
+	assert.Error(t, ReloadSettings())
+	writeFile("chs.json", `{"3": {"ACLSettings": {"GetInfo": 1}}}`)
+	GlobalSettings.ServerFSM = writeFile("sfsm.json", `{"States": []}`)
+	assert.Error(t, ReloadSettings())
+	GlobalSettings.ServerFSM = filepath.Join(dir, "nonexistent.json")
+	assert.Error(t, ReloadSettings())
+	assert.Equal(t, ChannelAccessLevel_Any, GlobalSettings.GetChannelSettings(channeldpb.ChannelType_SUBWORLD).ACLSettings.GetInfo)
+
+	// Migrate the existing connections by the state name.
+	GlobalSettings.ServerFSM = "../../config/server_conn_fsm_test.json"
+	GlobalSettings.MigrateFSMsOnReload = true
+	assert.NoError(t, ReloadSettings())
+	assert.Equal(t, ChannelAccessLevel_OwnerOnly, GlobalSettings.GetChannelSettings(channeldpb.ChannelType_SUBWORLD).ACLSettings.GetInfo)
+	assert.Equal(t, "OPEN", existingConn.fsm.CurrentState().Name)
+	assert.True(t, existingConn.fsm.IsAllowed(uint32(channeldpb.MessageType_GET_CHANNEL_INFO)))
+
+	// The channel data type can be added, but not changed.
+	writeFile("chs.json", `{"104": {"DataMsgFullName": "testpb.TestChannelDataMessage"}}`)
+	assert.NoError(t, ReloadSettings())
+	dataType, _ := getChannelDataType(channeldpb.ChannelType_TEST4)
+	assert.NotNil(t, dataType)
+	writeFile("chs.json", `{"104": {"DataMsgFullName": "testpb.TestMapMessage"}}`)
+	assert.Error(t, ReloadSettings())
+	writeFile("chs.json", `{"104": {"DataMsgFullName": "testpb.NonexistentMessage"}}`)
+	assert.Error(t, ReloadSettings())
+	assert.Equal(t, "testpb.TestChannelDataMessage", GlobalSettings.GetChannelSettings(channeldpb.ChannelType_TEST4).DataMsgFullName)
+}
@@ -26,13 +26,48 @@ func defaultSubOptions(t channeldpb.ChannelType) *channeldpb.ChannelSubscription
 		FanOutIntervalMs:     proto.Uint32(GlobalSettings.GetChannelSettings(t).DefaultFanOutIntervalMs),
 		SkipSelfUpdateFanOut: proto.Bool(true),
 		SkipFirstFanOut:      proto.Bool(false),
+		DeltaFanOut:          proto.Bool(false),
+		Priority:             proto.Uint32(0),
 	}
 	return options
 }
 
+// Returns the shortest fan-out interval of the subscription, which is the interval of the fastest tier if FanOutTiers is set.
+func (cs *ChannelSubscription) minFanOutIntervalMs() uint32 {
+	if len(cs.options.FanOutTiers) == 0 {
+		return *cs.options.FanOutIntervalMs
+	}
+	interval := cs.options.FanOutTiers[0].FanOutIntervalMs
+	for _, tier := range cs.options.FanOutTiers[1:] {
+		if tier.FanOutIntervalMs < interval {
+			interval = tier.FanOutIntervalMs
+		}
+	}
+	return interval
+}
+
+// Returns the longest fan-out interval of the subscription, including the intervals of the FanOutTiers.
+func (cs *ChannelSubscription) maxFanOutIntervalMs() uint32 {
+	interval := *cs.options.FanOutIntervalMs
+	for _, tier := range cs.options.FanOutTiers {
+		if tier.FanOutIntervalMs > interval {
+			interval = tier.FanOutIntervalMs
+		}
+	}
+	return interval
+}
+
+var ErrChannelFull = errors.New("channel has reached the max number of subscribers")
+
 func (c *Connection) SubscribeToChannel(ch *Channel, options *channeldpb.ChannelSubscriptionOptions) (*ChannelSubscription, bool) {
+	cs, exists, _ := c.subscribeToChannel(ch, options)
+	return cs, exists
+}
+
+// Same as SubscribeToChannel, but also returns the error if the subscription is refused.
+func (c *Connection) subscribeToChannel(ch *Channel, options *channeldpb.ChannelSubscriptionOptions) (*ChannelSubscription, bool, error) {
 	if c.IsClosing() {
-		return nil, false
+		return nil, false, errors.New("connection is closing")
 	}
 
 	defer func() {
@@ -48,9 +83,26 @@ func (c *Connection) SubscribeToChannel(ch *Channel, options *channeldpb.Channel
 				zap.Uint32("channelId", uint32(ch.id)),
 			)
 			proto.Merge(&cs.options, options)
+			if options.BandwidthBudget != nil {
+				c.fanOutBudget.setLimit(*options.BandwidthBudget)
+			}
+			if ch.data != nil && ch.data.maxFanOutIntervalMs < cs.maxFanOutIntervalMs() {
+				ch.data.maxFanOutIntervalMs = cs.maxFanOutIntervalMs()
+			}
 		}
-		return cs, exists
+		return cs, exists, nil
+	}
+
+	if err := ch.checkSubscriberCapacity(c); err != nil {
+		ch.Logger().Info("refused subscription",
+			zap.Uint32("connId", uint32(c.Id())),
+			zap.String("connType", c.connectionType.String()),
+			zap.Error(err),
+		)
+		return nil, false, err
 	}
+	// The reserved slot is taken by the subscription.
+	delete(ch.reservedSubSlots, c.id)
 
 	cs = &ChannelSubscription{
 		options: *defaultSubOptions(ch.channelType),
@@ -61,6 +113,9 @@ func (c *Connection) SubscribeToChannel(ch *Channel, options *channeldpb.Channel
 
 	if options != nil {
 		proto.Merge(&cs.options, options)
+		if options.BandwidthBudget != nil {
+			c.fanOutBudget.setLimit(*options.BandwidthBudget)
+		}
 	}
 
 	cs.fanOutElement = ch.fanOutQueue.PushFront(&fanOutConnection{
@@ -77,15 +132,18 @@ func (c *Connection) SubscribeToChannel(ch *Channel, options *channeldpb.Channel
 	// )
 
 	// Records the maximum fan-out interval for checking if the oldest update message is removable when the buffer is overflowed.
-	if ch.data != nil && ch.data.maxFanOutIntervalMs < *cs.options.FanOutIntervalMs {
-		ch.data.maxFanOutIntervalMs = *cs.options.FanOutIntervalMs
+	if ch.data != nil && ch.data.maxFanOutIntervalMs < cs.maxFanOutIntervalMs() {
+		ch.data.maxFanOutIntervalMs = cs.maxFanOutIntervalMs()
 	}
 	ch.subscribedConnections[c] = cs
+	ch.markFanOutPending()
 
 	if ch.channelType == channeldpb.ChannelType_SPATIAL {
 		c.spatialSubscriptions.Store(ch.id, &cs.options)
 	}
 
+	ch.notifyPresence(channeldpb.ChannelPresenceMessage_JOIN, c)
+
 	ch.Logger().Debug("subscribed connection",
 		zap.Uint32("connId", uint32(c.Id())),
 		zap.String("dataAccess", channeldpb.ChannelDataAccess_name[int32(*cs.options.DataAccess)]),
@@ -94,7 +152,120 @@ func (c *Connection) SubscribeToChannel(ch *Channel, options *channeldpb.Channel
 		zap.Bool("skipSelfUpdateFanOut", *cs.options.SkipSelfUpdateFanOut),
 		zap.Bool("skipFirstFanOut", *cs.options.SkipFirstFanOut),
 	)
-	return cs, false
+	return cs, false, nil
+}
+
+var ErrNotSubscribed = errors.New("connection is not subscribed to the channel")
+
+// Replaces the subscription options of the connection, without resubscribing. Unlike SubscribeToChannel, the options are not merged,
+// so the unset fields are reset to the defaults of the channel type. The BandwidthBudget is kept if not set, as it applies to the connection.
+// If the data field masks are changed, the next fan-out sends the full channel data, so the subscriber gets the fields that were filtered out.
+// Should be called in the channel's goroutine.
+func (c *Connection) UpdateSubscriptionOptions(ch *Channel, options *channeldpb.ChannelSubscriptionOptions) (*ChannelSubscription, error) {
+	defer func() {
+		ch.connectionsLock.Unlock()
+	}()
+	ch.connectionsLock.Lock()
+
+	cs, exists := ch.subscribedConnections[c]
+	if !exists {
+		return nil, ErrNotSubscribed
+	}
+
+	newOptions := defaultSubOptions(ch.channelType)
+	if options != nil {
+		proto.Merge(newOptions, options)
+		if options.BandwidthBudget != nil {
+			c.fanOutBudget.setLimit(*options.BandwidthBudget)
+		}
+	}
+	if newOptions.BandwidthBudget == nil {
+		newOptions.BandwidthBudget = cs.options.BandwidthBudget
+	}
+
+	masksChanged := fanOutMasksKey(cs.options.DataFieldMasks) != fanOutMasksKey(newOptions.DataFieldMasks) ||
+		len(cs.options.FanOutTiers) != len(newOptions.FanOutTiers)
+	for i := 0; !masksChanged && i < len(newOptions.FanOutTiers); i++ {
+		masksChanged = !proto.Equal(cs.options.FanOutTiers[i], newOptions.FanOutTiers[i])
+	}
+
+	// Keep the address of the options, which is also referenced by the spatial subscriptions.
+	proto.Reset(&cs.options)
+	proto.Merge(&cs.options, newOptions)
+
+	foc := cs.fanOutElement.Value.(*fanOutConnection)
+	if masksChanged {
+		foc.hadFirstFanOut = false
+		foc.tiers = nil
+	}
+	ch.updateMaxFanOutIntervalMs()
+	ch.markFanOutPending()
+
+	ch.Logger().Debug("updated subscription options",
+		zap.Uint32("connId", uint32(c.Id())),
+		zap.String("dataAccess", channeldpb.ChannelDataAccess_name[int32(*cs.options.DataAccess)]),
+		zap.Uint32("fanOutIntervalMs", *cs.options.FanOutIntervalMs),
+		zap.Strings("dataFieldMasks", cs.options.DataFieldMasks),
+		zap.Bool("resync", masksChanged),
+	)
+	return cs, nil
+}
+
+// Recomputes the max fan-out interval of all the subscriptions, which decides how long the update messages are kept in the buffer.
+// The caller should hold the connectionsLock.
+func (ch *Channel) updateMaxFanOutIntervalMs() {
+	if ch.data == nil {
+		return
+	}
+	var interval uint32 = 0
+	for _, cs := range ch.subscribedConnections {
+		if cs.maxFanOutIntervalMs() > interval {
+			interval = cs.maxFanOutIntervalMs()
+		}
+	}
+	ch.data.maxFanOutIntervalMs = interval
+}
+
+// Checks if the connection can take a subscriber slot of the channel. The reserved slots are counted as subscribers.
+// The caller should hold the connectionsLock.
+func (ch *Channel) checkSubscriberCapacity(conn ConnectionInChannel) error {
+	if _, reserved := ch.reservedSubSlots[conn.Id()]; reserved {
+		return nil
+	}
+
+	connType := conn.GetConnectionType()
+	var maxSubs uint32
+	switch connType {
+	case channeldpb.ConnectionType_CLIENT:
+		maxSubs = ch.maxSubscribers.Client
+	case channeldpb.ConnectionType_SERVER:
+		maxSubs = ch.maxSubscribers.Server
+	}
+	if maxSubs == 0 {
+		return nil
+	}
+
+	var count uint32 = 0
+	for sc := range ch.subscribedConnections {
+		if sc.GetConnectionType() == connType {
+			count++
+		}
+	}
+	for connId, t := range ch.reservedSubSlots {
+		// Release the slot if the connection is lost
+		if GetConnection(connId) == nil {
+			delete(ch.reservedSubSlots, connId)
+			continue
+		}
+		if t == connType {
+			count++
+		}
+	}
+
+	if count >= maxSubs {
+		return ErrChannelFull
+	}
+	return nil
 }
 
 func (c *Connection) UnsubscribeFromChannel(ch *Channel) (*channeldpb.ChannelSubscriptionOptions, error) {
@@ -116,10 +287,116 @@ func (c *Connection) UnsubscribeFromChannel(ch *Channel) (*channeldpb.ChannelSub
 		c.spatialSubscriptions.Delete(ch.id)
 	}
 
+	ch.notifyPresence(channeldpb.ChannelPresenceMessage_LEAVE, c)
+
 	ch.Logger().Debug("unsubscribed connection", zap.Uint32("connId", uint32(c.Id())))
 	return &cs.options, nil
 }
 
+// The error of subscribing or unsubscribing a connection, with the code of the ErrorResultMessage.
+type subscriptionError struct {
+	code channeldpb.ErrorResultMessage_ErrorCode
+	err  error
+}
+
+// Subscribes the connection to the channel on behalf of the sender, after checking the ACL and the channel behavior.
+// The subscribed (if it's not the sender) and the channel owner are notified, but the sender is left to the caller.
+// Shared by SUB_TO_CHANNEL, BATCH_SUBSCRIBE and AUTO_SUBSCRIBE. Should be called in the channel's goroutine.
+func (ch *Channel) subscribeConnection(sender ConnectionInChannel, connToSub *Connection, subOptions *channeldpb.ChannelSubscriptionOptions) (*ChannelSubscription, *subscriptionError) {
+	hasAccess, err := ch.CheckACL(sender, ChannelAccessType_Sub)
+	if connToSub.Id() != sender.Id() && !hasAccess {
+		sender.Logger().Warn("connection doesn't have access to sub connection to this channel",
+			zap.Uint32("subConnId", uint32(connToSub.Id())),
+			zap.String("channelType", ch.channelType.String()),
+			zap.Uint32("channelId", uint32(ch.id)),
+			zap.Error(err),
+		)
+		return nil, &subscriptionError{channeldpb.ErrorResultMessage_NO_ACCESS, err}
+	}
+
+	subOptions = ch.keepDataAccess(sender, connToSub, subOptions, false)
+
+	if ch.behavior != nil {
+		if err := ch.behavior.OnSubscribe(ch, connToSub, subOptions); err != nil {
+			ch.Logger().Info("subscription is vetoed by the channel behavior",
+				zap.Uint32("subConnId", uint32(connToSub.Id())),
+				zap.Error(err),
+			)
+			return nil, &subscriptionError{channeldpb.ErrorResultMessage_NO_ACCESS, err}
+		}
+	}
+
+	cs, alreadySubed, err := connToSub.subscribeToChannel(ch, subOptions)
+	if err == ErrChannelFull {
+		return nil, &subscriptionError{channeldpb.ErrorResultMessage_CHANNEL_FULL, err}
+	}
+	if cs == nil {
+		if err == nil {
+			err = errors.New("failed to subscribe to the channel")
+		}
+		return nil, &subscriptionError{channeldpb.ErrorResultMessage_UNKNOWN_ERROR, err}
+	}
+
+	ctx := MessageContext{Channel: ch}
+	// Notify the subscribed if it's not the sender.
+	if connToSub != sender {
+		connToSub.sendSubscribed(ctx, ch, connToSub, 0, &cs.options)
+	}
+
+	// Notify the channel owner if not already subed and it's not the sender.
+	if !alreadySubed && ch.HasOwner() && ch.ownerConnection != sender {
+		ch.ownerConnection.sendSubscribed(ctx, ch, connToSub, 0, &cs.options)
+	}
+
+	return cs, nil
+}
+
+// Unsubscribes the connection from the channel on behalf of the sender, after checking the ACL.
+// The unsubscribed (if it's not the sender) and the channel owner are notified, but the sender is left to the caller.
+// The channel owner is reset if it unsubscribes itself. Should be called in the channel's goroutine.
+func (ch *Channel) unsubscribeConnection(sender ConnectionInChannel, connToUnsub *Connection) *subscriptionError {
+	hasAccess, accessErr := ch.CheckACL(sender, ChannelAccessType_Unsub)
+	if connToUnsub.Id() != sender.Id() && !hasAccess {
+		sender.Logger().Warn("connection dosen't have access to unsub connection from this channel",
+			zap.Uint32("unsubConnId", uint32(connToUnsub.Id())),
+			zap.String("channelType", ch.channelType.String()),
+			zap.Uint32("channelId", uint32(ch.id)),
+			zap.Error(accessErr),
+		)
+		return &subscriptionError{channeldpb.ErrorResultMessage_NO_ACCESS, accessErr}
+	}
+
+	if _, err := connToUnsub.UnsubscribeFromChannel(ch); err != nil {
+		sender.Logger().Warn("failed to unsub from channel",
+			zap.String("channelType", ch.channelType.String()),
+			zap.Uint32("channelId", uint32(ch.id)),
+			zap.Error(err),
+		)
+		return &subscriptionError{channeldpb.ErrorResultMessage_INVALID_MESSAGE, err}
+	}
+
+	if ch.behavior != nil {
+		ch.behavior.OnUnsubscribe(ch, connToUnsub)
+	}
+
+	ctx := MessageContext{Channel: ch}
+	// Notify the unsubscribed.
+	if connToUnsub != sender {
+		connToUnsub.sendUnsubscribed(ctx, ch, connToUnsub, 0)
+	}
+	// Notify the channel owner.
+	if ch.HasOwner() {
+		if ch.ownerConnection != sender && ch.ownerConnection != connToUnsub {
+			ch.ownerConnection.sendUnsubscribed(ctx, ch, connToUnsub, 0)
+		} else if ch.ownerConnection == connToUnsub {
+			// Reset the owner if it unsubscribed itself
+			ch.ownerConnection = nil
+		}
+	}
+
+	return nil
+}
+
 /*
 func (c *Connection) sendConnSubscribed(connId ConnectionId, ids ...ChannelId) {
 	channelIds := make([]uint32, len(ids))
@@ -174,6 +451,45 @@ func (c *Connection) sendUnsubscribed(ctx MessageContext, ch *Channel, connToUns
 	c.Send(ctx)
 }
 
+// Sends the JOIN/LEAVE event of the connection to the other subscribers, and the MEMBER_LIST to the newcomer, if the channel has presence enabled.
+// The caller should hold the connectionsLock.
+func (ch *Channel) notifyPresence(eventType channeldpb.ChannelPresenceMessage_EventType, conn ConnectionInChannel) {
+	if !ch.enablePresence {
+		return
+	}
+
+	ctx := MessageContext{
+		MsgType:   channeldpb.MessageType_CHANNEL_PRESENCE,
+		ChannelId: uint32(ch.id),
+		Msg: &channeldpb.ChannelPresenceMessage{
+			EventType: eventType,
+			Members: []*channeldpb.ChannelPresenceMessage_MemberInfo{
+				{ConnId: uint32(conn.Id()), ConnType: conn.GetConnectionType()},
+			},
+		},
+	}
+	for sc := range ch.subscribedConnections {
+		if sc != conn && !sc.IsClosing() {
+			sc.Send(ctx)
+		}
+	}
+
+	if eventType == channeldpb.ChannelPresenceMessage_JOIN {
+		members := make([]*channeldpb.ChannelPresenceMessage_MemberInfo, 0, len(ch.subscribedConnections))
+		for sc := range ch.subscribedConnections {
+			members = append(members, &channeldpb.ChannelPresenceMessage_MemberInfo{
+				ConnId:   uint32(sc.Id()),
+				ConnType: sc.GetConnectionType(),
+			})
+		}
+		ctx.Msg = &channeldpb.ChannelPresenceMessage{
+			EventType: channeldpb.ChannelPresenceMessage_MEMBER_LIST,
+			Members:   members,
+		}
+		conn.Send(ctx)
+	}
+}
+
 func (c *Connection) HasInterestIn(spatialChId common.ChannelId) bool {
 	if c.spatialSubscriptions == nil {
 		return false
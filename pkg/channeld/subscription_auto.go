@@ -0,0 +1,233 @@
+package channeld
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/metaworking/channeld/pkg/channeldpb"
+	"github.com/metaworking/channeld/pkg/common"
+	"go.uber.org/zap"
+)
+
+// A standing rule to subscribe a server connection to the channels that match the filters. See AutoSubscribeMessage.
+type autoSubRule struct {
+	id              uint32
+	conn            *Connection
+	typeFilter      channeldpb.ChannelType
+	metadataFilters []string
+	attributeFilter *channeldpb.ChannelAttributeFilter
+	subOptions      *channeldpb.ChannelSubscriptionOptions
+}
+
+var autoSubRules = make(map[uint32]*autoSubRule)
+var autoSubRulesLock sync.RWMutex
+var nextAutoSubRuleId uint32 = 0
+
+// Should be called in the GLOBAL channel, as the metadata of the channel is set there.
+func (rule *autoSubRule) match(ch *Channel) bool {
+	if ch.channelType == channeldpb.ChannelType_GLOBAL {
+		return false
+	}
+	if rule.typeFilter != channeldpb.ChannelType_UNKNOWN && rule.typeFilter != ch.channelType {
+		return false
+	}
+	return matchMetadataFilters(ch.metadata, rule.metadataFilters) && matchAttributeFilter(ch.GetAttributes(), rule.attributeFilter)
+}
+
+// Subscribes the connection of the rule in the channel's goroutine. Called in the GLOBAL channel's goroutine,
+// so it never blocks: the subscription is skipped if the channel is being removed or its inbox is full.
+func (rule *autoSubRule) apply(ch *Channel) {
+	if !ch.tryExecute(func(ch *Channel) {
+		if rule.conn.IsClosing() || ch.IsRemoving() {
+			return
+		}
+
+		ch.connectionsLock.RLock()
+		_, subed := ch.subscribedConnections[rule.conn]
+		ch.connectionsLock.RUnlock()
+		// Don't override the options of the existing subscription.
+		if subed {
+			return
+		}
+
+		cs, err := ch.subscribeConnection(rule.conn, rule.conn, rule.subOptions)
+		if err != nil {
+			rule.conn.Logger().Info("failed to auto-subscribe to the channel",
+				zap.Uint32("ruleId", rule.id),
+				zap.String("channelType", ch.channelType.String()),
+				zap.Uint32("channelId", uint32(ch.id)),
+				zap.Error(err.err),
+			)
+			return
+		}
+		rule.conn.sendSubscribed(MessageContext{}, ch, rule.conn, 0, &cs.options)
+
+		ch.Logger().Debug("auto-subscribed connection",
+			zap.Uint32("connId", uint32(rule.conn.Id())),
+			zap.Uint32("ruleId", rule.id),
+		)
+	}) {
+		rule.conn.Logger().Warn("failed to auto-subscribe to the channel",
+			zap.Uint32("ruleId", rule.id),
+			zap.String("channelType", ch.channelType.String()),
+			zap.Uint32("channelId", uint32(ch.id)),
+			zap.Error(ErrChannelUnavailable),
+		)
+	}
+}
+
+func addAutoSubRule(conn *Connection, msg *channeldpb.AutoSubscribeMessage) *autoSubRule {
+	autoSubRulesLock.Lock()
+	defer autoSubRulesLock.Unlock()
+
+	hasRule := false
+	for _, rule := range autoSubRules {
+		if rule.conn == conn {
+			hasRule = true
+			break
+		}
+	}
+	if !hasRule {
+		conn.AddCloseHandler(func() {
+			removeAutoSubRules(conn)
+		})
+	}
+
+	nextAutoSubRuleId++
+	rule := &autoSubRule{
+		id:              nextAutoSubRuleId,
+		conn:            conn,
+		typeFilter:      msg.TypeFilter,
+		metadataFilters: msg.MetadataFilters,
+		attributeFilter: msg.AttributeFilter,
+		subOptions:      msg.SubOptions,
+	}
+	autoSubRules[rule.id] = rule
+	return rule
+}
+
+// Removes all the rules of the connection.
+func removeAutoSubRules(conn *Connection) {
+	autoSubRulesLock.Lock()
+	defer autoSubRulesLock.Unlock()
+
+	for id, rule := range autoSubRules {
+		if rule.conn == conn {
+			delete(autoSubRules, id)
+		}
+	}
+}
+
+// Listens to Event_ChannelCreated. The metadata and attributes of the new channel are set after the event is broadcasted,
+// so the rules are checked in the next message of the GLOBAL channel, where the channel is created.
+// The event is usually broadcasted in the GLOBAL channel's goroutine, so the message is enqueued without blocking.
+func onChannelCreatedForAutoSub(ch *Channel) {
+	if globalChannel == nil || ch == globalChannel {
+		return
+	}
+
+	autoSubRulesLock.RLock()
+	noRule := len(autoSubRules) == 0
+	autoSubRulesLock.RUnlock()
+	if noRule {
+		return
+	}
+
+	if !globalChannel.tryExecute(func(_ *Channel) {
+		applyAutoSubRules(ch)
+	}) {
+		globalChannel.Logger().Warn("failed to check the auto-subscription rules of the new channel",
+			zap.Uint32("channelId", uint32(ch.id)),
+			zap.Error(ErrChannelUnavailable),
+		)
+	}
+}
+
+func applyAutoSubRules(ch *Channel) {
+	if ch.IsRemoving() {
+		return
+	}
+
+	matchedRules := make([]*autoSubRule, 0)
+	autoSubRulesLock.RLock()
+	for _, rule := range autoSubRules {
+		if rule.match(ch) {
+			matchedRules = append(matchedRules, rule)
+		}
+	}
+	autoSubRulesLock.RUnlock()
+
+	for _, rule := range matchedRules {
+		rule.apply(ch)
+	}
+}
+
+func handleAutoSubscribe(ctx MessageContext) {
+	if ctx.Channel != globalChannel {
+		ctx.Connection.Logger().Error("illegal attemp to add auto-subscription rule outside the GLOBAL channel")
+		return
+	}
+
+	msg, ok := ctx.Msg.(*channeldpb.AutoSubscribeMessage)
+	if !ok {
+		ctx.Connection.Logger().Error("message is not an AutoSubscribeMessage, will not be handled.")
+		return
+	}
+
+	conn, ok := ctx.Connection.(*Connection)
+	if !ok || conn.GetConnectionType() != channeldpb.ConnectionType_SERVER {
+		ctx.Connection.Logger().Warn("illegal attempt to add auto-subscription rule as the connection is not a server connection")
+		ctx.SendErrorResult(channeldpb.ErrorResultMessage_NO_ACCESS, errors.New("only the server connection can add the auto-subscription rules"))
+		return
+	}
+
+	if msg.TypeFilter == channeldpb.ChannelType_GLOBAL {
+		ctx.SendErrorResult(channeldpb.ErrorResultMessage_INVALID_MESSAGE, errors.New("can't auto-subscribe to the GLOBAL channel"))
+		return
+	}
+
+	rule := addAutoSubRule(conn, msg)
+	conn.Logger().Info("added auto-subscription rule",
+		zap.Uint32("ruleId", rule.id),
+		zap.String("typeFilter", rule.typeFilter.String()),
+		zap.Strings("metadataFilters", rule.metadataFilters),
+	)
+
+	ctx.Msg = &channeldpb.AutoSubscribeResultMessage{RuleId: rule.id}
+	ctx.Connection.Send(ctx)
+
+	if msg.IncludeExisting {
+		allChannels.Range(func(_ common.ChannelId, ch *Channel) bool {
+			if !ch.IsRemoving() && rule.match(ch) {
+				rule.apply(ch)
+			}
+			return true
+		})
+	}
+}
+
+func handleRemoveAutoSubscribe(ctx MessageContext) {
+	if ctx.Channel != globalChannel {
+		ctx.Connection.Logger().Error("illegal attemp to remove auto-subscription rule outside the GLOBAL channel")
+		return
+	}
+
+	msg, ok := ctx.Msg.(*channeldpb.RemoveAutoSubscribeMessage)
+	if !ok {
+		ctx.Connection.Logger().Error("message is not a RemoveAutoSubscribeMessage, will not be handled.")
+		return
+	}
+
+	autoSubRulesLock.Lock()
+	defer autoSubRulesLock.Unlock()
+
+	rule, exists := autoSubRules[msg.RuleId]
+	if !exists || rule.conn != ctx.Connection {
+		ctx.Connection.Logger().Warn("failed to remove auto-subscription rule", zap.Uint32("ruleId", msg.RuleId))
+		ctx.SendErrorResult(channeldpb.ErrorResultMessage_INVALID_MESSAGE, fmt.Errorf("auto-subscription rule %d doesn't exist", msg.RuleId))
+		return
+	}
+	delete(autoSubRules, msg.RuleId)
+	ctx.Connection.Logger().Info("removed auto-subscription rule", zap.Uint32("ruleId", msg.RuleId))
+}
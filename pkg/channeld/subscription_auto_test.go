@@ -0,0 +1,132 @@
+package channeld
+
+import (
+	"testing"
+	"time"
+
+	"github.com/metaworking/channeld/pkg/channeldpb"
+	"github.com/metaworking/channeld/pkg/common"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestAutoSubscribe(t *testing.T) {
+	InitLogs()
+	InitChannels()
+	InitConnections("../../config/server_conn_fsm_test.json", "../../config/client_non_authoratative_fsm.json")
+
+	owner := addTestConnection(channeldpb.ConnectionType_SERVER)
+	spectator := addTestConnection(channeldpb.ConnectionType_SERVER)
+	client := addTestConnection(channeldpb.ConnectionType_CLIENT)
+
+	isSubscribed := func(conn *Connection, ch *Channel) bool {
+		ch.connectionsLock.RLock()
+		defer ch.connectionsLock.RUnlock()
+		_, subed := ch.subscribedConnections[conn]
+		return subed
+	}
+	createChannel := func(metadata string, attributes map[string]*channeldpb.ChannelAttributeValue) *Channel {
+		handleCreateChannel(MessageContext{
+			MsgType:    channeldpb.MessageType_CREATE_CHANNEL,
+			Msg:        &channeldpb.CreateChannelMessage{ChannelType: channeldpb.ChannelType_SUBWORLD, Metadata: metadata, Attributes: attributes},
+			Connection: owner,
+			Channel:    globalChannel,
+		})
+		// The owner also receives the subscription result of the channel, and maybe the auto-subscriptions of the other channels.
+		queue := owner.testQueue()
+		for i := len(queue) - 1; i >= 0; i-- {
+			if result, ok := queue[i].(*channeldpb.CreateChannelResultMessage); ok {
+				return GetChannel(common.ChannelId(result.ChannelId))
+			}
+		}
+		return nil
+	}
+
+	existingCh := createChannel("arena-0", map[string]*channeldpb.ChannelAttributeValue{
+		"private": {Value: &channeldpb.ChannelAttributeValue_BoolValue{BoolValue: false}},
+	})
+
+	// Only the server connection can add the rules.
+	handleAutoSubscribe(MessageContext{
+		MsgType:    channeldpb.MessageType_AUTO_SUBSCRIBE,
+		Msg:        &channeldpb.AutoSubscribeMessage{},
+		Connection: client,
+		Channel:    globalChannel,
+	})
+	assert.Equal(t, channeldpb.ErrorResultMessage_NO_ACCESS, client.latestMsg().(*channeldpb.ErrorResultMessage).ErrorCode)
+
+	handleAutoSubscribe(MessageContext{
+		MsgType: channeldpb.MessageType_AUTO_SUBSCRIBE,
+		Msg: &channeldpb.AutoSubscribeMessage{
+			TypeFilter:      channeldpb.ChannelType_SUBWORLD,
+			MetadataFilters: []string{"arena"},
+			AttributeFilter: &channeldpb.ChannelAttributeFilter{Op: channeldpb.ChannelAttributeFilter_NE, Key: "private",
+				Value: &channeldpb.ChannelAttributeValue{Value: &channeldpb.ChannelAttributeValue_BoolValue{BoolValue: true}}},
+			SubOptions:      &channeldpb.ChannelSubscriptionOptions{DataAccess: channeldpb.ChannelDataAccess_NO_ACCESS.Enum(), FanOutIntervalMs: proto.Uint32(500)},
+			IncludeExisting: true,
+		},
+		Connection: spectator,
+		Channel:    globalChannel,
+	})
+	result, ok := spectator.latestMsg().(*channeldpb.AutoSubscribeResultMessage)
+	assert.True(t, ok)
+	ruleId := result.RuleId
+	assert.Eventually(t, func() bool { return isSubscribed(spectator, existingCh) }, time.Second, 10*time.Millisecond)
+
+	// The metadata and attributes are set after the channel is created.
+	matchedCh := createChannel("arena-1", map[string]*channeldpb.ChannelAttributeValue{
+		"private": {Value: &channeldpb.ChannelAttributeValue_BoolValue{BoolValue: false}},
+	})
+	privateCh := createChannel("arena-2", map[string]*channeldpb.ChannelAttributeValue{
+		"private": {Value: &channeldpb.ChannelAttributeValue_BoolValue{BoolValue: true}},
+	})
+	lobbyCh := createChannel("lobby", nil)
+	assert.Eventually(t, func() bool { return isSubscribed(spectator, matchedCh) }, time.Second, 10*time.Millisecond)
+	matchedCh.connectionsLock.RLock()
+	assert.Equal(t, channeldpb.ChannelDataAccess_NO_ACCESS, matchedCh.subscribedConnections[spectator].options.GetDataAccess())
+	assert.EqualValues(t, 500, matchedCh.subscribedConnections[spectator].options.GetFanOutIntervalMs())
+	matchedCh.connectionsLock.RUnlock()
+	// Wait for the GLOBAL channel to check the rest of the channels.
+	time.Sleep(100 * time.Millisecond)
+	assert.False(t, isSubscribed(spectator, privateCh))
+	assert.False(t, isSubscribed(spectator, lobbyCh))
+
+	// Only the connection that adds the rule can remove it.
+	handleRemoveAutoSubscribe(MessageContext{
+		MsgType:    channeldpb.MessageType_REMOVE_AUTO_SUBSCRIBE,
+		Msg:        &channeldpb.RemoveAutoSubscribeMessage{RuleId: ruleId},
+		Connection: owner,
+		Channel:    globalChannel,
+	})
+	assert.Equal(t, channeldpb.ErrorResultMessage_INVALID_MESSAGE, owner.latestMsg().(*channeldpb.ErrorResultMessage).ErrorCode)
+	handleRemoveAutoSubscribe(MessageContext{
+		MsgType:    channeldpb.MessageType_REMOVE_AUTO_SUBSCRIBE,
+		Msg:        &channeldpb.RemoveAutoSubscribeMessage{RuleId: ruleId},
+		Connection: spectator,
+		Channel:    globalChannel,
+	})
+	autoSubRulesLock.RLock()
+	assert.NotContains(t, autoSubRules, ruleId)
+	autoSubRulesLock.RUnlock()
+
+	// The rules are removed when the connection is closed.
+	handleAutoSubscribe(MessageContext{
+		MsgType:    channeldpb.MessageType_AUTO_SUBSCRIBE,
+		Msg:        &channeldpb.AutoSubscribeMessage{},
+		Connection: spectator,
+		Channel:    globalChannel,
+	})
+	ruleId = spectator.latestMsg().(*channeldpb.AutoSubscribeResultMessage).RuleId
+	spectator.Close()
+	autoSubRulesLock.RLock()
+	assert.NotContains(t, autoSubRules, ruleId)
+	autoSubRulesLock.RUnlock()
+
+	// Applying the rule to a removed channel neither blocks nor panics.
+	RemoveChannel(lobbyCh)
+	(&autoSubRule{conn: owner}).apply(lobbyCh)
+
+	for _, ch := range []*Channel{existingCh, matchedCh, privateCh} {
+		RemoveChannel(ch)
+	}
+}
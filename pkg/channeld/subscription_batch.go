@@ -0,0 +1,167 @@
+package channeld
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/metaworking/channeld/pkg/channeldpb"
+	"github.com/metaworking/channeld/pkg/common"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+)
+
+const DefaultBatchSubscribeTimeoutMs = 1000
+
+var ErrBatchSubscribeTimeout = errors.New("timed out subscribing or unsubscribing in the channel")
+
+type batchSubscribeResult struct {
+	// The index in BatchSubscribeMessage.Subs or BatchSubscribeMessage.UnsubChannelIds
+	index   int
+	isSub   bool
+	options *channeldpb.ChannelSubscriptionOptions
+	err     *subscriptionError
+}
+
+func handleBatchSubscribe(ctx MessageContext) {
+	if ctx.Channel != globalChannel {
+		ctx.Connection.Logger().Error("illegal attemp to batch subscribe outside the GLOBAL channel")
+		return
+	}
+
+	msg, ok := ctx.Msg.(*channeldpb.BatchSubscribeMessage)
+	if !ok {
+		ctx.Connection.Logger().Error("message is not a BatchSubscribeMessage, will not be handled.")
+		return
+	}
+
+	if len(msg.Subs) == 0 && len(msg.UnsubChannelIds) == 0 {
+		ctx.SendErrorResult(channeldpb.ErrorResultMessage_INVALID_MESSAGE, errors.New("no channel to subscribe or unsubscribe"))
+		return
+	}
+
+	var connToSub *Connection
+	if ctx.Connection.GetConnectionType() == channeldpb.ConnectionType_CLIENT {
+		connToSub = ctx.Connection.(*Connection)
+	} else {
+		// Only the server can specify a ConnId.
+		connToSub = GetConnection(ConnectionId(msg.ConnId))
+	}
+
+	if connToSub == nil {
+		ctx.Connection.Logger().Error("invalid ConnectionId for batch subscribe", zap.Uint32("connIdInMsg", msg.ConnId))
+		ctx.SendErrorResult(channeldpb.ErrorResultMessage_INVALID_MESSAGE, fmt.Errorf("invalid connId: %d", msg.ConnId))
+		return
+	}
+
+	timeout := GlobalSettings.multiChannelTimeout(msg.TimeoutMs, DefaultBatchSubscribeTimeoutMs)
+
+	// Don't block the GLOBAL channel while waiting for the other channels.
+	go func() {
+		ctx.Msg = batchSubscribe(ctx.Connection, connToSub, msg.Subs, msg.UnsubChannelIds, timeout)
+		ctx.Connection.Send(ctx)
+	}()
+}
+
+// Subscribes and unsubscribes the connection in the channels' own goroutines, and waits for all of them until the timeout.
+// The subscription or unsubscription that times out is still going to be handled by the channel, but not reported in the result.
+func batchSubscribe(sender ConnectionInChannel, connToSub *Connection, subs []*channeldpb.BatchSubscribeMessage_SubEntry,
+	unsubChannelIds []uint32, timeout time.Duration) *channeldpb.BatchSubscribeResultMessage {
+	result := &channeldpb.BatchSubscribeResultMessage{
+		ConnId:       uint32(connToSub.Id()),
+		SubResults:   make([]*channeldpb.BatchSubscribeResultMessage_ChannelResult, len(subs)),
+		UnsubResults: make([]*channeldpb.BatchSubscribeResultMessage_ChannelResult, len(unsubChannelIds)),
+	}
+	// Buffered with the number of the channels, so the channels never block after the timeout.
+	batchResults := make(chan batchSubscribeResult, len(subs)+len(unsubChannelIds))
+	pending := 0
+	// Whether the result of the channel is settled, so the pending ones can be marked as timed out.
+	subDone := make([]bool, len(subs))
+	unsubDone := make([]bool, len(unsubChannelIds))
+
+	// Dispatch the unsubscriptions first, so they are handled before the subscriptions in the same channel.
+	for i, chId := range unsubChannelIds {
+		result.UnsubResults[i] = &channeldpb.BatchSubscribeResultMessage_ChannelResult{ChannelId: chId}
+		ch := GetChannel(common.ChannelId(chId))
+		if ch == nil || ch.IsRemoving() {
+			setBatchSubscribeError(result.UnsubResults[i], channeldpb.ErrorResultMessage_INVALID_MESSAGE, errors.New("channel doesn't exist"))
+			unsubDone[i] = true
+			continue
+		}
+		result.UnsubResults[i].ChannelType = ch.channelType
+		index := i
+		if !ch.tryExecute(func(ch *Channel) {
+			batchResults <- batchSubscribeResult{index: index, err: ch.unsubscribeConnection(sender, connToSub)}
+		}) {
+			setBatchSubscribeError(result.UnsubResults[i], channeldpb.ErrorResultMessage_CHANNEL_BUSY, ErrChannelUnavailable)
+			unsubDone[i] = true
+			continue
+		}
+		pending++
+	}
+
+	for i, sub := range subs {
+		result.SubResults[i] = &channeldpb.BatchSubscribeResultMessage_ChannelResult{ChannelId: sub.ChannelId}
+		ch := GetChannel(common.ChannelId(sub.ChannelId))
+		if ch == nil || ch.IsRemoving() {
+			setBatchSubscribeError(result.SubResults[i], channeldpb.ErrorResultMessage_INVALID_MESSAGE, errors.New("channel doesn't exist"))
+			subDone[i] = true
+			continue
+		}
+		result.SubResults[i].ChannelType = ch.channelType
+		index := i
+		subOptions := sub.SubOptions
+		if !ch.tryExecute(func(ch *Channel) {
+			r := batchSubscribeResult{index: index, isSub: true}
+			if cs, err := ch.subscribeConnection(sender, connToSub, subOptions); err != nil {
+				r.err = err
+			} else {
+				r.options = proto.Clone(&cs.options).(*channeldpb.ChannelSubscriptionOptions)
+			}
+			batchResults <- r
+		}) {
+			setBatchSubscribeError(result.SubResults[i], channeldpb.ErrorResultMessage_CHANNEL_BUSY, ErrChannelUnavailable)
+			subDone[i] = true
+			continue
+		}
+		pending++
+	}
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	for pending > 0 {
+		select {
+		case r := <-batchResults:
+			pending--
+			channelResult, done := result.UnsubResults, unsubDone
+			if r.isSub {
+				channelResult, done = result.SubResults, subDone
+			}
+			done[r.index] = true
+			if r.err != nil {
+				setBatchSubscribeError(channelResult[r.index], r.err.code, r.err.err)
+			} else {
+				channelResult[r.index].SubOptions = r.options
+			}
+		case <-timer.C:
+			for i, channelResult := range result.UnsubResults {
+				if !unsubDone[i] {
+					setBatchSubscribeError(channelResult, channeldpb.ErrorResultMessage_UNKNOWN_ERROR, ErrBatchSubscribeTimeout)
+				}
+			}
+			for i, channelResult := range result.SubResults {
+				if !subDone[i] {
+					setBatchSubscribeError(channelResult, channeldpb.ErrorResultMessage_UNKNOWN_ERROR, ErrBatchSubscribeTimeout)
+				}
+			}
+			pending = 0
+		}
+	}
+
+	return result
+}
+
+func setBatchSubscribeError(channelResult *channeldpb.BatchSubscribeResultMessage_ChannelResult, code channeldpb.ErrorResultMessage_ErrorCode, err error) {
+	channelResult.Error = err.Error()
+	channelResult.ErrorCode = code
+}
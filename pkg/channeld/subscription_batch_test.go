@@ -0,0 +1,112 @@
+package channeld
+
+import (
+	"testing"
+	"time"
+
+	"github.com/metaworking/channeld/pkg/channeldpb"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestBatchSubscribe(t *testing.T) {
+	InitLogs()
+	InitChannels()
+	InitConnections("../../config/server_conn_fsm_test.json", "../../config/client_non_authoratative_fsm.json")
+
+	settings := GlobalSettings.GetChannelSettings(channeldpb.ChannelType_SUBWORLD)
+	settings.ACLSettings.Sub = ChannelAccessLevel_OwnerOnly
+	settings.ACLSettings.Unsub = ChannelAccessLevel_OwnerOnly
+	GlobalSettings.ChannelSettings[channeldpb.ChannelType_SUBWORLD] = settings
+	defer delete(GlobalSettings.ChannelSettings, channeldpb.ChannelType_SUBWORLD)
+
+	server := addTestConnection(channeldpb.ConnectionType_SERVER)
+	client := addTestConnection(channeldpb.ConnectionType_CLIENT)
+	ch1, _ := CreateChannel(channeldpb.ChannelType_SUBWORLD, server)
+	ch2, _ := CreateChannel(channeldpb.ChannelType_SUBWORLD, server)
+	ch3, _ := CreateChannel(channeldpb.ChannelType_SUBWORLD, nil)
+	channels := []*Channel{ch1, ch2, ch3}
+	for _, ch := range channels {
+		// Drop the channel from the scheduler, so the test can tick it manually.
+		scheduler.unschedule(ch)
+	}
+	client.SubscribeToChannel(ch2, nil)
+
+	// Subscribes and unsubscribes while ticking the channels in the test goroutine.
+	batch := func(sender *Connection, connToSub *Connection, subs []*channeldpb.BatchSubscribeMessage_SubEntry, unsubChannelIds []uint32, timeout time.Duration, tickedChannels ...*Channel) *channeldpb.BatchSubscribeResultMessage {
+		resultChan := make(chan *channeldpb.BatchSubscribeResultMessage)
+		go func() {
+			resultChan <- batchSubscribe(sender, connToSub, subs, unsubChannelIds, timeout)
+		}()
+		for {
+			select {
+			case result := <-resultChan:
+				return result
+			default:
+				for _, ch := range tickedChannels {
+					ch.tickMessages(time.Now())
+				}
+				time.Sleep(time.Millisecond)
+			}
+		}
+	}
+
+	// The server subscribes the client to ch1, and unsubscribes it from ch2. The server doesn't own ch3, and the unknown channel fails alone.
+	result := batch(server, client, []*channeldpb.BatchSubscribeMessage_SubEntry{
+		{ChannelId: uint32(ch1.id), SubOptions: &channeldpb.ChannelSubscriptionOptions{FanOutIntervalMs: proto.Uint32(50)}},
+		{ChannelId: 12345},
+		{ChannelId: uint32(ch3.id)},
+	}, []uint32{uint32(ch2.id)}, time.Second, ch1, ch2, ch3)
+	assert.EqualValues(t, client.Id(), result.ConnId)
+	assert.Equal(t, 3, len(result.SubResults))
+	assert.EqualValues(t, ch1.id, result.SubResults[0].ChannelId)
+	assert.Equal(t, channeldpb.ChannelType_SUBWORLD, result.SubResults[0].ChannelType)
+	assert.Empty(t, result.SubResults[0].Error)
+	assert.EqualValues(t, 50, result.SubResults[0].SubOptions.GetFanOutIntervalMs())
+	assert.Nil(t, result.SubResults[1].SubOptions)
+	assert.Equal(t, channeldpb.ErrorResultMessage_INVALID_MESSAGE, result.SubResults[1].ErrorCode)
+	assert.Equal(t, channeldpb.ErrorResultMessage_NO_ACCESS, result.SubResults[2].ErrorCode)
+	assert.Equal(t, ErrOwnerOnlyAccess.Error(), result.SubResults[2].Error)
+	assert.Equal(t, 1, len(result.UnsubResults))
+	assert.Empty(t, result.UnsubResults[0].Error)
+	assert.Contains(t, ch1.subscribedConnections, client)
+	assert.NotContains(t, ch2.subscribedConnections, client)
+	assert.NotContains(t, ch3.subscribedConnections, client)
+	// The subscribed is notified of each channel, but the sender only receives the aggregated result.
+	assert.Equal(t, 2, len(client.testQueue()))
+	assert.Empty(t, server.testQueue())
+
+	// A channel in both lists is resubscribed.
+	result = batch(client, client, []*channeldpb.BatchSubscribeMessage_SubEntry{{ChannelId: uint32(ch1.id)}},
+		[]uint32{uint32(ch1.id), uint32(ch2.id)}, time.Second, ch1, ch2)
+	assert.Empty(t, result.SubResults[0].Error)
+	assert.EqualValues(t, GlobalSettings.GetChannelSettings(channeldpb.ChannelType_SUBWORLD).DefaultFanOutIntervalMs,
+		result.SubResults[0].SubOptions.GetFanOutIntervalMs())
+	assert.Empty(t, result.UnsubResults[0].Error)
+	// Not subscribed to ch2
+	assert.NotEmpty(t, result.UnsubResults[1].Error)
+	assert.Equal(t, channeldpb.ErrorResultMessage_INVALID_MESSAGE, result.UnsubResults[1].ErrorCode)
+	assert.Contains(t, ch1.subscribedConnections, client)
+
+	// ch3 is not ticked in time
+	result = batch(client, client, []*channeldpb.BatchSubscribeMessage_SubEntry{{ChannelId: uint32(ch2.id)}, {ChannelId: uint32(ch3.id)}},
+		nil, 50*time.Millisecond, ch2)
+	assert.Empty(t, result.SubResults[0].Error)
+	assert.Equal(t, ErrBatchSubscribeTimeout.Error(), result.SubResults[1].Error)
+	// The late subscription is still handled, and doesn't block the channel.
+	ch3.tickMessages(time.Now())
+	assert.Contains(t, ch3.subscribedConnections, client)
+
+	// No channel to subscribe or unsubscribe
+	handleBatchSubscribe(MessageContext{
+		MsgType:    channeldpb.MessageType_BATCH_SUBSCRIBE,
+		Msg:        &channeldpb.BatchSubscribeMessage{},
+		Connection: server,
+		Channel:    globalChannel,
+	})
+	assert.Equal(t, channeldpb.ErrorResultMessage_INVALID_MESSAGE, server.latestMsg().(*channeldpb.ErrorResultMessage).ErrorCode)
+
+	for _, ch := range channels {
+		RemoveChannel(ch)
+	}
+}
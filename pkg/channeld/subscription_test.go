@@ -3,8 +3,10 @@ package channeld
 import (
 	"testing"
 
+	"github.com/metaworking/channeld/internal/testpb"
 	"github.com/metaworking/channeld/pkg/channeldpb"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
 )
 
 func TestSubscribeToChannel(t *testing.T) {
@@ -26,3 +28,233 @@ func TestSubscribeToChannel(t *testing.T) {
 	assert.Contains(t, globalChannel.subscribedConnections, c1)
 
 }
+
+func TestMaxSubscribers(t *testing.T) {
+	InitLogs()
+	InitChannels()
+	InitConnections("../../config/server_conn_fsm_test.json", "../../config/client_non_authoratative_fsm.json")
+
+	settings := GlobalSettings.GetChannelSettings(channeldpb.ChannelType_SUBWORLD)
+	settings.ACLSettings.Sub = ChannelAccessLevel_OwnerOnly
+	GlobalSettings.ChannelSettings[channeldpb.ChannelType_SUBWORLD] = settings
+	defer delete(GlobalSettings.ChannelSettings, channeldpb.ChannelType_SUBWORLD)
+
+	server := addTestConnection(channeldpb.ConnectionType_SERVER)
+	ch, _ := CreateChannel(channeldpb.ChannelType_SUBWORLD, server)
+	// Stop the channel from being ticked by the scheduler
+	scheduler.unschedule(ch)
+	ch.maxSubscribers = MaxSubscribersType{Client: 2, Server: 1}
+
+	cs, _ := server.SubscribeToChannel(ch, nil)
+	assert.NotNil(t, cs)
+	// The servers and clients are counted separately
+	cs, _ = addTestConnection(channeldpb.ConnectionType_SERVER).SubscribeToChannel(ch, nil)
+	assert.Nil(t, cs)
+
+	c1 := addTestConnection(channeldpb.ConnectionType_CLIENT)
+	c2 := addTestConnection(channeldpb.ConnectionType_CLIENT)
+	c3 := addTestConnection(channeldpb.ConnectionType_CLIENT)
+	cs, _ = c1.SubscribeToChannel(ch, nil)
+	assert.NotNil(t, cs)
+
+	// Reserve the last client slot for c2
+	handleReserveSubSlots(MessageContext{
+		MsgType:    channeldpb.MessageType_RESERVE_SUB_SLOTS,
+		Msg:        &channeldpb.ReserveSubscriberSlotsMessage{ConnIdsToReserve: []uint32{uint32(c2.Id())}},
+		Connection: server,
+		Channel:    ch,
+	})
+	assert.Contains(t, ch.reservedSubSlots, c2.Id())
+
+	// c3 can't take the slot reserved for c2
+	handleSubToChannel(MessageContext{
+		MsgType:    channeldpb.MessageType_SUB_TO_CHANNEL,
+		Msg:        &channeldpb.SubscribedToChannelMessage{ConnId: uint32(c3.Id())},
+		Connection: server,
+		Channel:    ch,
+		StubId:     1,
+	})
+	assert.NotContains(t, ch.subscribedConnections, c3)
+	errMsg, ok := server.latestMsg().(*channeldpb.ErrorResultMessage)
+	assert.True(t, ok)
+	assert.Equal(t, channeldpb.ErrorResultMessage_CHANNEL_FULL, errMsg.ErrorCode)
+	assert.EqualValues(t, channeldpb.MessageType_SUB_TO_CHANNEL, errMsg.MsgType)
+	assert.IsType(t, &channeldpb.ErrorResultMessage{}, c3.latestMsg())
+
+	// c2 takes the reserved slot
+	cs, _ = c2.SubscribeToChannel(ch, nil)
+	assert.NotNil(t, cs)
+	assert.NotContains(t, ch.reservedSubSlots, c2.Id())
+
+	// Free a slot for c3
+	_, err := c1.UnsubscribeFromChannel(ch)
+	assert.NoError(t, err)
+	cs, _ = c3.SubscribeToChannel(ch, nil)
+	assert.NotNil(t, cs)
+
+	// Only the owner can reserve the slots
+	handleReserveSubSlots(MessageContext{
+		MsgType:    channeldpb.MessageType_RESERVE_SUB_SLOTS,
+		Msg:        &channeldpb.ReserveSubscriberSlotsMessage{ConnIdsToReserve: []uint32{uint32(c1.Id())}},
+		Connection: c1,
+		Channel:    ch,
+	})
+	assert.NotContains(t, ch.reservedSubSlots, c1.Id())
+	assert.Equal(t, channeldpb.ErrorResultMessage_NO_ACCESS, c1.latestMsg().(*channeldpb.ErrorResultMessage).ErrorCode)
+}
+
+func TestChannelPresence(t *testing.T) {
+	InitLogs()
+	InitChannels()
+	InitConnections("../../config/server_conn_fsm_test.json", "../../config/client_non_authoratative_fsm.json")
+
+	server := addTestConnection(channeldpb.ConnectionType_SERVER)
+	ch, _ := CreateChannel(channeldpb.ChannelType_SUBWORLD, server)
+	// Stop the channel from being ticked by the scheduler
+	scheduler.unschedule(ch)
+	ch.enablePresence = true
+
+	c1 := addTestConnection(channeldpb.ConnectionType_CLIENT)
+	c2 := addTestConnection(channeldpb.ConnectionType_CLIENT)
+
+	c1.SubscribeToChannel(ch, nil)
+	presence := c1.latestMsg().(*channeldpb.ChannelPresenceMessage)
+	assert.Equal(t, channeldpb.ChannelPresenceMessage_MEMBER_LIST, presence.EventType)
+	assert.Equal(t, 1, len(presence.Members))
+
+	c2.SubscribeToChannel(ch, nil)
+	presence = c1.latestMsg().(*channeldpb.ChannelPresenceMessage)
+	assert.Equal(t, channeldpb.ChannelPresenceMessage_JOIN, presence.EventType)
+	assert.EqualValues(t, c2.id, presence.Members[0].ConnId)
+	presence = c2.latestMsg().(*channeldpb.ChannelPresenceMessage)
+	assert.Equal(t, channeldpb.ChannelPresenceMessage_MEMBER_LIST, presence.EventType)
+	assert.Equal(t, 2, len(presence.Members))
+	// The member list is only sent once
+	assert.Equal(t, 1, len(c2.testQueue()))
+
+	// Subscribing again doesn't trigger the presence event
+	c2.SubscribeToChannel(ch, nil)
+	assert.Equal(t, 2, len(c1.testQueue()))
+
+	c2.UnsubscribeFromChannel(ch)
+	presence = c1.latestMsg().(*channeldpb.ChannelPresenceMessage)
+	assert.Equal(t, channeldpb.ChannelPresenceMessage_LEAVE, presence.EventType)
+	assert.EqualValues(t, c2.id, presence.Members[0].ConnId)
+
+	// Disconnected connection
+	c2.SubscribeToChannel(ch, nil)
+	c2.state = ConnectionState_CLOSING
+	ch.tickConnections()
+	presence = c1.latestMsg().(*channeldpb.ChannelPresenceMessage)
+	assert.Equal(t, channeldpb.ChannelPresenceMessage_LEAVE, presence.EventType)
+	assert.EqualValues(t, c2.id, presence.Members[0].ConnId)
+
+	// Presence is disabled
+	ch.enablePresence = false
+	msgCount := len(c1.testQueue())
+	c3 := addTestConnection(channeldpb.ConnectionType_CLIENT)
+	c3.SubscribeToChannel(ch, nil)
+	assert.Equal(t, msgCount, len(c1.testQueue()))
+	assert.Empty(t, c3.testQueue())
+}
+
+func TestUpdateSubOptions(t *testing.T) {
+	InitLogs()
+	InitChannels()
+	InitConnections("../../config/server_conn_fsm_test.json", "../../config/client_non_authoratative_fsm.json")
+
+	settings := GlobalSettings.GetChannelSettings(channeldpb.ChannelType_SUBWORLD)
+	settings.ACLSettings.Sub = ChannelAccessLevel_OwnerOnly
+	settings.DefaultFanOutIntervalMs = 20
+	GlobalSettings.ChannelSettings[channeldpb.ChannelType_SUBWORLD] = settings
+	defer delete(GlobalSettings.ChannelSettings, channeldpb.ChannelType_SUBWORLD)
+
+	server := addTestConnection(channeldpb.ConnectionType_SERVER)
+	ch, _ := CreateChannel(channeldpb.ChannelType_SUBWORLD, server)
+	// Stop the channel from being ticked by the scheduler
+	scheduler.unschedule(ch)
+	ch.InitData(&testpb.TestChannelDataMessage{Text: "a", Num: 1}, nil)
+	server.SubscribeToChannel(ch, nil)
+
+	client := addTestConnection(channeldpb.ConnectionType_CLIENT)
+	client.SubscribeToChannel(ch, &channeldpb.ChannelSubscriptionOptions{
+		DataFieldMasks:   []string{"text"},
+		FanOutIntervalMs: proto.Uint32(100),
+		SkipFirstFanOut:  proto.Bool(true),
+	})
+	assert.EqualValues(t, 100, ch.data.maxFanOutIntervalMs)
+
+	// The merged options also update the max fan-out interval.
+	client.SubscribeToChannel(ch, &channeldpb.ChannelSubscriptionOptions{FanOutIntervalMs: proto.Uint32(200)})
+	assert.EqualValues(t, 200, ch.data.maxFanOutIntervalMs)
+
+	// Another client can't update the options of the subscribed client, and it's not subscribed itself.
+	other := addTestConnection(channeldpb.ConnectionType_CLIENT)
+	handleUpdateSubOptions(MessageContext{
+		MsgType:    channeldpb.MessageType_UPDATE_SUB_OPTIONS,
+		Msg:        &channeldpb.UpdateSubOptionsMessage{ConnId: uint32(client.Id())},
+		Connection: other,
+		Channel:    ch,
+	})
+	assert.Equal(t, channeldpb.ErrorResultMessage_INVALID_MESSAGE, other.latestMsg().(*channeldpb.ErrorResultMessage).ErrorCode)
+
+	// The options are replaced rather than merged, so the masks are removed.
+	handleUpdateSubOptions(MessageContext{
+		MsgType: channeldpb.MessageType_UPDATE_SUB_OPTIONS,
+		Msg: &channeldpb.UpdateSubOptionsMessage{
+			ConnId:     uint32(client.Id()),
+			SubOptions: &channeldpb.ChannelSubscriptionOptions{FanOutIntervalMs: proto.Uint32(50)},
+		},
+		Connection: server,
+		Channel:    ch,
+		StubId:     1,
+	})
+	result, ok := server.latestMsg().(*channeldpb.UpdateSubOptionsResultMessage)
+	assert.True(t, ok)
+	assert.EqualValues(t, client.Id(), result.ConnId)
+	assert.Empty(t, result.SubOptions.DataFieldMasks)
+	assert.EqualValues(t, 50, result.SubOptions.GetFanOutIntervalMs())
+	assert.False(t, result.SubOptions.GetSkipFirstFanOut())
+	assert.IsType(t, &channeldpb.UpdateSubOptionsResultMessage{}, client.latestMsg())
+	// The buffer retention shrinks to the longest interval of the subscriptions.
+	assert.EqualValues(t, 50, ch.data.maxFanOutIntervalMs)
+
+	// The client receives the full data, including the field that was filtered out.
+	ch.tickData(ch.GetTime().AddMs(50))
+	updateMsg, ok := client.latestMsg().(*channeldpb.ChannelDataUpdateMessage)
+	assert.True(t, ok)
+	dataMsg, err := testChannelDataMessageProcessor(updateMsg)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 1, dataMsg.(*testpb.TestChannelDataMessage).Num)
+
+	// The server restricts the write access of the client.
+	handleUpdateSubOptions(MessageContext{
+		MsgType: channeldpb.MessageType_UPDATE_SUB_OPTIONS,
+		Msg: &channeldpb.UpdateSubOptionsMessage{
+			ConnId: uint32(client.Id()),
+			SubOptions: &channeldpb.ChannelSubscriptionOptions{
+				DataAccess:      Pointer(channeldpb.ChannelDataAccess_WRITE_ACCESS),
+				WriteFieldMasks: []string{"num"},
+			},
+		},
+		Connection: server,
+		Channel:    ch,
+	})
+	// The client can't change its own data access or write masks, even if the options are replaced.
+	handleUpdateSubOptions(MessageContext{
+		MsgType: channeldpb.MessageType_UPDATE_SUB_OPTIONS,
+		Msg: &channeldpb.UpdateSubOptionsMessage{
+			SubOptions: &channeldpb.ChannelSubscriptionOptions{
+				DataAccess:       Pointer(channeldpb.ChannelDataAccess_READ_ACCESS),
+				FanOutIntervalMs: proto.Uint32(30),
+			},
+		},
+		Connection: client,
+		Channel:    ch,
+	})
+	result, ok = client.latestMsg().(*channeldpb.UpdateSubOptionsResultMessage)
+	assert.True(t, ok)
+	assert.Equal(t, channeldpb.ChannelDataAccess_WRITE_ACCESS, result.SubOptions.GetDataAccess())
+	assert.Equal(t, []string{"num"}, result.SubOptions.WriteFieldMasks)
+	assert.EqualValues(t, 30, result.SubOptions.GetFanOutIntervalMs())
+}
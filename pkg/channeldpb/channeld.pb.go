@@ -260,6 +260,36 @@ const (
 	MessageType_ENTITY_GROUP_ADD MessageType = 16
 	// Used by @RemoveEntityGroupMessage
 	MessageType_ENTITY_GROUP_REMOVE MessageType = 17
+	// Used by @ErrorResultMessage
+	MessageType_ERROR_RESULT MessageType = 18
+	// Used by @ReserveSubscriberSlotsMessage
+	MessageType_RESERVE_SUB_SLOTS MessageType = 19
+	// Used by @UpdateChannelAttributesMessage and @UpdateChannelAttributesResultMessage
+	MessageType_UPDATE_CHANNEL_ATTRIBUTES MessageType = 20
+	// Used by @GetChannelInfoMessage and @GetChannelInfoResultMessage
+	MessageType_GET_CHANNEL_INFO MessageType = 21
+	// Used by @ChannelPresenceMessage
+	MessageType_CHANNEL_PRESENCE MessageType = 22
+	// Used by @ScheduleMessageMessage and @ScheduleMessageResultMessage
+	MessageType_SCHEDULE_MESSAGE MessageType = 23
+	// Used by @CancelScheduledMessage
+	MessageType_CANCEL_SCHEDULED MessageType = 24
+	// Used by @ChannelDataTransactionMessage and @ChannelDataTransactionResultMessage
+	MessageType_CHANNEL_DATA_TRANSACTION MessageType = 25
+	// Used by @ChannelDataAckMessage
+	MessageType_CHANNEL_DATA_ACK MessageType = 26
+	// Used by @ChannelDataConflictMessage
+	MessageType_CHANNEL_DATA_CONFLICT MessageType = 27
+	// Used by @GetChannelDataMessage and @GetChannelDataResultMessage
+	MessageType_GET_CHANNEL_DATA MessageType = 28
+	// Used by @UpdateSubOptionsMessage and @UpdateSubOptionsResultMessage
+	MessageType_UPDATE_SUB_OPTIONS MessageType = 29
+	// Used by @BatchSubscribeMessage and @BatchSubscribeResultMessage
+	MessageType_BATCH_SUBSCRIBE MessageType = 30
+	// Used by @AutoSubscribeMessage and @AutoSubscribeResultMessage
+	MessageType_AUTO_SUBSCRIBE MessageType = 31
+	// Used by @RemoveAutoSubscribeMessage
+	MessageType_REMOVE_AUTO_SUBSCRIBE MessageType = 32
 	// Used by @DebugGetSpatialRegionsMessage
 	MessageType_DEBUG_GET_SPATIAL_REGIONS MessageType = 99
 	// Start of any user-space defined message
@@ -286,6 +316,21 @@ var (
 		15:  "CREATE_ENTITY_CHANNEL",
 		16:  "ENTITY_GROUP_ADD",
 		17:  "ENTITY_GROUP_REMOVE",
+		18:  "ERROR_RESULT",
+		19:  "RESERVE_SUB_SLOTS",
+		20:  "UPDATE_CHANNEL_ATTRIBUTES",
+		21:  "GET_CHANNEL_INFO",
+		22:  "CHANNEL_PRESENCE",
+		23:  "SCHEDULE_MESSAGE",
+		24:  "CANCEL_SCHEDULED",
+		25:  "CHANNEL_DATA_TRANSACTION",
+		26:  "CHANNEL_DATA_ACK",
+		27:  "CHANNEL_DATA_CONFLICT",
+		28:  "GET_CHANNEL_DATA",
+		29:  "UPDATE_SUB_OPTIONS",
+		30:  "BATCH_SUBSCRIBE",
+		31:  "AUTO_SUBSCRIBE",
+		32:  "REMOVE_AUTO_SUBSCRIBE",
 		99:  "DEBUG_GET_SPATIAL_REGIONS",
 		100: "USER_SPACE_START",
 	}
@@ -307,6 +352,21 @@ var (
 		"CREATE_ENTITY_CHANNEL":     15,
 		"ENTITY_GROUP_ADD":          16,
 		"ENTITY_GROUP_REMOVE":       17,
+		"ERROR_RESULT":              18,
+		"RESERVE_SUB_SLOTS":         19,
+		"UPDATE_CHANNEL_ATTRIBUTES": 20,
+		"GET_CHANNEL_INFO":          21,
+		"CHANNEL_PRESENCE":          22,
+		"SCHEDULE_MESSAGE":          23,
+		"CANCEL_SCHEDULED":          24,
+		"CHANNEL_DATA_TRANSACTION":  25,
+		"CHANNEL_DATA_ACK":          26,
+		"CHANNEL_DATA_CONFLICT":     27,
+		"GET_CHANNEL_DATA":          28,
+		"UPDATE_SUB_OPTIONS":        29,
+		"BATCH_SUBSCRIBE":           30,
+		"AUTO_SUBSCRIBE":            31,
+		"REMOVE_AUTO_SUBSCRIBE":     32,
 		"DEBUG_GET_SPATIAL_REGIONS": 99,
 		"USER_SPACE_START":          100,
 	}
@@ -530,6 +590,299 @@ func (AuthResultMessage_AuthResult) EnumDescriptor() ([]byte, []int) {
 	return file_channeld_proto_rawDescGZIP(), []int{4, 0}
 }
 
+// How the conflicting writes to the same field from different connections are resolved.
+// The conflicts are checked per top-level field of the channel data, and per entry of a top-level map field, e.g. "players.1001".
+// Nested fields and the entries of nested maps are not checked separately. The losing writer receives a @ChannelDataConflictMessage.
+type ChannelDataMergeOptions_ConflictPolicy int32
+
+const (
+	// The last processed write wins silently.
+	ChannelDataMergeOptions_LAST_PROCESSED_WINS ChannelDataMergeOptions_ConflictPolicy = 0
+	// The write of the channel owner wins over the writes of the other connections since the last fan-out.
+	ChannelDataMergeOptions_OWNER_WINS ChannelDataMergeOptions_ConflictPolicy = 1
+	// The write with the latest @ChannelDataUpdateMessage.timestamp (the sender's clock) wins. The write older than the field is rejected.
+	// The timestamp later than the server time plus 1 second is clamped to it.
+	ChannelDataMergeOptions_LAST_WRITER_WINS ChannelDataMergeOptions_ConflictPolicy = 2
+	// Each field has a version that increases with every accepted write. The write should carry the version it's based on
+	// in @ChannelDataUpdateMessage.fieldVersions, otherwise it's stale and rejected.
+	ChannelDataMergeOptions_VERSION_COUNTER ChannelDataMergeOptions_ConflictPolicy = 3
+)
+
+// Enum value maps for ChannelDataMergeOptions_ConflictPolicy.
+var (
+	ChannelDataMergeOptions_ConflictPolicy_name = map[int32]string{
+		0: "LAST_PROCESSED_WINS",
+		1: "OWNER_WINS",
+		2: "LAST_WRITER_WINS",
+		3: "VERSION_COUNTER",
+	}
+	ChannelDataMergeOptions_ConflictPolicy_value = map[string]int32{
+		"LAST_PROCESSED_WINS": 0,
+		"OWNER_WINS":          1,
+		"LAST_WRITER_WINS":    2,
+		"VERSION_COUNTER":     3,
+	}
+)
+
+func (x ChannelDataMergeOptions_ConflictPolicy) Enum() *ChannelDataMergeOptions_ConflictPolicy {
+	p := new(ChannelDataMergeOptions_ConflictPolicy)
+	*p = x
+	return p
+}
+
+func (x ChannelDataMergeOptions_ConflictPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChannelDataMergeOptions_ConflictPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_channeld_proto_enumTypes[8].Descriptor()
+}
+
+func (ChannelDataMergeOptions_ConflictPolicy) Type() protoreflect.EnumType {
+	return &file_channeld_proto_enumTypes[8]
+}
+
+func (x ChannelDataMergeOptions_ConflictPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChannelDataMergeOptions_ConflictPolicy.Descriptor instead.
+func (ChannelDataMergeOptions_ConflictPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_channeld_proto_rawDescGZIP(), []int{7, 0}
+}
+
+type ChannelAttributeFilter_Operator int32
+
+const (
+	ChannelAttributeFilter_EQ  ChannelAttributeFilter_Operator = 0
+	ChannelAttributeFilter_NE  ChannelAttributeFilter_Operator = 1
+	ChannelAttributeFilter_LT  ChannelAttributeFilter_Operator = 2
+	ChannelAttributeFilter_LTE ChannelAttributeFilter_Operator = 3
+	ChannelAttributeFilter_GT  ChannelAttributeFilter_Operator = 4
+	ChannelAttributeFilter_GTE ChannelAttributeFilter_Operator = 5
+	// True if the attribute exists. The value is ignored.
+	ChannelAttributeFilter_EXISTS ChannelAttributeFilter_Operator = 6
+	// True if all the sub-filters are true.
+	ChannelAttributeFilter_AND ChannelAttributeFilter_Operator = 7
+	// True if any of the sub-filters is true.
+	ChannelAttributeFilter_OR ChannelAttributeFilter_Operator = 8
+	// True if the attribute doesn't exist. The value is ignored.
+	ChannelAttributeFilter_NOT_EXISTS ChannelAttributeFilter_Operator = 9
+)
+
+// Enum value maps for ChannelAttributeFilter_Operator.
+var (
+	ChannelAttributeFilter_Operator_name = map[int32]string{
+		0: "EQ",
+		1: "NE",
+		2: "LT",
+		3: "LTE",
+		4: "GT",
+		5: "GTE",
+		6: "EXISTS",
+		7: "AND",
+		8: "OR",
+		9: "NOT_EXISTS",
+	}
+	ChannelAttributeFilter_Operator_value = map[string]int32{
+		"EQ":         0,
+		"NE":         1,
+		"LT":         2,
+		"LTE":        3,
+		"GT":         4,
+		"GTE":        5,
+		"EXISTS":     6,
+		"AND":        7,
+		"OR":         8,
+		"NOT_EXISTS": 9,
+	}
+)
+
+func (x ChannelAttributeFilter_Operator) Enum() *ChannelAttributeFilter_Operator {
+	p := new(ChannelAttributeFilter_Operator)
+	*p = x
+	return p
+}
+
+func (x ChannelAttributeFilter_Operator) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChannelAttributeFilter_Operator) Descriptor() protoreflect.EnumDescriptor {
+	return file_channeld_proto_enumTypes[9].Descriptor()
+}
+
+func (ChannelAttributeFilter_Operator) Type() protoreflect.EnumType {
+	return &file_channeld_proto_enumTypes[9]
+}
+
+func (x ChannelAttributeFilter_Operator) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChannelAttributeFilter_Operator.Descriptor instead.
+func (ChannelAttributeFilter_Operator) EnumDescriptor() ([]byte, []int) {
+	return file_channeld_proto_rawDescGZIP(), []int{10, 0}
+}
+
+type ChannelPresenceMessage_EventType int32
+
+const (
+	ChannelPresenceMessage_JOIN  ChannelPresenceMessage_EventType = 0
+	ChannelPresenceMessage_LEAVE ChannelPresenceMessage_EventType = 1
+	// All the current subscribers of the channel, including the newcomer.
+	ChannelPresenceMessage_MEMBER_LIST ChannelPresenceMessage_EventType = 2
+)
+
+// Enum value maps for ChannelPresenceMessage_EventType.
+var (
+	ChannelPresenceMessage_EventType_name = map[int32]string{
+		0: "JOIN",
+		1: "LEAVE",
+		2: "MEMBER_LIST",
+	}
+	ChannelPresenceMessage_EventType_value = map[string]int32{
+		"JOIN":        0,
+		"LEAVE":       1,
+		"MEMBER_LIST": 2,
+	}
+)
+
+func (x ChannelPresenceMessage_EventType) Enum() *ChannelPresenceMessage_EventType {
+	p := new(ChannelPresenceMessage_EventType)
+	*p = x
+	return p
+}
+
+func (x ChannelPresenceMessage_EventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChannelPresenceMessage_EventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_channeld_proto_enumTypes[10].Descriptor()
+}
+
+func (ChannelPresenceMessage_EventType) Type() protoreflect.EnumType {
+	return &file_channeld_proto_enumTypes[10]
+}
+
+func (x ChannelPresenceMessage_EventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChannelPresenceMessage_EventType.Descriptor instead.
+func (ChannelPresenceMessage_EventType) EnumDescriptor() ([]byte, []int) {
+	return file_channeld_proto_rawDescGZIP(), []int{20, 0}
+}
+
+type GetChannelInfoResultMessage_ChannelState int32
+
+const (
+	GetChannelInfoResultMessage_INIT     GetChannelInfoResultMessage_ChannelState = 0
+	GetChannelInfoResultMessage_OPEN     GetChannelInfoResultMessage_ChannelState = 1
+	GetChannelInfoResultMessage_HANDOVER GetChannelInfoResultMessage_ChannelState = 2
+)
+
+// Enum value maps for GetChannelInfoResultMessage_ChannelState.
+var (
+	GetChannelInfoResultMessage_ChannelState_name = map[int32]string{
+		0: "INIT",
+		1: "OPEN",
+		2: "HANDOVER",
+	}
+	GetChannelInfoResultMessage_ChannelState_value = map[string]int32{
+		"INIT":     0,
+		"OPEN":     1,
+		"HANDOVER": 2,
+	}
+)
+
+func (x GetChannelInfoResultMessage_ChannelState) Enum() *GetChannelInfoResultMessage_ChannelState {
+	p := new(GetChannelInfoResultMessage_ChannelState)
+	*p = x
+	return p
+}
+
+func (x GetChannelInfoResultMessage_ChannelState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GetChannelInfoResultMessage_ChannelState) Descriptor() protoreflect.EnumDescriptor {
+	return file_channeld_proto_enumTypes[11].Descriptor()
+}
+
+func (GetChannelInfoResultMessage_ChannelState) Type() protoreflect.EnumType {
+	return &file_channeld_proto_enumTypes[11]
+}
+
+func (x GetChannelInfoResultMessage_ChannelState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GetChannelInfoResultMessage_ChannelState.Descriptor instead.
+func (GetChannelInfoResultMessage_ChannelState) EnumDescriptor() ([]byte, []int) {
+	return file_channeld_proto_rawDescGZIP(), []int{30, 0}
+}
+
+type ErrorResultMessage_ErrorCode int32
+
+const (
+	ErrorResultMessage_UNKNOWN_ERROR ErrorResultMessage_ErrorCode = 0
+	// The connection doesn't have the access to perform the operation.
+	ErrorResultMessage_NO_ACCESS ErrorResultMessage_ErrorCode = 1
+	// The channel has reached the max number of subscribers.
+	ErrorResultMessage_CHANNEL_FULL ErrorResultMessage_ErrorCode = 2
+	// The message is invalid, e.g. an invalid connection or channel ID is specified.
+	ErrorResultMessage_INVALID_MESSAGE ErrorResultMessage_ErrorCode = 3
+	// The channel's inbox is full. The message is dropped.
+	ErrorResultMessage_CHANNEL_BUSY ErrorResultMessage_ErrorCode = 4
+)
+
+// Enum value maps for ErrorResultMessage_ErrorCode.
+var (
+	ErrorResultMessage_ErrorCode_name = map[int32]string{
+		0: "UNKNOWN_ERROR",
+		1: "NO_ACCESS",
+		2: "CHANNEL_FULL",
+		3: "INVALID_MESSAGE",
+		4: "CHANNEL_BUSY",
+	}
+	ErrorResultMessage_ErrorCode_value = map[string]int32{
+		"UNKNOWN_ERROR":   0,
+		"NO_ACCESS":       1,
+		"CHANNEL_FULL":    2,
+		"INVALID_MESSAGE": 3,
+		"CHANNEL_BUSY":    4,
+	}
+)
+
+func (x ErrorResultMessage_ErrorCode) Enum() *ErrorResultMessage_ErrorCode {
+	p := new(ErrorResultMessage_ErrorCode)
+	*p = x
+	return p
+}
+
+func (x ErrorResultMessage_ErrorCode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ErrorResultMessage_ErrorCode) Descriptor() protoreflect.EnumDescriptor {
+	return file_channeld_proto_enumTypes[12].Descriptor()
+}
+
+func (ErrorResultMessage_ErrorCode) Type() protoreflect.EnumType {
+	return &file_channeld_proto_enumTypes[12]
+}
+
+func (x ErrorResultMessage_ErrorCode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ErrorResultMessage_ErrorCode.Descriptor instead.
+func (ErrorResultMessage_ErrorCode) EnumDescriptor() ([]byte, []int) {
+	return file_channeld_proto_rawDescGZIP(), []int{44, 0}
+}
+
 // The data packet that is sent between the endpoints. A packet can have multiple messages in the payload in one trip to improve the efficiency.
 type Packet struct {
 	state         protoimpl.MessageState
@@ -736,6 +1089,9 @@ type AuthMessage struct {
 
 	PlayerIdentifierToken string `protobuf:"bytes,1,opt,name=playerIdentifierToken,proto3" json:"playerIdentifierToken,omitempty"`
 	LoginToken            string `protobuf:"bytes,2,opt,name=loginToken,proto3" json:"loginToken,omitempty"`
+	// The max bytes per second of the channel data fan-outs to the connection, across all the subscribed channels. 0 = no limit.
+	// Can be changed later by @ChannelSubscriptionOptions.bandwidthBudget.
+	BandwidthBudget uint32 `protobuf:"varint,3,opt,name=bandwidthBudget,proto3" json:"bandwidthBudget,omitempty"`
 }
 
 func (x *AuthMessage) Reset() {
@@ -784,6 +1140,13 @@ func (x *AuthMessage) GetLoginToken() string {
 	return ""
 }
 
+func (x *AuthMessage) GetBandwidthBudget() uint32 {
+	if x != nil {
+		return x.BandwidthBudget
+	}
+	return 0
+}
+
 type AuthResultMessage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -873,6 +1236,28 @@ type ChannelSubscriptionOptions struct {
 	SkipSelfUpdateFanOut *bool `protobuf:"varint,5,opt,name=skipSelfUpdateFanOut,proto3,oneof" json:"skipSelfUpdateFanOut,omitempty"`
 	// Whether the subscriber should skip the first fan-out that contains the full states. Default is false.
 	SkipFirstFanOut *bool `protobuf:"varint,6,opt,name=skipFirstFanOut,proto3,oneof" json:"skipFirstFanOut,omitempty"`
+	// If true, each fan-out contains all the updates since the last @ChannelDataAckMessage of the subscriber (the baseline),
+	// instead of the updates since the last fan-out. If the updates since the baseline are no longer buffered in channeld,
+	// the full states are sent instead. As a delta may contain the updates that have been received, merging it should be idempotent.
+	// Default is false.
+	DeltaFanOut *bool `protobuf:"varint,7,opt,name=deltaFanOut,proto3,oneof" json:"deltaFanOut,omitempty"`
+	// The max bytes per second of the channel data fan-outs to the subscriber, across all the subscribed channels. 0 = no limit.
+	// It's a property of the subscriber connection, so setting it in any subscription applies to all the subscriptions.
+	// When the connection is over the budget, the fan-out intervals of its subscriptions are stretched dynamically.
+	BandwidthBudget *uint32 `protobuf:"varint,8,opt,name=bandwidthBudget,proto3,oneof" json:"bandwidthBudget,omitempty"`
+	// Lower value means higher priority. When the fan-out intervals are stretched due to the bandwidth budget,
+	// the subscription with higher priority is stretched less. The spatial subscriptions updated by @UpdateSpatialInterestMessage
+	// use the distance to the query center as the priority. Default is 0.
+	Priority *uint32 `protobuf:"varint,9,opt,name=priority,proto3,oneof" json:"priority,omitempty"`
+	// Fans out different fields at different intervals within the subscription, e.g. the position at 20ms and the inventory at 500ms.
+	// If set, after the first fan-out (the full states filtered by @dataFieldMasks), each tier fans out the updates of its fields at its own interval,
+	// and @fanOutIntervalMs and @deltaFanOut are ignored. The fields not in any tier are not fanned-out after the first fan-out.
+	FanOutTiers []*FanOutTier `protobuf:"bytes,10,rep,name=fanOutTiers,proto3" json:"fanOutTiers,omitempty"`
+	// The fields that the subscriber with WRITE_ACCESS can update. Empty = all the fields. Doesn't apply to the channel owner.
+	// Uses the same syntax as @dataFieldMasks, and the key of a map field can be used as a path segment, e.g. "players.{connId}.input",
+	// in which "{connId}" is replaced with the ID of the subscriber. The disallowed fields in the @ChannelDataUpdateMessage are stripped before being merged.
+	// Only the channel owner or a server connection can change @dataAccess and @writeFieldMasks of an existing subscription.
+	WriteFieldMasks []string `protobuf:"bytes,11,rep,name=writeFieldMasks,proto3" json:"writeFieldMasks,omitempty"`
 }
 
 func (x *ChannelSubscriptionOptions) Reset() {
@@ -949,6 +1334,99 @@ func (x *ChannelSubscriptionOptions) GetSkipFirstFanOut() bool {
 	return false
 }
 
+func (x *ChannelSubscriptionOptions) GetDeltaFanOut() bool {
+	if x != nil && x.DeltaFanOut != nil {
+		return *x.DeltaFanOut
+	}
+	return false
+}
+
+func (x *ChannelSubscriptionOptions) GetBandwidthBudget() uint32 {
+	if x != nil && x.BandwidthBudget != nil {
+		return *x.BandwidthBudget
+	}
+	return 0
+}
+
+func (x *ChannelSubscriptionOptions) GetPriority() uint32 {
+	if x != nil && x.Priority != nil {
+		return *x.Priority
+	}
+	return 0
+}
+
+func (x *ChannelSubscriptionOptions) GetFanOutTiers() []*FanOutTier {
+	if x != nil {
+		return x.FanOutTiers
+	}
+	return nil
+}
+
+func (x *ChannelSubscriptionOptions) GetWriteFieldMasks() []string {
+	if x != nil {
+		return x.WriteFieldMasks
+	}
+	return nil
+}
+
+// A group of fields that are fanned-out at the same interval. See @ChannelSubscriptionOptions.fanOutTiers.
+type FanOutTier struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Same as @ChannelSubscriptionOptions.dataFieldMasks. Should not be empty.
+	DataFieldMasks []string `protobuf:"bytes,1,rep,name=dataFieldMasks,proto3" json:"dataFieldMasks,omitempty"`
+	// The fan-out interval of the fields, in millisecond.
+	FanOutIntervalMs uint32 `protobuf:"varint,2,opt,name=fanOutIntervalMs,proto3" json:"fanOutIntervalMs,omitempty"`
+}
+
+func (x *FanOutTier) Reset() {
+	*x = FanOutTier{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_channeld_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FanOutTier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FanOutTier) ProtoMessage() {}
+
+func (x *FanOutTier) ProtoReflect() protoreflect.Message {
+	mi := &file_channeld_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FanOutTier.ProtoReflect.Descriptor instead.
+func (*FanOutTier) Descriptor() ([]byte, []int) {
+	return file_channeld_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *FanOutTier) GetDataFieldMasks() []string {
+	if x != nil {
+		return x.DataFieldMasks
+	}
+	return nil
+}
+
+func (x *FanOutTier) GetFanOutIntervalMs() uint32 {
+	if x != nil {
+		return x.FanOutIntervalMs
+	}
+	return 0
+}
+
 // Defines how two @ChannelDataUpdateMessage.data are merged.
 // The custom merge function should always be implemented for the sake of performance. Otherwise,
 // the default merge that based on Protobuf's reflection will be used, and it's >10 times slower.
@@ -964,13 +1442,18 @@ type ChannelDataMergeOptions struct {
 	// If true, the top elements of the list will be truncated instead of the end. It's useful for scenarios like chat message list.
 	TruncateTop bool `protobuf:"varint,3,opt,name=truncateTop,proto3" json:"truncateTop,omitempty"`
 	// If true, the merge method will remove any map entry that has removed=true in its value.
-	ShouldCheckRemovableMapField bool `protobuf:"varint,4,opt,name=shouldCheckRemovableMapField,proto3" json:"shouldCheckRemovableMapField,omitempty"`
+	ShouldCheckRemovableMapField bool                                   `protobuf:"varint,4,opt,name=shouldCheckRemovableMapField,proto3" json:"shouldCheckRemovableMapField,omitempty"`
+	ConflictPolicy               ChannelDataMergeOptions_ConflictPolicy `protobuf:"varint,5,opt,name=conflictPolicy,proto3,enum=channeldpb.ChannelDataMergeOptions_ConflictPolicy" json:"conflictPolicy,omitempty"`
+	// The merge options of the specific fields, keyed by the field path from the root of the channel data, e.g. "chatMessages" or "rooms.members".
+	// The keys of the map fields are not part of the path. Overrides the options above and the channeldpb.fieldMerge annotation of the field.
+	// Only honored by the reflection-based merge; the custom merge (see MergeableChannelData) should handle it by itself.
+	FieldOptions map[string]*FieldMergeOptions `protobuf:"bytes,6,rep,name=fieldOptions,proto3" json:"fieldOptions,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (x *ChannelDataMergeOptions) Reset() {
 	*x = ChannelDataMergeOptions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_channeld_proto_msgTypes[6]
+		mi := &file_channeld_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -983,7 +1466,7 @@ func (x *ChannelDataMergeOptions) String() string {
 func (*ChannelDataMergeOptions) ProtoMessage() {}
 
 func (x *ChannelDataMergeOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_channeld_proto_msgTypes[6]
+	mi := &file_channeld_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -996,7 +1479,7 @@ func (x *ChannelDataMergeOptions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChannelDataMergeOptions.ProtoReflect.Descriptor instead.
 func (*ChannelDataMergeOptions) Descriptor() ([]byte, []int) {
-	return file_channeld_proto_rawDescGZIP(), []int{6}
+	return file_channeld_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ChannelDataMergeOptions) GetShouldReplaceList() bool {
@@ -1027,10 +1510,25 @@ func (x *ChannelDataMergeOptions) GetShouldCheckRemovableMapField() bool {
 	return false
 }
 
+func (x *ChannelDataMergeOptions) GetConflictPolicy() ChannelDataMergeOptions_ConflictPolicy {
+	if x != nil {
+		return x.ConflictPolicy
+	}
+	return ChannelDataMergeOptions_LAST_PROCESSED_WINS
+}
+
+func (x *ChannelDataMergeOptions) GetFieldOptions() map[string]*FieldMergeOptions {
+	if x != nil {
+		return x.FieldOptions
+	}
+	return nil
+}
+
 // The message should have channelId = 0 in order to be handled.
 // Response: @CreateChannelResultMessage, if the MessageType is CREATE_CHANNEL and the channelType is not SPATIAL. The GLOBAL channel owner will also receive this message.
 // Response: @CreateSpatialChannelsResultMessage, if the MessageType is CREATE_SPATIAL_CHANNEL and the channelType is SPATIAL. The GLOBAL channel owner will also receive this message.
 // Response: @SubscribedToChannelResultMessage. The channel creator will also be subscribed to the channel immediately after the creation.
+// Response: @ErrorResultMessage, if the parent channel doesn't exist or the sender has no authority over it.
 type CreateChannelMessage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1041,12 +1539,22 @@ type CreateChannelMessage struct {
 	SubOptions   *ChannelSubscriptionOptions `protobuf:"bytes,3,opt,name=subOptions,proto3" json:"subOptions,omitempty"`
 	Data         *anypb.Any                  `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
 	MergeOptions *ChannelDataMergeOptions    `protobuf:"bytes,5,opt,name=mergeOptions,proto3" json:"mergeOptions,omitempty"`
+	// Optional. Overrides the MaxSubscribers in the channel settings of the channel type.
+	MaxSubscribers *ChannelSubscriberLimit `protobuf:"bytes,6,opt,name=maxSubscribers,proto3" json:"maxSubscribers,omitempty"`
+	// Optional. The ID of the parent channel. 0 means the channel has no parent.
+	// Only the owner of the parent channel or the GLOBAL channel owner can create the child channel.
+	// Removing the parent channel will also remove all its child channels.
+	ParentChannelId uint32 `protobuf:"varint,7,opt,name=parentChannelId,proto3" json:"parentChannelId,omitempty"`
+	// Optional. The initial attributes of the channel. See @UpdateChannelAttributesMessage.
+	Attributes map[string]*ChannelAttributeValue `protobuf:"bytes,8,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Optional. Overrides the EnablePresence in the channel settings of the channel type. See @ChannelPresenceMessage.
+	EnablePresence *bool `protobuf:"varint,9,opt,name=enablePresence,proto3,oneof" json:"enablePresence,omitempty"`
 }
 
 func (x *CreateChannelMessage) Reset() {
 	*x = CreateChannelMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_channeld_proto_msgTypes[7]
+		mi := &file_channeld_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1059,7 +1567,7 @@ func (x *CreateChannelMessage) String() string {
 func (*CreateChannelMessage) ProtoMessage() {}
 
 func (x *CreateChannelMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_channeld_proto_msgTypes[7]
+	mi := &file_channeld_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1072,7 +1580,7 @@ func (x *CreateChannelMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateChannelMessage.ProtoReflect.Descriptor instead.
 func (*CreateChannelMessage) Descriptor() ([]byte, []int) {
-	return file_channeld_proto_rawDescGZIP(), []int{7}
+	return file_channeld_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *CreateChannelMessage) GetChannelType() ChannelType {
@@ -1110,35 +1618,64 @@ func (x *CreateChannelMessage) GetMergeOptions() *ChannelDataMergeOptions {
 	return nil
 }
 
-type CreateChannelResultMessage struct {
+func (x *CreateChannelMessage) GetMaxSubscribers() *ChannelSubscriberLimit {
+	if x != nil {
+		return x.MaxSubscribers
+	}
+	return nil
+}
+
+func (x *CreateChannelMessage) GetParentChannelId() uint32 {
+	if x != nil {
+		return x.ParentChannelId
+	}
+	return 0
+}
+
+func (x *CreateChannelMessage) GetAttributes() map[string]*ChannelAttributeValue {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *CreateChannelMessage) GetEnablePresence() bool {
+	if x != nil && x.EnablePresence != nil {
+		return *x.EnablePresence
+	}
+	return false
+}
+
+// The typed value of a channel attribute.
+type ChannelAttributeValue struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ChannelType ChannelType `protobuf:"varint,1,opt,name=channelType,proto3,enum=channeldpb.ChannelType" json:"channelType,omitempty"`
-	Metadata    string      `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	OwnerConnId uint32      `protobuf:"varint,3,opt,name=ownerConnId,proto3" json:"ownerConnId,omitempty"`
-	// The ID of the newly-created channel. Add this field to differentiate it from MessagePack.channelId.
-	ChannelId uint32 `protobuf:"varint,4,opt,name=channelId,proto3" json:"channelId,omitempty"`
+	// Types that are assignable to Value:
+	//	*ChannelAttributeValue_StringValue
+	//	*ChannelAttributeValue_IntValue
+	//	*ChannelAttributeValue_BoolValue
+	Value isChannelAttributeValue_Value `protobuf_oneof:"value"`
 }
 
-func (x *CreateChannelResultMessage) Reset() {
-	*x = CreateChannelResultMessage{}
+func (x *ChannelAttributeValue) Reset() {
+	*x = ChannelAttributeValue{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_channeld_proto_msgTypes[8]
+		mi := &file_channeld_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateChannelResultMessage) String() string {
+func (x *ChannelAttributeValue) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateChannelResultMessage) ProtoMessage() {}
+func (*ChannelAttributeValue) ProtoMessage() {}
 
-func (x *CreateChannelResultMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_channeld_proto_msgTypes[8]
+func (x *ChannelAttributeValue) ProtoReflect() protoreflect.Message {
+	mi := &file_channeld_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1149,20 +1686,248 @@ func (x *CreateChannelResultMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateChannelResultMessage.ProtoReflect.Descriptor instead.
-func (*CreateChannelResultMessage) Descriptor() ([]byte, []int) {
-	return file_channeld_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use ChannelAttributeValue.ProtoReflect.Descriptor instead.
+func (*ChannelAttributeValue) Descriptor() ([]byte, []int) {
+	return file_channeld_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *CreateChannelResultMessage) GetChannelType() ChannelType {
-	if x != nil {
-		return x.ChannelType
+func (m *ChannelAttributeValue) GetValue() isChannelAttributeValue_Value {
+	if m != nil {
+		return m.Value
 	}
-	return ChannelType_UNKNOWN
+	return nil
 }
 
-func (x *CreateChannelResultMessage) GetMetadata() string {
-	if x != nil {
+func (x *ChannelAttributeValue) GetStringValue() string {
+	if x, ok := x.GetValue().(*ChannelAttributeValue_StringValue); ok {
+		return x.StringValue
+	}
+	return ""
+}
+
+func (x *ChannelAttributeValue) GetIntValue() int64 {
+	if x, ok := x.GetValue().(*ChannelAttributeValue_IntValue); ok {
+		return x.IntValue
+	}
+	return 0
+}
+
+func (x *ChannelAttributeValue) GetBoolValue() bool {
+	if x, ok := x.GetValue().(*ChannelAttributeValue_BoolValue); ok {
+		return x.BoolValue
+	}
+	return false
+}
+
+type isChannelAttributeValue_Value interface {
+	isChannelAttributeValue_Value()
+}
+
+type ChannelAttributeValue_StringValue struct {
+	StringValue string `protobuf:"bytes,1,opt,name=stringValue,proto3,oneof"`
+}
+
+type ChannelAttributeValue_IntValue struct {
+	IntValue int64 `protobuf:"varint,2,opt,name=intValue,proto3,oneof"`
+}
+
+type ChannelAttributeValue_BoolValue struct {
+	BoolValue bool `protobuf:"varint,3,opt,name=boolValue,proto3,oneof"`
+}
+
+func (*ChannelAttributeValue_StringValue) isChannelAttributeValue_Value() {}
+
+func (*ChannelAttributeValue_IntValue) isChannelAttributeValue_Value() {}
+
+func (*ChannelAttributeValue_BoolValue) isChannelAttributeValue_Value() {}
+
+// The filter of the channel attributes that is used in @ListChannelMessage and @AutoSubscribeMessage.
+// A filter is either a comparison of an attribute (EQ/NE/LT/LTE/GT/GTE/EXISTS/NOT_EXISTS), or a combination of the sub-filters (AND/OR).
+// A comparison is always false if the attribute doesn't exist, or the type of the attribute doesn't match the value.
+type ChannelAttributeFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Op ChannelAttributeFilter_Operator `protobuf:"varint,1,opt,name=op,proto3,enum=channeldpb.ChannelAttributeFilter_Operator" json:"op,omitempty"`
+	// The key of the attribute to compare. Ignored by AND/OR.
+	Key string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// The value to compare with. Ignored by EXISTS/AND/OR.
+	Value *ChannelAttributeValue `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	// Used by AND/OR.
+	SubFilters []*ChannelAttributeFilter `protobuf:"bytes,4,rep,name=subFilters,proto3" json:"subFilters,omitempty"`
+}
+
+func (x *ChannelAttributeFilter) Reset() {
+	*x = ChannelAttributeFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_channeld_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChannelAttributeFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelAttributeFilter) ProtoMessage() {}
+
+func (x *ChannelAttributeFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_channeld_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelAttributeFilter.ProtoReflect.Descriptor instead.
+func (*ChannelAttributeFilter) Descriptor() ([]byte, []int) {
+	return file_channeld_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ChannelAttributeFilter) GetOp() ChannelAttributeFilter_Operator {
+	if x != nil {
+		return x.Op
+	}
+	return ChannelAttributeFilter_EQ
+}
+
+func (x *ChannelAttributeFilter) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ChannelAttributeFilter) GetValue() *ChannelAttributeValue {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *ChannelAttributeFilter) GetSubFilters() []*ChannelAttributeFilter {
+	if x != nil {
+		return x.SubFilters
+	}
+	return nil
+}
+
+// The capacity of a channel. The clients and servers are counted separately. 0 means no limit.
+type ChannelSubscriberLimit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MaxClients uint32 `protobuf:"varint,1,opt,name=maxClients,proto3" json:"maxClients,omitempty"`
+	MaxServers uint32 `protobuf:"varint,2,opt,name=maxServers,proto3" json:"maxServers,omitempty"`
+}
+
+func (x *ChannelSubscriberLimit) Reset() {
+	*x = ChannelSubscriberLimit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_channeld_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChannelSubscriberLimit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelSubscriberLimit) ProtoMessage() {}
+
+func (x *ChannelSubscriberLimit) ProtoReflect() protoreflect.Message {
+	mi := &file_channeld_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelSubscriberLimit.ProtoReflect.Descriptor instead.
+func (*ChannelSubscriberLimit) Descriptor() ([]byte, []int) {
+	return file_channeld_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ChannelSubscriberLimit) GetMaxClients() uint32 {
+	if x != nil {
+		return x.MaxClients
+	}
+	return 0
+}
+
+func (x *ChannelSubscriberLimit) GetMaxServers() uint32 {
+	if x != nil {
+		return x.MaxServers
+	}
+	return 0
+}
+
+type CreateChannelResultMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChannelType ChannelType `protobuf:"varint,1,opt,name=channelType,proto3,enum=channeldpb.ChannelType" json:"channelType,omitempty"`
+	Metadata    string      `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	OwnerConnId uint32      `protobuf:"varint,3,opt,name=ownerConnId,proto3" json:"ownerConnId,omitempty"`
+	// The ID of the newly-created channel. Add this field to differentiate it from MessagePack.channelId.
+	ChannelId       uint32 `protobuf:"varint,4,opt,name=channelId,proto3" json:"channelId,omitempty"`
+	ParentChannelId uint32 `protobuf:"varint,5,opt,name=parentChannelId,proto3" json:"parentChannelId,omitempty"`
+}
+
+func (x *CreateChannelResultMessage) Reset() {
+	*x = CreateChannelResultMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_channeld_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateChannelResultMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateChannelResultMessage) ProtoMessage() {}
+
+func (x *CreateChannelResultMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_channeld_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateChannelResultMessage.ProtoReflect.Descriptor instead.
+func (*CreateChannelResultMessage) Descriptor() ([]byte, []int) {
+	return file_channeld_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CreateChannelResultMessage) GetChannelType() ChannelType {
+	if x != nil {
+		return x.ChannelType
+	}
+	return ChannelType_UNKNOWN
+}
+
+func (x *CreateChannelResultMessage) GetMetadata() string {
+	if x != nil {
 		return x.Metadata
 	}
 	return ""
@@ -1182,8 +1947,16 @@ func (x *CreateChannelResultMessage) GetChannelId() uint32 {
 	return 0
 }
 
+func (x *CreateChannelResultMessage) GetParentChannelId() uint32 {
+	if x != nil {
+		return x.ParentChannelId
+	}
+	return 0
+}
+
 // The message should have channelId = 0 in order to be handled.
 // Response: all connections in the channel will receive @RemoveChannelMessage. The GLOBAL channel owner will also receive this message.
+// The child channels are removed before the channel, and all connections in the child channels will also receive @RemoveChannelMessage.
 type RemoveChannelMessage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1195,7 +1968,7 @@ type RemoveChannelMessage struct {
 func (x *RemoveChannelMessage) Reset() {
 	*x = RemoveChannelMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_channeld_proto_msgTypes[9]
+		mi := &file_channeld_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1208,7 +1981,7 @@ func (x *RemoveChannelMessage) String() string {
 func (*RemoveChannelMessage) ProtoMessage() {}
 
 func (x *RemoveChannelMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_channeld_proto_msgTypes[9]
+	mi := &file_channeld_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1221,7 +1994,7 @@ func (x *RemoveChannelMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveChannelMessage.ProtoReflect.Descriptor instead.
 func (*RemoveChannelMessage) Descriptor() ([]byte, []int) {
-	return file_channeld_proto_rawDescGZIP(), []int{9}
+	return file_channeld_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *RemoveChannelMessage) GetChannelId() uint32 {
@@ -1238,14 +2011,27 @@ type ListChannelMessage struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TypeFilter      ChannelType `protobuf:"varint,1,opt,name=typeFilter,proto3,enum=channeldpb.ChannelType" json:"typeFilter,omitempty"`
-	MetadataFilters []string    `protobuf:"bytes,2,rep,name=metadataFilters,proto3" json:"metadataFilters,omitempty"`
+	TypeFilter ChannelType `protobuf:"varint,1,opt,name=typeFilter,proto3,enum=channeldpb.ChannelType" json:"typeFilter,omitempty"`
+	// A channel matches if its metadata contains any of the filters.
+	MetadataFilters []string `protobuf:"bytes,2,rep,name=metadataFilters,proto3" json:"metadataFilters,omitempty"`
+	// If not 0, only the channel with the ID and its descendant channels are listed.
+	SubtreeRootId uint32 `protobuf:"varint,3,opt,name=subtreeRootId,proto3" json:"subtreeRootId,omitempty"`
+	// Optional. Only the channels whose attributes match the filter are listed.
+	AttributeFilter *ChannelAttributeFilter `protobuf:"bytes,4,opt,name=attributeFilter,proto3" json:"attributeFilter,omitempty"`
+	// Optional. The key of the attribute to sort the result by. The channels without the attribute are put at the end.
+	// By default, the result is sorted by the channel ID.
+	SortByAttribute string `protobuf:"bytes,5,opt,name=sortByAttribute,proto3" json:"sortByAttribute,omitempty"`
+	SortDescending  bool   `protobuf:"varint,6,opt,name=sortDescending,proto3" json:"sortDescending,omitempty"`
+	// The max number of channels in the result. 0 means no limit.
+	PageSize uint32 `protobuf:"varint,7,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	// The nextCursor in the previous @ListChannelResultMessage, to get the next page. Should be used with the same filters and sorting.
+	Cursor []byte `protobuf:"bytes,8,opt,name=cursor,proto3" json:"cursor,omitempty"`
 }
 
 func (x *ListChannelMessage) Reset() {
 	*x = ListChannelMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_channeld_proto_msgTypes[10]
+		mi := &file_channeld_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1258,7 +2044,7 @@ func (x *ListChannelMessage) String() string {
 func (*ListChannelMessage) ProtoMessage() {}
 
 func (x *ListChannelMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_channeld_proto_msgTypes[10]
+	mi := &file_channeld_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1271,7 +2057,7 @@ func (x *ListChannelMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListChannelMessage.ProtoReflect.Descriptor instead.
 func (*ListChannelMessage) Descriptor() ([]byte, []int) {
-	return file_channeld_proto_rawDescGZIP(), []int{10}
+	return file_channeld_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *ListChannelMessage) GetTypeFilter() ChannelType {
@@ -1288,18 +2074,62 @@ func (x *ListChannelMessage) GetMetadataFilters() []string {
 	return nil
 }
 
+func (x *ListChannelMessage) GetSubtreeRootId() uint32 {
+	if x != nil {
+		return x.SubtreeRootId
+	}
+	return 0
+}
+
+func (x *ListChannelMessage) GetAttributeFilter() *ChannelAttributeFilter {
+	if x != nil {
+		return x.AttributeFilter
+	}
+	return nil
+}
+
+func (x *ListChannelMessage) GetSortByAttribute() string {
+	if x != nil {
+		return x.SortByAttribute
+	}
+	return ""
+}
+
+func (x *ListChannelMessage) GetSortDescending() bool {
+	if x != nil {
+		return x.SortDescending
+	}
+	return false
+}
+
+func (x *ListChannelMessage) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListChannelMessage) GetCursor() []byte {
+	if x != nil {
+		return x.Cursor
+	}
+	return nil
+}
+
 type ListChannelResultMessage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Channels []*ListChannelResultMessage_ChannelInfo `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels,omitempty"`
+	// Empty if there's no more page.
+	NextCursor []byte `protobuf:"bytes,2,opt,name=nextCursor,proto3" json:"nextCursor,omitempty"`
 }
 
 func (x *ListChannelResultMessage) Reset() {
 	*x = ListChannelResultMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_channeld_proto_msgTypes[11]
+		mi := &file_channeld_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1312,7 +2142,7 @@ func (x *ListChannelResultMessage) String() string {
 func (*ListChannelResultMessage) ProtoMessage() {}
 
 func (x *ListChannelResultMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_channeld_proto_msgTypes[11]
+	mi := &file_channeld_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1325,7 +2155,7 @@ func (x *ListChannelResultMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListChannelResultMessage.ProtoReflect.Descriptor instead.
 func (*ListChannelResultMessage) Descriptor() ([]byte, []int) {
-	return file_channeld_proto_rawDescGZIP(), []int{11}
+	return file_channeld_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *ListChannelResultMessage) GetChannels() []*ListChannelResultMessage_ChannelInfo {
@@ -1335,8 +2165,16 @@ func (x *ListChannelResultMessage) GetChannels() []*ListChannelResultMessage_Cha
 	return nil
 }
 
+func (x *ListChannelResultMessage) GetNextCursor() []byte {
+	if x != nil {
+		return x.NextCursor
+	}
+	return nil
+}
+
 // Response: @SubscribedToChannelResultMessage. The message sender, the subscribed connection (if not the sender), and the channel owner will receive the message respectively.
-// If the connection has already been subscripbed to the channel, the subOptions will be merged, but no response message will be sent.
+// If the connection has already been subscripbed to the channel, the subOptions will be merged. Use @UpdateSubOptionsMessage to replace them.
+// Response: @ErrorResultMessage, if the channel has reached the max number of subscribers of the connection type.
 type SubscribedToChannelMessage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1346,12 +2184,16 @@ type SubscribedToChannelMessage struct {
 	// Remarks: only the channel owner or the GLOBAL channel owner can sub another connection to the channel.
 	ConnId     uint32                      `protobuf:"varint,1,opt,name=connId,proto3" json:"connId,omitempty"`
 	SubOptions *ChannelSubscriptionOptions `protobuf:"bytes,2,opt,name=subOptions,proto3" json:"subOptions,omitempty"`
+	// If true, the connection will also be subscribed to the descendant channels, with the same subOptions.
+	// The subscription to each descendant channel is handled in the same way as a SubscribedToChannelMessage sent to it (ACL, capacity, etc.),
+	// and the responses are sent without the stubId.
+	SubToChildren bool `protobuf:"varint,3,opt,name=subToChildren,proto3" json:"subToChildren,omitempty"`
 }
 
 func (x *SubscribedToChannelMessage) Reset() {
 	*x = SubscribedToChannelMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_channeld_proto_msgTypes[12]
+		mi := &file_channeld_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1364,7 +2206,7 @@ func (x *SubscribedToChannelMessage) String() string {
 func (*SubscribedToChannelMessage) ProtoMessage() {}
 
 func (x *SubscribedToChannelMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_channeld_proto_msgTypes[12]
+	mi := &file_channeld_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1377,7 +2219,7 @@ func (x *SubscribedToChannelMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubscribedToChannelMessage.ProtoReflect.Descriptor instead.
 func (*SubscribedToChannelMessage) Descriptor() ([]byte, []int) {
-	return file_channeld_proto_rawDescGZIP(), []int{12}
+	return file_channeld_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *SubscribedToChannelMessage) GetConnId() uint32 {
@@ -1394,6 +2236,13 @@ func (x *SubscribedToChannelMessage) GetSubOptions() *ChannelSubscriptionOptions
 	return nil
 }
 
+func (x *SubscribedToChannelMessage) GetSubToChildren() bool {
+	if x != nil {
+		return x.SubToChildren
+	}
+	return false
+}
+
 type SubscribedToChannelResultMessage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1409,7 +2258,7 @@ type SubscribedToChannelResultMessage struct {
 func (x *SubscribedToChannelResultMessage) Reset() {
 	*x = SubscribedToChannelResultMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_channeld_proto_msgTypes[13]
+		mi := &file_channeld_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1422,7 +2271,7 @@ func (x *SubscribedToChannelResultMessage) String() string {
 func (*SubscribedToChannelResultMessage) ProtoMessage() {}
 
 func (x *SubscribedToChannelResultMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_channeld_proto_msgTypes[13]
+	mi := &file_channeld_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1435,7 +2284,7 @@ func (x *SubscribedToChannelResultMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubscribedToChannelResultMessage.ProtoReflect.Descriptor instead.
 func (*SubscribedToChannelResultMessage) Descriptor() ([]byte, []int) {
-	return file_channeld_proto_rawDescGZIP(), []int{13}
+	return file_channeld_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *SubscribedToChannelResultMessage) GetConnId() uint32 {
@@ -1466,34 +2315,42 @@ func (x *SubscribedToChannelResultMessage) GetChannelType() ChannelType {
 	return ChannelType_UNKNOWN
 }
 
-// Response: @UnsubscribedFromChannelResultMessage. The message sender, the connection that unsubscribed, and the channel owner will receive the message respectively.
-type UnsubscribedFromChannelMessage struct {
+// Replace the subscription options of a connection to the channel that the message is sent to, without resubscribing.
+// Unlike @SubscribedToChannelMessage, the options are replaced rather than merged: the unset fields are reset to the defaults
+// of the channel type, e.g. the dataFieldMasks are removed if not set. If the data field masks change, the subscriber will receive
+// the full channel data (filtered by the new masks) in the next fan-out.
+// Remarks: only the channel owner or the GLOBAL channel owner can update the options of another connection.
+// A client updating its own options keeps the dataAccess and writeFieldMasks of the subscription.
+// Response: @UpdateSubOptionsResultMessage. The message sender and the subscribed connection (if not the sender) will receive the message respectively.
+// Response: @ErrorResultMessage, if the connection is not subscribed to the channel, or has no access.
+type UpdateSubOptionsMessage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The connection to be removed from the channel is not necessarily the one sends the message.
-	// Remarks: only the channel owner or the GLOBAL channel can unsub another connection from the channel.
+	// Ignored if the sender is a client connection, which can only update its own options.
 	ConnId uint32 `protobuf:"varint,1,opt,name=connId,proto3" json:"connId,omitempty"`
+	// The bandwidthBudget is kept if not set, as it applies to the connection rather than the subscription.
+	SubOptions *ChannelSubscriptionOptions `protobuf:"bytes,2,opt,name=subOptions,proto3" json:"subOptions,omitempty"`
 }
 
-func (x *UnsubscribedFromChannelMessage) Reset() {
-	*x = UnsubscribedFromChannelMessage{}
+func (x *UpdateSubOptionsMessage) Reset() {
+	*x = UpdateSubOptionsMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_channeld_proto_msgTypes[14]
+		mi := &file_channeld_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UnsubscribedFromChannelMessage) String() string {
+func (x *UpdateSubOptionsMessage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnsubscribedFromChannelMessage) ProtoMessage() {}
+func (*UpdateSubOptionsMessage) ProtoMessage() {}
 
-func (x *UnsubscribedFromChannelMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_channeld_proto_msgTypes[14]
+func (x *UpdateSubOptionsMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_channeld_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1504,46 +2361,52 @@ func (x *UnsubscribedFromChannelMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnsubscribedFromChannelMessage.ProtoReflect.Descriptor instead.
-func (*UnsubscribedFromChannelMessage) Descriptor() ([]byte, []int) {
-	return file_channeld_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use UpdateSubOptionsMessage.ProtoReflect.Descriptor instead.
+func (*UpdateSubOptionsMessage) Descriptor() ([]byte, []int) {
+	return file_channeld_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *UnsubscribedFromChannelMessage) GetConnId() uint32 {
+func (x *UpdateSubOptionsMessage) GetConnId() uint32 {
 	if x != nil {
 		return x.ConnId
 	}
 	return 0
 }
 
-type UnsubscribedFromChannelResultMessage struct {
+func (x *UpdateSubOptionsMessage) GetSubOptions() *ChannelSubscriptionOptions {
+	if x != nil {
+		return x.SubOptions
+	}
+	return nil
+}
+
+type UpdateSubOptionsResultMessage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The connection that unsubsribed.
-	ConnId      uint32         `protobuf:"varint,1,opt,name=connId,proto3" json:"connId,omitempty"`
-	ConnType    ConnectionType `protobuf:"varint,2,opt,name=connType,proto3,enum=channeldpb.ConnectionType" json:"connType,omitempty"`
-	ChannelType ChannelType    `protobuf:"varint,3,opt,name=channelType,proto3,enum=channeldpb.ChannelType" json:"channelType,omitempty"`
+	ConnId uint32 `protobuf:"varint,1,opt,name=connId,proto3" json:"connId,omitempty"`
+	// The options after the update, including the defaults.
+	SubOptions *ChannelSubscriptionOptions `protobuf:"bytes,2,opt,name=subOptions,proto3" json:"subOptions,omitempty"`
 }
 
-func (x *UnsubscribedFromChannelResultMessage) Reset() {
-	*x = UnsubscribedFromChannelResultMessage{}
+func (x *UpdateSubOptionsResultMessage) Reset() {
+	*x = UpdateSubOptionsResultMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_channeld_proto_msgTypes[15]
+		mi := &file_channeld_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UnsubscribedFromChannelResultMessage) String() string {
+func (x *UpdateSubOptionsResultMessage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnsubscribedFromChannelResultMessage) ProtoMessage() {}
+func (*UpdateSubOptionsResultMessage) ProtoMessage() {}
 
-func (x *UnsubscribedFromChannelResultMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_channeld_proto_msgTypes[15]
+func (x *UpdateSubOptionsResultMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_channeld_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1554,62 +2417,55 @@ func (x *UnsubscribedFromChannelResultMessage) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnsubscribedFromChannelResultMessage.ProtoReflect.Descriptor instead.
-func (*UnsubscribedFromChannelResultMessage) Descriptor() ([]byte, []int) {
-	return file_channeld_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use UpdateSubOptionsResultMessage.ProtoReflect.Descriptor instead.
+func (*UpdateSubOptionsResultMessage) Descriptor() ([]byte, []int) {
+	return file_channeld_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *UnsubscribedFromChannelResultMessage) GetConnId() uint32 {
+func (x *UpdateSubOptionsResultMessage) GetConnId() uint32 {
 	if x != nil {
 		return x.ConnId
 	}
 	return 0
 }
 
-func (x *UnsubscribedFromChannelResultMessage) GetConnType() ConnectionType {
-	if x != nil {
-		return x.ConnType
-	}
-	return ConnectionType_NO_CONNECTION
-}
-
-func (x *UnsubscribedFromChannelResultMessage) GetChannelType() ChannelType {
+func (x *UpdateSubOptionsResultMessage) GetSubOptions() *ChannelSubscriptionOptions {
 	if x != nil {
-		return x.ChannelType
+		return x.SubOptions
 	}
-	return ChannelType_UNKNOWN
+	return nil
 }
 
-// Response: no. Each connection in the channel receives the @ChannelDataUpdateMessage in every @ChannelSubscriptionOptions.FanOutIntervalMs
-type ChannelDataUpdateMessage struct {
+// Sent by channeld to the subscribers of a channel that has presence enabled.
+// When a connection subscribes to the channel, the other subscribers receive a JOIN event, and the newcomer receives a MEMBER_LIST event once.
+// When a connection unsubscribes from the channel or disconnects, the remaining subscribers receive a LEAVE event.
+type ChannelPresenceMessage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Data *anypb.Any `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
-	// The ID of the connection that causes the update of the channel data.
-	// In a server-authoratative system (which means the @ChannelDataUpdateMessage will only be sent by server), the servers need to send this field to channeld.
-	// If the sender is a client, this field will be ignored.
-	ContextConnId uint32 `protobuf:"varint,2,opt,name=contextConnId,proto3" json:"contextConnId,omitempty"`
+	EventType ChannelPresenceMessage_EventType `protobuf:"varint,1,opt,name=eventType,proto3,enum=channeldpb.ChannelPresenceMessage_EventType" json:"eventType,omitempty"`
+	// For JOIN and LEAVE, the connection that joined or left.
+	Members []*ChannelPresenceMessage_MemberInfo `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
 }
 
-func (x *ChannelDataUpdateMessage) Reset() {
-	*x = ChannelDataUpdateMessage{}
+func (x *ChannelPresenceMessage) Reset() {
+	*x = ChannelPresenceMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_channeld_proto_msgTypes[16]
+		mi := &file_channeld_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ChannelDataUpdateMessage) String() string {
+func (x *ChannelPresenceMessage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChannelDataUpdateMessage) ProtoMessage() {}
+func (*ChannelPresenceMessage) ProtoMessage() {}
 
-func (x *ChannelDataUpdateMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_channeld_proto_msgTypes[16]
+func (x *ChannelPresenceMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_channeld_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1620,54 +2476,53 @@ func (x *ChannelDataUpdateMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChannelDataUpdateMessage.ProtoReflect.Descriptor instead.
-func (*ChannelDataUpdateMessage) Descriptor() ([]byte, []int) {
-	return file_channeld_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use ChannelPresenceMessage.ProtoReflect.Descriptor instead.
+func (*ChannelPresenceMessage) Descriptor() ([]byte, []int) {
+	return file_channeld_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *ChannelDataUpdateMessage) GetData() *anypb.Any {
+func (x *ChannelPresenceMessage) GetEventType() ChannelPresenceMessage_EventType {
 	if x != nil {
-		return x.Data
+		return x.EventType
 	}
-	return nil
+	return ChannelPresenceMessage_JOIN
 }
 
-func (x *ChannelDataUpdateMessage) GetContextConnId() uint32 {
+func (x *ChannelPresenceMessage) GetMembers() []*ChannelPresenceMessage_MemberInfo {
 	if x != nil {
-		return x.ContextConnId
+		return x.Members
 	}
-	return 0
+	return nil
 }
 
-// Disconnect another connection from channeld.
-// This message should only be sent by the server connection in a server-authoratative environment.
-// The message should have channelId = 0 in order to be handled.
-// Response: no.
-type DisconnectMessage struct {
+// Response: @UnsubscribedFromChannelResultMessage. The message sender, the connection that unsubscribed, and the channel owner will receive the message respectively.
+type UnsubscribedFromChannelMessage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// The connection to be removed from the channel is not necessarily the one sends the message.
+	// Remarks: only the channel owner or the GLOBAL channel can unsub another connection from the channel.
 	ConnId uint32 `protobuf:"varint,1,opt,name=connId,proto3" json:"connId,omitempty"`
 }
 
-func (x *DisconnectMessage) Reset() {
-	*x = DisconnectMessage{}
+func (x *UnsubscribedFromChannelMessage) Reset() {
+	*x = UnsubscribedFromChannelMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_channeld_proto_msgTypes[17]
+		mi := &file_channeld_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DisconnectMessage) String() string {
+func (x *UnsubscribedFromChannelMessage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DisconnectMessage) ProtoMessage() {}
+func (*UnsubscribedFromChannelMessage) ProtoMessage() {}
 
-func (x *DisconnectMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_channeld_proto_msgTypes[17]
+func (x *UnsubscribedFromChannelMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_channeld_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1678,46 +2533,46 @@ func (x *DisconnectMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DisconnectMessage.ProtoReflect.Descriptor instead.
-func (*DisconnectMessage) Descriptor() ([]byte, []int) {
-	return file_channeld_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use UnsubscribedFromChannelMessage.ProtoReflect.Descriptor instead.
+func (*UnsubscribedFromChannelMessage) Descriptor() ([]byte, []int) {
+	return file_channeld_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *DisconnectMessage) GetConnId() uint32 {
+func (x *UnsubscribedFromChannelMessage) GetConnId() uint32 {
 	if x != nil {
 		return x.ConnId
 	}
 	return 0
 }
 
-// Left-handed coordinate system with Y-up rule.
-type SpatialInfo struct {
+type UnsubscribedFromChannelResultMessage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	X float64 `protobuf:"fixed64,1,opt,name=x,proto3" json:"x,omitempty"`
-	Y float64 `protobuf:"fixed64,2,opt,name=y,proto3" json:"y,omitempty"`
-	Z float64 `protobuf:"fixed64,3,opt,name=z,proto3" json:"z,omitempty"`
+	// The connection that unsubsribed.
+	ConnId      uint32         `protobuf:"varint,1,opt,name=connId,proto3" json:"connId,omitempty"`
+	ConnType    ConnectionType `protobuf:"varint,2,opt,name=connType,proto3,enum=channeldpb.ConnectionType" json:"connType,omitempty"`
+	ChannelType ChannelType    `protobuf:"varint,3,opt,name=channelType,proto3,enum=channeldpb.ChannelType" json:"channelType,omitempty"`
 }
 
-func (x *SpatialInfo) Reset() {
-	*x = SpatialInfo{}
+func (x *UnsubscribedFromChannelResultMessage) Reset() {
+	*x = UnsubscribedFromChannelResultMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_channeld_proto_msgTypes[18]
+		mi := &file_channeld_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SpatialInfo) String() string {
+func (x *UnsubscribedFromChannelResultMessage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SpatialInfo) ProtoMessage() {}
+func (*UnsubscribedFromChannelResultMessage) ProtoMessage() {}
 
-func (x *SpatialInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_channeld_proto_msgTypes[18]
+func (x *UnsubscribedFromChannelResultMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_channeld_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1728,59 +2583,73 @@ func (x *SpatialInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SpatialInfo.ProtoReflect.Descriptor instead.
-func (*SpatialInfo) Descriptor() ([]byte, []int) {
-	return file_channeld_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use UnsubscribedFromChannelResultMessage.ProtoReflect.Descriptor instead.
+func (*UnsubscribedFromChannelResultMessage) Descriptor() ([]byte, []int) {
+	return file_channeld_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *SpatialInfo) GetX() float64 {
+func (x *UnsubscribedFromChannelResultMessage) GetConnId() uint32 {
 	if x != nil {
-		return x.X
+		return x.ConnId
 	}
 	return 0
 }
 
-func (x *SpatialInfo) GetY() float64 {
+func (x *UnsubscribedFromChannelResultMessage) GetConnType() ConnectionType {
 	if x != nil {
-		return x.Y
+		return x.ConnType
 	}
-	return 0
+	return ConnectionType_NO_CONNECTION
 }
 
-func (x *SpatialInfo) GetZ() float64 {
+func (x *UnsubscribedFromChannelResultMessage) GetChannelType() ChannelType {
 	if x != nil {
-		return x.Z
+		return x.ChannelType
 	}
-	return 0
+	return ChannelType_UNKNOWN
 }
 
-type CreateSpatialChannelsResultMessage struct {
+// Response: no. Each connection in the channel receives the @ChannelDataUpdateMessage in every @ChannelSubscriptionOptions.FanOutIntervalMs
+type ChannelDataUpdateMessage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SpatialChannelId []uint32 `protobuf:"varint,1,rep,packed,name=spatialChannelId,proto3" json:"spatialChannelId,omitempty"`
-	Metadata         string   `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	OwnerConnId      uint32   `protobuf:"varint,3,opt,name=ownerConnId,proto3" json:"ownerConnId,omitempty"`
+	Data *anypb.Any `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	// The ID of the connection that causes the update of the channel data.
+	// In a server-authoratative system (which means the @ChannelDataUpdateMessage will only be sent by server), the servers need to send this field to channeld.
+	// If the sender is a client, this field will be ignored.
+	ContextConnId uint32 `protobuf:"varint,2,opt,name=contextConnId,proto3" json:"contextConnId,omitempty"`
+	// Only set in the fan-out to the subscriber with @ChannelSubscriptionOptions.deltaFanOut.
+	// The index of the latest update in the data. The subscriber should acknowledge it via @ChannelDataAckMessage.
+	MsgIndex uint64 `protobuf:"varint,3,opt,name=msgIndex,proto3" json:"msgIndex,omitempty"`
+	// Only set in the fan-out to the subscriber with @ChannelSubscriptionOptions.deltaFanOut.
+	// If true, the data contains the full states and should replace the local data, instead of being merged into it.
+	FullState bool `protobuf:"varint,4,opt,name=fullState,proto3" json:"fullState,omitempty"`
+	// The time when the sender made the update, in Unix milliseconds by the sender's clock. Used by the LAST_WRITER_WINS @ChannelDataMergeOptions.conflictPolicy.
+	Timestamp int64 `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// The versions of the top-level fields (or the entries of the top-level map fields) that the update is based on, keyed by the path, e.g. "text" or "players.1001".
+	// Used by the VERSION_COUNTER @ChannelDataMergeOptions.conflictPolicy.
+	FieldVersions map[string]uint64 `protobuf:"bytes,6,rep,name=fieldVersions,proto3" json:"fieldVersions,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
 }
 
-func (x *CreateSpatialChannelsResultMessage) Reset() {
-	*x = CreateSpatialChannelsResultMessage{}
+func (x *ChannelDataUpdateMessage) Reset() {
+	*x = ChannelDataUpdateMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_channeld_proto_msgTypes[19]
+		mi := &file_channeld_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateSpatialChannelsResultMessage) String() string {
+func (x *ChannelDataUpdateMessage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateSpatialChannelsResultMessage) ProtoMessage() {}
+func (*ChannelDataUpdateMessage) ProtoMessage() {}
 
-func (x *CreateSpatialChannelsResultMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_channeld_proto_msgTypes[19]
+func (x *ChannelDataUpdateMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_channeld_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1791,59 +2660,81 @@ func (x *CreateSpatialChannelsResultMessage) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateSpatialChannelsResultMessage.ProtoReflect.Descriptor instead.
-func (*CreateSpatialChannelsResultMessage) Descriptor() ([]byte, []int) {
-	return file_channeld_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use ChannelDataUpdateMessage.ProtoReflect.Descriptor instead.
+func (*ChannelDataUpdateMessage) Descriptor() ([]byte, []int) {
+	return file_channeld_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *CreateSpatialChannelsResultMessage) GetSpatialChannelId() []uint32 {
+func (x *ChannelDataUpdateMessage) GetData() *anypb.Any {
 	if x != nil {
-		return x.SpatialChannelId
+		return x.Data
 	}
 	return nil
 }
 
-func (x *CreateSpatialChannelsResultMessage) GetMetadata() string {
+func (x *ChannelDataUpdateMessage) GetContextConnId() uint32 {
 	if x != nil {
-		return x.Metadata
+		return x.ContextConnId
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateSpatialChannelsResultMessage) GetOwnerConnId() uint32 {
+func (x *ChannelDataUpdateMessage) GetMsgIndex() uint64 {
 	if x != nil {
-		return x.OwnerConnId
+		return x.MsgIndex
 	}
 	return 0
 }
 
-// The message should have channelId = 0 in order to be handled.
-// Response: @QuerySpatialChannelResultMessage
-type QuerySpatialChannelMessage struct {
+func (x *ChannelDataUpdateMessage) GetFullState() bool {
+	if x != nil {
+		return x.FullState
+	}
+	return false
+}
+
+func (x *ChannelDataUpdateMessage) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *ChannelDataUpdateMessage) GetFieldVersions() map[string]uint64 {
+	if x != nil {
+		return x.FieldVersions
+	}
+	return nil
+}
+
+// Sent by channeld to the connection whose @ChannelDataUpdateMessage lost the conflict with another writer.
+// The fields that lost are not merged into the channel data. See @ChannelDataMergeOptions.conflictPolicy.
+type ChannelDataConflictMessage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SpatialInfo []*SpatialInfo `protobuf:"bytes,1,rep,name=spatialInfo,proto3" json:"spatialInfo,omitempty"`
+	Policy    ChannelDataMergeOptions_ConflictPolicy      `protobuf:"varint,1,opt,name=policy,proto3,enum=channeldpb.ChannelDataMergeOptions_ConflictPolicy" json:"policy,omitempty"`
+	Conflicts []*ChannelDataConflictMessage_FieldConflict `protobuf:"bytes,2,rep,name=conflicts,proto3" json:"conflicts,omitempty"`
 }
 
-func (x *QuerySpatialChannelMessage) Reset() {
-	*x = QuerySpatialChannelMessage{}
+func (x *ChannelDataConflictMessage) Reset() {
+	*x = ChannelDataConflictMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_channeld_proto_msgTypes[20]
+		mi := &file_channeld_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QuerySpatialChannelMessage) String() string {
+func (x *ChannelDataConflictMessage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QuerySpatialChannelMessage) ProtoMessage() {}
+func (*ChannelDataConflictMessage) ProtoMessage() {}
 
-func (x *QuerySpatialChannelMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_channeld_proto_msgTypes[20]
+func (x *ChannelDataConflictMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_channeld_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1854,43 +2745,54 @@ func (x *QuerySpatialChannelMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QuerySpatialChannelMessage.ProtoReflect.Descriptor instead.
-func (*QuerySpatialChannelMessage) Descriptor() ([]byte, []int) {
-	return file_channeld_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use ChannelDataConflictMessage.ProtoReflect.Descriptor instead.
+func (*ChannelDataConflictMessage) Descriptor() ([]byte, []int) {
+	return file_channeld_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *QuerySpatialChannelMessage) GetSpatialInfo() []*SpatialInfo {
+func (x *ChannelDataConflictMessage) GetPolicy() ChannelDataMergeOptions_ConflictPolicy {
 	if x != nil {
-		return x.SpatialInfo
+		return x.Policy
+	}
+	return ChannelDataMergeOptions_LAST_PROCESSED_WINS
+}
+
+func (x *ChannelDataConflictMessage) GetConflicts() []*ChannelDataConflictMessage_FieldConflict {
+	if x != nil {
+		return x.Conflicts
 	}
 	return nil
 }
 
-type QuerySpatialChannelResultMessage struct {
+// Acknowledge the @ChannelDataUpdateMessage received by the subscriber with @ChannelSubscriptionOptions.deltaFanOut.
+// The following fan-outs to the subscriber will be based on the acknowledged update.
+// Response: no.
+type ChannelDataAckMessage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ChannelId []uint32 `protobuf:"varint,1,rep,packed,name=channelId,proto3" json:"channelId,omitempty"`
+	// The @ChannelDataUpdateMessage.msgIndex of the received update.
+	MsgIndex uint64 `protobuf:"varint,1,opt,name=msgIndex,proto3" json:"msgIndex,omitempty"`
 }
 
-func (x *QuerySpatialChannelResultMessage) Reset() {
-	*x = QuerySpatialChannelResultMessage{}
+func (x *ChannelDataAckMessage) Reset() {
+	*x = ChannelDataAckMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_channeld_proto_msgTypes[21]
+		mi := &file_channeld_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QuerySpatialChannelResultMessage) String() string {
+func (x *ChannelDataAckMessage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QuerySpatialChannelResultMessage) ProtoMessage() {}
+func (*ChannelDataAckMessage) ProtoMessage() {}
 
-func (x *QuerySpatialChannelResultMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_channeld_proto_msgTypes[21]
+func (x *ChannelDataAckMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_channeld_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1901,50 +2803,1746 @@ func (x *QuerySpatialChannelResultMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QuerySpatialChannelResultMessage.ProtoReflect.Descriptor instead.
-func (*QuerySpatialChannelResultMessage) Descriptor() ([]byte, []int) {
-	return file_channeld_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use ChannelDataAckMessage.ProtoReflect.Descriptor instead.
+func (*ChannelDataAckMessage) Descriptor() ([]byte, []int) {
+	return file_channeld_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *QuerySpatialChannelResultMessage) GetChannelId() []uint32 {
+func (x *ChannelDataAckMessage) GetMsgIndex() uint64 {
 	if x != nil {
-		return x.ChannelId
+		return x.MsgIndex
 	}
-	return nil
+	return 0
 }
 
-// ALL connections in the source AND destination channels receive this messge when a handover happpned.
-// Handover means an object moves from a spatial channel to another. It doesn't necessarily mean the objece moves from a spatial server to another.
-type ChannelDataHandoverMessage struct {
+// Reserve the subscriber slots of the channel for the specified connections, or release the reserved slots.
+// A reserved slot is counted as a subscriber, and can only be taken by the connection it's reserved for, even if the channel is full.
+// The slot is released once the connection subscribes to the channel. Only the channel owner can send this message.
+// Response: @ErrorResultMessage, if the slots can't be reserved.
+type ReserveSubscriberSlotsMessage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SrcChannelId uint32 `protobuf:"varint,1,opt,name=srcChannelId,proto3" json:"srcChannelId,omitempty"`
-	DstChannelId uint32 `protobuf:"varint,2,opt,name=dstChannelId,proto3" json:"dstChannelId,omitempty"`
-	// The ID of the client connection that triggered the handover. If the handover is triggered by server (e.g. NPC movement), the value will be 0.
-	ContextConnId uint32 `protobuf:"varint,3,opt,name=contextConnId,proto3" json:"contextConnId,omitempty"`
-	// The data that migrate from the source channel to the destination channel. It can be the spatial channel data or anything, as long as the spatial servers can use it to process the handover.
+	ConnIdsToReserve []uint32 `protobuf:"varint,1,rep,packed,name=connIdsToReserve,proto3" json:"connIdsToReserve,omitempty"`
+	ConnIdsToRelease []uint32 `protobuf:"varint,2,rep,packed,name=connIdsToRelease,proto3" json:"connIdsToRelease,omitempty"`
+}
+
+func (x *ReserveSubscriberSlotsMessage) Reset() {
+	*x = ReserveSubscriberSlotsMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_channeld_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReserveSubscriberSlotsMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveSubscriberSlotsMessage) ProtoMessage() {}
+
+func (x *ReserveSubscriberSlotsMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_channeld_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReserveSubscriberSlotsMessage.ProtoReflect.Descriptor instead.
+func (*ReserveSubscriberSlotsMessage) Descriptor() ([]byte, []int) {
+	return file_channeld_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ReserveSubscriberSlotsMessage) GetConnIdsToReserve() []uint32 {
+	if x != nil {
+		return x.ConnIdsToReserve
+	}
+	return nil
+}
+
+func (x *ReserveSubscriberSlotsMessage) GetConnIdsToRelease() []uint32 {
+	if x != nil {
+		return x.ConnIdsToRelease
+	}
+	return nil
+}
+
+// Add, overwrite, or remove the attributes of the channel. Only the channel owner can send this message.
+// Response: @UpdateChannelAttributesResultMessage, or @ErrorResultMessage if the connection is not the owner or the message is invalid.
+type UpdateChannelAttributesMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The attributes to add or overwrite.
+	Attributes map[string]*ChannelAttributeValue `protobuf:"bytes,1,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The keys of the attributes to remove.
+	KeysToRemove []string `protobuf:"bytes,2,rep,name=keysToRemove,proto3" json:"keysToRemove,omitempty"`
+}
+
+func (x *UpdateChannelAttributesMessage) Reset() {
+	*x = UpdateChannelAttributesMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_channeld_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateChannelAttributesMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateChannelAttributesMessage) ProtoMessage() {}
+
+func (x *UpdateChannelAttributesMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_channeld_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateChannelAttributesMessage.ProtoReflect.Descriptor instead.
+func (*UpdateChannelAttributesMessage) Descriptor() ([]byte, []int) {
+	return file_channeld_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *UpdateChannelAttributesMessage) GetAttributes() map[string]*ChannelAttributeValue {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *UpdateChannelAttributesMessage) GetKeysToRemove() []string {
+	if x != nil {
+		return x.KeysToRemove
+	}
+	return nil
+}
+
+type UpdateChannelAttributesResultMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// All the attributes of the channel after the update.
+	Attributes map[string]*ChannelAttributeValue `protobuf:"bytes,1,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *UpdateChannelAttributesResultMessage) Reset() {
+	*x = UpdateChannelAttributesResultMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_channeld_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateChannelAttributesResultMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateChannelAttributesResultMessage) ProtoMessage() {}
+
+func (x *UpdateChannelAttributesResultMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_channeld_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateChannelAttributesResultMessage.ProtoReflect.Descriptor instead.
+func (*UpdateChannelAttributesResultMessage) Descriptor() ([]byte, []int) {
+	return file_channeld_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *UpdateChannelAttributesResultMessage) GetAttributes() map[string]*ChannelAttributeValue {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+// Query the detailed information of the channel that the message is sent to.
+// The access is controlled by the GetInfo ACL settings of the channel type.
+// Response: @GetChannelInfoResultMessage, or @ErrorResultMessage if the connection has no access.
+type GetChannelInfoMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetChannelInfoMessage) Reset() {
+	*x = GetChannelInfoMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_channeld_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetChannelInfoMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChannelInfoMessage) ProtoMessage() {}
+
+func (x *GetChannelInfoMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_channeld_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChannelInfoMessage.ProtoReflect.Descriptor instead.
+func (*GetChannelInfoMessage) Descriptor() ([]byte, []int) {
+	return file_channeld_proto_rawDescGZIP(), []int{29}
+}
+
+type GetChannelInfoResultMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChannelId   uint32      `protobuf:"varint,1,opt,name=channelId,proto3" json:"channelId,omitempty"`
+	ChannelType ChannelType `protobuf:"varint,2,opt,name=channelType,proto3,enum=channeldpb.ChannelType" json:"channelType,omitempty"`
+	Metadata    string      `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// 0 if the channel has no owner.
+	OwnerConnId uint32                                        `protobuf:"varint,4,opt,name=ownerConnId,proto3" json:"ownerConnId,omitempty"`
+	Subscribers []*GetChannelInfoResultMessage_SubscriberInfo `protobuf:"bytes,5,rep,name=subscribers,proto3" json:"subscribers,omitempty"`
+	State       GetChannelInfoResultMessage_ChannelState      `protobuf:"varint,6,opt,name=state,proto3,enum=channeldpb.GetChannelInfoResultMessage_ChannelState" json:"state,omitempty"`
+	// The time when the channel was created, in milliseconds since the Unix epoch.
+	CreationTime int64 `protobuf:"varint,7,opt,name=creationTime,proto3" json:"creationTime,omitempty"`
+	// The full name of the channel data message type. Empty if the channel has no data.
+	DataMsgTypeName string `protobuf:"bytes,8,opt,name=dataMsgTypeName,proto3" json:"dataMsgTypeName,omitempty"`
+	// The size of the channel data message in bytes.
+	DataSize        uint32                            `protobuf:"varint,9,opt,name=dataSize,proto3" json:"dataSize,omitempty"`
+	ParentChannelId uint32                            `protobuf:"varint,10,opt,name=parentChannelId,proto3" json:"parentChannelId,omitempty"`
+	Attributes      map[string]*ChannelAttributeValue `protobuf:"bytes,11,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *GetChannelInfoResultMessage) Reset() {
+	*x = GetChannelInfoResultMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_channeld_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetChannelInfoResultMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChannelInfoResultMessage) ProtoMessage() {}
+
+func (x *GetChannelInfoResultMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_channeld_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChannelInfoResultMessage.ProtoReflect.Descriptor instead.
+func (*GetChannelInfoResultMessage) Descriptor() ([]byte, []int) {
+	return file_channeld_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetChannelInfoResultMessage) GetChannelId() uint32 {
+	if x != nil {
+		return x.ChannelId
+	}
+	return 0
+}
+
+func (x *GetChannelInfoResultMessage) GetChannelType() ChannelType {
+	if x != nil {
+		return x.ChannelType
+	}
+	return ChannelType_UNKNOWN
+}
+
+func (x *GetChannelInfoResultMessage) GetMetadata() string {
+	if x != nil {
+		return x.Metadata
+	}
+	return ""
+}
+
+func (x *GetChannelInfoResultMessage) GetOwnerConnId() uint32 {
+	if x != nil {
+		return x.OwnerConnId
+	}
+	return 0
+}
+
+func (x *GetChannelInfoResultMessage) GetSubscribers() []*GetChannelInfoResultMessage_SubscriberInfo {
+	if x != nil {
+		return x.Subscribers
+	}
+	return nil
+}
+
+func (x *GetChannelInfoResultMessage) GetState() GetChannelInfoResultMessage_ChannelState {
+	if x != nil {
+		return x.State
+	}
+	return GetChannelInfoResultMessage_INIT
+}
+
+func (x *GetChannelInfoResultMessage) GetCreationTime() int64 {
+	if x != nil {
+		return x.CreationTime
+	}
+	return 0
+}
+
+func (x *GetChannelInfoResultMessage) GetDataMsgTypeName() string {
+	if x != nil {
+		return x.DataMsgTypeName
+	}
+	return ""
+}
+
+func (x *GetChannelInfoResultMessage) GetDataSize() uint32 {
+	if x != nil {
+		return x.DataSize
+	}
+	return 0
+}
+
+func (x *GetChannelInfoResultMessage) GetParentChannelId() uint32 {
+	if x != nil {
+		return x.ParentChannelId
+	}
+	return 0
+}
+
+func (x *GetChannelInfoResultMessage) GetAttributes() map[string]*ChannelAttributeValue {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+// Schedule a message to be handled in the channel's goroutine at a channel time, or repeatedly on an interval.
+// Only the server connection can send this message. The scheduled messages are removed when the channel is removed,
+// or when the server connection is closed.
+// Response: @ScheduleMessageResultMessage, or @ErrorResultMessage if the message is invalid.
+type ScheduleMessageMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The message to schedule. Can be a user-space or built-in message (except SCHEDULE_MESSAGE and CANCEL_SCHEDULED).
+	// The channelId is ignored - the message is always handled in the channel that receives the ScheduleMessageMessage.
+	Message *MessagePack `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	// The channel time (in milliseconds since the channel was created) to handle the message.
+	// If 0, the message is handled after the interval. If it's in the past, the message is handled in the next tick.
+	ChannelTimeMs int64 `protobuf:"varint,2,opt,name=channelTimeMs,proto3" json:"channelTimeMs,omitempty"`
+	// If not 0, the message is handled repeatedly on the interval, until cancelled.
+	IntervalMs uint32 `protobuf:"varint,3,opt,name=intervalMs,proto3" json:"intervalMs,omitempty"`
+}
+
+func (x *ScheduleMessageMessage) Reset() {
+	*x = ScheduleMessageMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_channeld_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScheduleMessageMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleMessageMessage) ProtoMessage() {}
+
+func (x *ScheduleMessageMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_channeld_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleMessageMessage.ProtoReflect.Descriptor instead.
+func (*ScheduleMessageMessage) Descriptor() ([]byte, []int) {
+	return file_channeld_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ScheduleMessageMessage) GetMessage() *MessagePack {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *ScheduleMessageMessage) GetChannelTimeMs() int64 {
+	if x != nil {
+		return x.ChannelTimeMs
+	}
+	return 0
+}
+
+func (x *ScheduleMessageMessage) GetIntervalMs() uint32 {
+	if x != nil {
+		return x.IntervalMs
+	}
+	return 0
+}
+
+type ScheduleMessageResultMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Used by @CancelScheduledMessage.
+	ScheduleId uint32 `protobuf:"varint,1,opt,name=scheduleId,proto3" json:"scheduleId,omitempty"`
+	// The channel time (in milliseconds since the channel was created) when the message will be handled for the first time.
+	ChannelTimeMs int64 `protobuf:"varint,2,opt,name=channelTimeMs,proto3" json:"channelTimeMs,omitempty"`
+}
+
+func (x *ScheduleMessageResultMessage) Reset() {
+	*x = ScheduleMessageResultMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_channeld_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScheduleMessageResultMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleMessageResultMessage) ProtoMessage() {}
+
+func (x *ScheduleMessageResultMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_channeld_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleMessageResultMessage.ProtoReflect.Descriptor instead.
+func (*ScheduleMessageResultMessage) Descriptor() ([]byte, []int) {
+	return file_channeld_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ScheduleMessageResultMessage) GetScheduleId() uint32 {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return 0
+}
+
+func (x *ScheduleMessageResultMessage) GetChannelTimeMs() int64 {
+	if x != nil {
+		return x.ChannelTimeMs
+	}
+	return 0
+}
+
+// Cancel a message scheduled by @ScheduleMessageMessage in the same channel. Only the server connection can send this message.
+// Response: no, or @ErrorResultMessage if the scheduled message doesn't exist.
+type CancelScheduledMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ScheduleId uint32 `protobuf:"varint,1,opt,name=scheduleId,proto3" json:"scheduleId,omitempty"`
+}
+
+func (x *CancelScheduledMessage) Reset() {
+	*x = CancelScheduledMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_channeld_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelScheduledMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelScheduledMessage) ProtoMessage() {}
+
+func (x *CancelScheduledMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_channeld_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelScheduledMessage.ProtoReflect.Descriptor instead.
+func (*CancelScheduledMessage) Descriptor() ([]byte, []int) {
+	return file_channeld_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *CancelScheduledMessage) GetScheduleId() uint32 {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return 0
+}
+
+// Apply the data updates of multiple channels all-or-nothing, e.g. trading an item between two PRIVATE channels.
+// Each channel is locked and checked in its own goroutine first. The updates are applied only if all the channels are prepared
+// in time; otherwise, no update is applied. While a channel is locked, its @ChannelDataUpdateMessage is rejected (CHANNEL_BUSY).
+// The message should have channelId = 0 in order to be handled.
+// Response: @ChannelDataTransactionResultMessage.
+type ChannelDataTransactionMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Each channel should appear at most once.
+	Updates []*ChannelDataTransactionMessage_Update `protobuf:"bytes,1,rep,name=updates,proto3" json:"updates,omitempty"`
+	// The max time to wait for all the channels to be locked. 0 = the default timeout (1000ms). Capped by the server (5000ms by default).
+	TimeoutMs uint32 `protobuf:"varint,2,opt,name=timeoutMs,proto3" json:"timeoutMs,omitempty"`
+}
+
+func (x *ChannelDataTransactionMessage) Reset() {
+	*x = ChannelDataTransactionMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_channeld_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChannelDataTransactionMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelDataTransactionMessage) ProtoMessage() {}
+
+func (x *ChannelDataTransactionMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_channeld_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelDataTransactionMessage.ProtoReflect.Descriptor instead.
+func (*ChannelDataTransactionMessage) Descriptor() ([]byte, []int) {
+	return file_channeld_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ChannelDataTransactionMessage) GetUpdates() []*ChannelDataTransactionMessage_Update {
+	if x != nil {
+		return x.Updates
+	}
+	return nil
+}
+
+func (x *ChannelDataTransactionMessage) GetTimeoutMs() uint32 {
+	if x != nil {
+		return x.TimeoutMs
+	}
+	return 0
+}
+
+type ChannelDataTransactionResultMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// The channel that failed the transaction. Not set if succeeded, or the transaction itself is invalid.
+	FailedChannelId *uint32 `protobuf:"varint,2,opt,name=failedChannelId,proto3,oneof" json:"failedChannelId,omitempty"`
+	// Why the transaction failed.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *ChannelDataTransactionResultMessage) Reset() {
+	*x = ChannelDataTransactionResultMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_channeld_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChannelDataTransactionResultMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelDataTransactionResultMessage) ProtoMessage() {}
+
+func (x *ChannelDataTransactionResultMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_channeld_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelDataTransactionResultMessage.ProtoReflect.Descriptor instead.
+func (*ChannelDataTransactionResultMessage) Descriptor() ([]byte, []int) {
+	return file_channeld_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ChannelDataTransactionResultMessage) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ChannelDataTransactionResultMessage) GetFailedChannelId() uint32 {
+	if x != nil && x.FailedChannelId != nil {
+		return *x.FailedChannelId
+	}
+	return 0
+}
+
+func (x *ChannelDataTransactionResultMessage) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// Read the current data of multiple channels at once, without subscribing to them, e.g. for the admin tools and the lobby screens.
+// The data is read in each channel's goroutine. The connection can read the channel it has subscribed to with the data access;
+// otherwise, the access is controlled by the GetData ACL settings of the channel type.
+// The message should have channelId = 0 in order to be handled.
+// Response: @GetChannelDataResultMessage.
+type GetChannelDataMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChannelIds []uint32 `protobuf:"varint,1,rep,packed,name=channelIds,proto3" json:"channelIds,omitempty"`
+	// The same as @ChannelSubscriptionOptions.dataFieldMasks. Empty means all the fields.
+	DataFieldMasks []string `protobuf:"bytes,2,rep,name=dataFieldMasks,proto3" json:"dataFieldMasks,omitempty"`
+	// The max time to wait for all the channels. 0 = the default timeout (1000ms). Capped by the server (5000ms by default).
+	TimeoutMs uint32 `protobuf:"varint,3,opt,name=timeoutMs,proto3" json:"timeoutMs,omitempty"`
+}
+
+func (x *GetChannelDataMessage) Reset() {
+	*x = GetChannelDataMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_channeld_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetChannelDataMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChannelDataMessage) ProtoMessage() {}
+
+func (x *GetChannelDataMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_channeld_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChannelDataMessage.ProtoReflect.Descriptor instead.
+func (*GetChannelDataMessage) Descriptor() ([]byte, []int) {
+	return file_channeld_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetChannelDataMessage) GetChannelIds() []uint32 {
+	if x != nil {
+		return x.ChannelIds
+	}
+	return nil
+}
+
+func (x *GetChannelDataMessage) GetDataFieldMasks() []string {
+	if x != nil {
+		return x.DataFieldMasks
+	}
+	return nil
+}
+
+func (x *GetChannelDataMessage) GetTimeoutMs() uint32 {
+	if x != nil {
+		return x.TimeoutMs
+	}
+	return 0
+}
+
+type GetChannelDataResultMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// In the same order as @GetChannelDataMessage.channelIds.
+	Results []*GetChannelDataResultMessage_ChannelDataResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *GetChannelDataResultMessage) Reset() {
+	*x = GetChannelDataResultMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_channeld_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetChannelDataResultMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChannelDataResultMessage) ProtoMessage() {}
+
+func (x *GetChannelDataResultMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_channeld_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChannelDataResultMessage.ProtoReflect.Descriptor instead.
+func (*GetChannelDataResultMessage) Descriptor() ([]byte, []int) {
+	return file_channeld_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetChannelDataResultMessage) GetResults() []*GetChannelDataResultMessage_ChannelDataResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// Subscribe a connection to multiple channels and/or unsubscribe it from multiple channels at once, e.g. upon the login of a player.
+// Each subscription or unsubscription is handled in the channel's goroutine, with the same access control, channel behavior and
+// notifications as @SubscribedToChannelMessage and @UnsubscribedFromChannelMessage, except that the sender only receives the aggregated result.
+// The unsubscriptions are dispatched before the subscriptions, so a channel in both lists is resubscribed.
+// The message should have channelId = 0 in order to be handled.
+// Response: @BatchSubscribeResultMessage.
+type BatchSubscribeMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Ignored if the sender is a client connection, which can only subscribe or unsubscribe itself.
+	ConnId          uint32                            `protobuf:"varint,1,opt,name=connId,proto3" json:"connId,omitempty"`
+	Subs            []*BatchSubscribeMessage_SubEntry `protobuf:"bytes,2,rep,name=subs,proto3" json:"subs,omitempty"`
+	UnsubChannelIds []uint32                          `protobuf:"varint,3,rep,packed,name=unsubChannelIds,proto3" json:"unsubChannelIds,omitempty"`
+	// The max time to wait for all the channels. 0 = the default timeout (1000ms). Capped by the server (5000ms by default).
+	TimeoutMs uint32 `protobuf:"varint,4,opt,name=timeoutMs,proto3" json:"timeoutMs,omitempty"`
+}
+
+func (x *BatchSubscribeMessage) Reset() {
+	*x = BatchSubscribeMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_channeld_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchSubscribeMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchSubscribeMessage) ProtoMessage() {}
+
+func (x *BatchSubscribeMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_channeld_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchSubscribeMessage.ProtoReflect.Descriptor instead.
+func (*BatchSubscribeMessage) Descriptor() ([]byte, []int) {
+	return file_channeld_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *BatchSubscribeMessage) GetConnId() uint32 {
+	if x != nil {
+		return x.ConnId
+	}
+	return 0
+}
+
+func (x *BatchSubscribeMessage) GetSubs() []*BatchSubscribeMessage_SubEntry {
+	if x != nil {
+		return x.Subs
+	}
+	return nil
+}
+
+func (x *BatchSubscribeMessage) GetUnsubChannelIds() []uint32 {
+	if x != nil {
+		return x.UnsubChannelIds
+	}
+	return nil
+}
+
+func (x *BatchSubscribeMessage) GetTimeoutMs() uint32 {
+	if x != nil {
+		return x.TimeoutMs
+	}
+	return 0
+}
+
+type BatchSubscribeResultMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConnId uint32 `protobuf:"varint,1,opt,name=connId,proto3" json:"connId,omitempty"`
+	// In the same order as @BatchSubscribeMessage.subs.
+	SubResults []*BatchSubscribeResultMessage_ChannelResult `protobuf:"bytes,2,rep,name=subResults,proto3" json:"subResults,omitempty"`
+	// In the same order as @BatchSubscribeMessage.unsubChannelIds.
+	UnsubResults []*BatchSubscribeResultMessage_ChannelResult `protobuf:"bytes,3,rep,name=unsubResults,proto3" json:"unsubResults,omitempty"`
+}
+
+func (x *BatchSubscribeResultMessage) Reset() {
+	*x = BatchSubscribeResultMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_channeld_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchSubscribeResultMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchSubscribeResultMessage) ProtoMessage() {}
+
+func (x *BatchSubscribeResultMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_channeld_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchSubscribeResultMessage.ProtoReflect.Descriptor instead.
+func (*BatchSubscribeResultMessage) Descriptor() ([]byte, []int) {
+	return file_channeld_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *BatchSubscribeResultMessage) GetConnId() uint32 {
+	if x != nil {
+		return x.ConnId
+	}
+	return 0
+}
+
+func (x *BatchSubscribeResultMessage) GetSubResults() []*BatchSubscribeResultMessage_ChannelResult {
+	if x != nil {
+		return x.SubResults
+	}
+	return nil
+}
+
+func (x *BatchSubscribeResultMessage) GetUnsubResults() []*BatchSubscribeResultMessage_ChannelResult {
+	if x != nil {
+		return x.UnsubResults
+	}
+	return nil
+}
+
+// Add a standing rule to subscribe the sender to the channels that match the filters, including the ones created later,
+// e.g. for the spectator and moderation servers. Only the server connection can add the rules.
+// The filters are checked once the channel is created with its metadata and attributes; the later changes of them don't subscribe or unsubscribe.
+// The channels that the connection has already subscribed to are skipped. The subscription can be vetoed by the channel behavior.
+// The rules are removed when the connection is closed, or by @RemoveAutoSubscribeMessage.
+// The message should have channelId = 0 in order to be handled.
+// Response: @AutoSubscribeResultMessage. Upon each subscription, the connection and the channel owner will receive @SubscribedToChannelResultMessage.
+// Response: @ErrorResultMessage, if the sender is not a server connection, or the typeFilter is GLOBAL.
+type AutoSubscribeMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The same as @ListChannelMessage.typeFilter. UNKNOWN means any type except GLOBAL.
+	TypeFilter ChannelType `protobuf:"varint,1,opt,name=typeFilter,proto3,enum=channeldpb.ChannelType" json:"typeFilter,omitempty"`
+	// The same as @ListChannelMessage.metadataFilters.
+	MetadataFilters []string `protobuf:"bytes,2,rep,name=metadataFilters,proto3" json:"metadataFilters,omitempty"`
+	// The same as @ListChannelMessage.attributeFilter.
+	AttributeFilter *ChannelAttributeFilter     `protobuf:"bytes,3,opt,name=attributeFilter,proto3" json:"attributeFilter,omitempty"`
+	SubOptions      *ChannelSubscriptionOptions `protobuf:"bytes,4,opt,name=subOptions,proto3" json:"subOptions,omitempty"`
+	// Also subscribe to the existing channels that match the filters.
+	IncludeExisting bool `protobuf:"varint,5,opt,name=includeExisting,proto3" json:"includeExisting,omitempty"`
+}
+
+func (x *AutoSubscribeMessage) Reset() {
+	*x = AutoSubscribeMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_channeld_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AutoSubscribeMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AutoSubscribeMessage) ProtoMessage() {}
+
+func (x *AutoSubscribeMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_channeld_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AutoSubscribeMessage.ProtoReflect.Descriptor instead.
+func (*AutoSubscribeMessage) Descriptor() ([]byte, []int) {
+	return file_channeld_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *AutoSubscribeMessage) GetTypeFilter() ChannelType {
+	if x != nil {
+		return x.TypeFilter
+	}
+	return ChannelType_UNKNOWN
+}
+
+func (x *AutoSubscribeMessage) GetMetadataFilters() []string {
+	if x != nil {
+		return x.MetadataFilters
+	}
+	return nil
+}
+
+func (x *AutoSubscribeMessage) GetAttributeFilter() *ChannelAttributeFilter {
+	if x != nil {
+		return x.AttributeFilter
+	}
+	return nil
+}
+
+func (x *AutoSubscribeMessage) GetSubOptions() *ChannelSubscriptionOptions {
+	if x != nil {
+		return x.SubOptions
+	}
+	return nil
+}
+
+func (x *AutoSubscribeMessage) GetIncludeExisting() bool {
+	if x != nil {
+		return x.IncludeExisting
+	}
+	return false
+}
+
+type AutoSubscribeResultMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Used by @RemoveAutoSubscribeMessage.
+	RuleId uint32 `protobuf:"varint,1,opt,name=ruleId,proto3" json:"ruleId,omitempty"`
+}
+
+func (x *AutoSubscribeResultMessage) Reset() {
+	*x = AutoSubscribeResultMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_channeld_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AutoSubscribeResultMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AutoSubscribeResultMessage) ProtoMessage() {}
+
+func (x *AutoSubscribeResultMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_channeld_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AutoSubscribeResultMessage.ProtoReflect.Descriptor instead.
+func (*AutoSubscribeResultMessage) Descriptor() ([]byte, []int) {
+	return file_channeld_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *AutoSubscribeResultMessage) GetRuleId() uint32 {
+	if x != nil {
+		return x.RuleId
+	}
+	return 0
+}
+
+// Remove an auto-subscription rule added by the sender. The existing subscriptions are kept.
+// The message should have channelId = 0 in order to be handled.
+// Response: @ErrorResultMessage, if the rule doesn't exist or is not added by the sender.
+type RemoveAutoSubscribeMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RuleId uint32 `protobuf:"varint,1,opt,name=ruleId,proto3" json:"ruleId,omitempty"`
+}
+
+func (x *RemoveAutoSubscribeMessage) Reset() {
+	*x = RemoveAutoSubscribeMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_channeld_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveAutoSubscribeMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveAutoSubscribeMessage) ProtoMessage() {}
+
+func (x *RemoveAutoSubscribeMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_channeld_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveAutoSubscribeMessage.ProtoReflect.Descriptor instead.
+func (*RemoveAutoSubscribeMessage) Descriptor() ([]byte, []int) {
+	return file_channeld_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *RemoveAutoSubscribeMessage) GetRuleId() uint32 {
+	if x != nil {
+		return x.RuleId
+	}
+	return 0
+}
+
+// Disconnect another connection from channeld.
+// This message should only be sent by the server connection in a server-authoratative environment.
+// The message should have channelId = 0 in order to be handled.
+// Response: no.
+type DisconnectMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConnId uint32 `protobuf:"varint,1,opt,name=connId,proto3" json:"connId,omitempty"`
+}
+
+func (x *DisconnectMessage) Reset() {
+	*x = DisconnectMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_channeld_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DisconnectMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisconnectMessage) ProtoMessage() {}
+
+func (x *DisconnectMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_channeld_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisconnectMessage.ProtoReflect.Descriptor instead.
+func (*DisconnectMessage) Descriptor() ([]byte, []int) {
+	return file_channeld_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *DisconnectMessage) GetConnId() uint32 {
+	if x != nil {
+		return x.ConnId
+	}
+	return 0
+}
+
+// Sent to the connection whose message is rejected or failed to be handled by channeld.
+// The stubId of the original message is kept, so the RPC callback of the sender can be triggered.
+type ErrorResultMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The type of the message that causes the error.
+	MsgType   uint32                       `protobuf:"varint,1,opt,name=msgType,proto3" json:"msgType,omitempty"`
+	ErrorCode ErrorResultMessage_ErrorCode `protobuf:"varint,2,opt,name=errorCode,proto3,enum=channeldpb.ErrorResultMessage_ErrorCode" json:"errorCode,omitempty"`
+	// The human-readable description of the error.
+	ErrorMessage string `protobuf:"bytes,3,opt,name=errorMessage,proto3" json:"errorMessage,omitempty"`
+}
+
+func (x *ErrorResultMessage) Reset() {
+	*x = ErrorResultMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_channeld_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ErrorResultMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ErrorResultMessage) ProtoMessage() {}
+
+func (x *ErrorResultMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_channeld_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ErrorResultMessage.ProtoReflect.Descriptor instead.
+func (*ErrorResultMessage) Descriptor() ([]byte, []int) {
+	return file_channeld_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ErrorResultMessage) GetMsgType() uint32 {
+	if x != nil {
+		return x.MsgType
+	}
+	return 0
+}
+
+func (x *ErrorResultMessage) GetErrorCode() ErrorResultMessage_ErrorCode {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ErrorResultMessage_UNKNOWN_ERROR
+}
+
+func (x *ErrorResultMessage) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+// Left-handed coordinate system with Y-up rule.
+type SpatialInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	X float64 `protobuf:"fixed64,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y float64 `protobuf:"fixed64,2,opt,name=y,proto3" json:"y,omitempty"`
+	Z float64 `protobuf:"fixed64,3,opt,name=z,proto3" json:"z,omitempty"`
+}
+
+func (x *SpatialInfo) Reset() {
+	*x = SpatialInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_channeld_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SpatialInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpatialInfo) ProtoMessage() {}
+
+func (x *SpatialInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_channeld_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpatialInfo.ProtoReflect.Descriptor instead.
+func (*SpatialInfo) Descriptor() ([]byte, []int) {
+	return file_channeld_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *SpatialInfo) GetX() float64 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *SpatialInfo) GetY() float64 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *SpatialInfo) GetZ() float64 {
+	if x != nil {
+		return x.Z
+	}
+	return 0
+}
+
+type CreateSpatialChannelsResultMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SpatialChannelId []uint32 `protobuf:"varint,1,rep,packed,name=spatialChannelId,proto3" json:"spatialChannelId,omitempty"`
+	Metadata         string   `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	OwnerConnId      uint32   `protobuf:"varint,3,opt,name=ownerConnId,proto3" json:"ownerConnId,omitempty"`
+}
+
+func (x *CreateSpatialChannelsResultMessage) Reset() {
+	*x = CreateSpatialChannelsResultMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_channeld_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateSpatialChannelsResultMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSpatialChannelsResultMessage) ProtoMessage() {}
+
+func (x *CreateSpatialChannelsResultMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_channeld_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSpatialChannelsResultMessage.ProtoReflect.Descriptor instead.
+func (*CreateSpatialChannelsResultMessage) Descriptor() ([]byte, []int) {
+	return file_channeld_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *CreateSpatialChannelsResultMessage) GetSpatialChannelId() []uint32 {
+	if x != nil {
+		return x.SpatialChannelId
+	}
+	return nil
+}
+
+func (x *CreateSpatialChannelsResultMessage) GetMetadata() string {
+	if x != nil {
+		return x.Metadata
+	}
+	return ""
+}
+
+func (x *CreateSpatialChannelsResultMessage) GetOwnerConnId() uint32 {
+	if x != nil {
+		return x.OwnerConnId
+	}
+	return 0
+}
+
+// The message should have channelId = 0 in order to be handled.
+// Response: @QuerySpatialChannelResultMessage
+type QuerySpatialChannelMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SpatialInfo []*SpatialInfo `protobuf:"bytes,1,rep,name=spatialInfo,proto3" json:"spatialInfo,omitempty"`
+}
+
+func (x *QuerySpatialChannelMessage) Reset() {
+	*x = QuerySpatialChannelMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_channeld_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuerySpatialChannelMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuerySpatialChannelMessage) ProtoMessage() {}
+
+func (x *QuerySpatialChannelMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_channeld_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuerySpatialChannelMessage.ProtoReflect.Descriptor instead.
+func (*QuerySpatialChannelMessage) Descriptor() ([]byte, []int) {
+	return file_channeld_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *QuerySpatialChannelMessage) GetSpatialInfo() []*SpatialInfo {
+	if x != nil {
+		return x.SpatialInfo
+	}
+	return nil
+}
+
+type QuerySpatialChannelResultMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChannelId []uint32 `protobuf:"varint,1,rep,packed,name=channelId,proto3" json:"channelId,omitempty"`
+}
+
+func (x *QuerySpatialChannelResultMessage) Reset() {
+	*x = QuerySpatialChannelResultMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_channeld_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuerySpatialChannelResultMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuerySpatialChannelResultMessage) ProtoMessage() {}
+
+func (x *QuerySpatialChannelResultMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_channeld_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuerySpatialChannelResultMessage.ProtoReflect.Descriptor instead.
+func (*QuerySpatialChannelResultMessage) Descriptor() ([]byte, []int) {
+	return file_channeld_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *QuerySpatialChannelResultMessage) GetChannelId() []uint32 {
+	if x != nil {
+		return x.ChannelId
+	}
+	return nil
+}
+
+// ALL connections in the source AND destination channels receive this messge when a handover happpned.
+// Handover means an object moves from a spatial channel to another. It doesn't necessarily mean the objece moves from a spatial server to another.
+type ChannelDataHandoverMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SrcChannelId uint32 `protobuf:"varint,1,opt,name=srcChannelId,proto3" json:"srcChannelId,omitempty"`
+	DstChannelId uint32 `protobuf:"varint,2,opt,name=dstChannelId,proto3" json:"dstChannelId,omitempty"`
+	// The ID of the client connection that triggered the handover. If the handover is triggered by server (e.g. NPC movement), the value will be 0.
+	ContextConnId uint32 `protobuf:"varint,3,opt,name=contextConnId,proto3" json:"contextConnId,omitempty"`
+	// The data that migrate from the source channel to the destination channel. It can be the spatial channel data or anything, as long as the spatial servers can use it to process the handover.
 	Data *anypb.Any `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *ChannelDataHandoverMessage) Reset() {
-	*x = ChannelDataHandoverMessage{}
+func (x *ChannelDataHandoverMessage) Reset() {
+	*x = ChannelDataHandoverMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_channeld_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChannelDataHandoverMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelDataHandoverMessage) ProtoMessage() {}
+
+func (x *ChannelDataHandoverMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_channeld_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelDataHandoverMessage.ProtoReflect.Descriptor instead.
+func (*ChannelDataHandoverMessage) Descriptor() ([]byte, []int) {
+	return file_channeld_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ChannelDataHandoverMessage) GetSrcChannelId() uint32 {
+	if x != nil {
+		return x.SrcChannelId
+	}
+	return 0
+}
+
+func (x *ChannelDataHandoverMessage) GetDstChannelId() uint32 {
+	if x != nil {
+		return x.DstChannelId
+	}
+	return 0
+}
+
+func (x *ChannelDataHandoverMessage) GetContextConnId() uint32 {
+	if x != nil {
+		return x.ContextConnId
+	}
+	return 0
+}
+
+func (x *ChannelDataHandoverMessage) GetData() *anypb.Any {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type SpatialRegion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Min         *SpatialInfo `protobuf:"bytes,1,opt,name=min,proto3" json:"min,omitempty"`
+	Max         *SpatialInfo `protobuf:"bytes,2,opt,name=max,proto3" json:"max,omitempty"`
+	ChannelId   uint32       `protobuf:"varint,3,opt,name=channelId,proto3" json:"channelId,omitempty"`
+	ServerIndex uint32       `protobuf:"varint,4,opt,name=serverIndex,proto3" json:"serverIndex,omitempty"`
+}
+
+func (x *SpatialRegion) Reset() {
+	*x = SpatialRegion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_channeld_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SpatialRegion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpatialRegion) ProtoMessage() {}
+
+func (x *SpatialRegion) ProtoReflect() protoreflect.Message {
+	mi := &file_channeld_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpatialRegion.ProtoReflect.Descriptor instead.
+func (*SpatialRegion) Descriptor() ([]byte, []int) {
+	return file_channeld_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *SpatialRegion) GetMin() *SpatialInfo {
+	if x != nil {
+		return x.Min
+	}
+	return nil
+}
+
+func (x *SpatialRegion) GetMax() *SpatialInfo {
+	if x != nil {
+		return x.Max
+	}
+	return nil
+}
+
+func (x *SpatialRegion) GetChannelId() uint32 {
+	if x != nil {
+		return x.ChannelId
+	}
+	return 0
+}
+
+func (x *SpatialRegion) GetServerIndex() uint32 {
+	if x != nil {
+		return x.ServerIndex
+	}
+	return 0
+}
+
+// channeld updates the information of spatial channels and regions to the spatial servers.
+// Spatial servers use this information mainly for mapping the position of a spawned object to a correct channelId at realtime (rather than querying it before sending the spawn message to the client).
+// Sent upon the creation of spatial channels (after @CreateSpatialChannelsResultMessage being sent), or any regional change (basiclally caused by the loadbalancer).
+type SpatialRegionsUpdateMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Regions []*SpatialRegion `protobuf:"bytes,1,rep,name=regions,proto3" json:"regions,omitempty"`
+}
+
+func (x *SpatialRegionsUpdateMessage) Reset() {
+	*x = SpatialRegionsUpdateMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_channeld_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SpatialRegionsUpdateMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpatialRegionsUpdateMessage) ProtoMessage() {}
+
+func (x *SpatialRegionsUpdateMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_channeld_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpatialRegionsUpdateMessage.ProtoReflect.Descriptor instead.
+func (*SpatialRegionsUpdateMessage) Descriptor() ([]byte, []int) {
+	return file_channeld_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *SpatialRegionsUpdateMessage) GetRegions() []*SpatialRegion {
+	if x != nil {
+		return x.Regions
+	}
+	return nil
+}
+
+type SpatialInterestQuery struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SpotsAOI  *SpatialInterestQuery_SpotsAOI  `protobuf:"bytes,1,opt,name=spotsAOI,proto3,oneof" json:"spotsAOI,omitempty"`
+	BoxAOI    *SpatialInterestQuery_BoxAOI    `protobuf:"bytes,2,opt,name=boxAOI,proto3,oneof" json:"boxAOI,omitempty"`
+	SphereAOI *SpatialInterestQuery_SphereAOI `protobuf:"bytes,3,opt,name=sphereAOI,proto3,oneof" json:"sphereAOI,omitempty"`
+	ConeAOI   *SpatialInterestQuery_ConeAOI   `protobuf:"bytes,4,opt,name=coneAOI,proto3,oneof" json:"coneAOI,omitempty"`
+}
+
+func (x *SpatialInterestQuery) Reset() {
+	*x = SpatialInterestQuery{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_channeld_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SpatialInterestQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpatialInterestQuery) ProtoMessage() {}
+
+func (x *SpatialInterestQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_channeld_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpatialInterestQuery.ProtoReflect.Descriptor instead.
+func (*SpatialInterestQuery) Descriptor() ([]byte, []int) {
+	return file_channeld_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *SpatialInterestQuery) GetSpotsAOI() *SpatialInterestQuery_SpotsAOI {
+	if x != nil {
+		return x.SpotsAOI
+	}
+	return nil
+}
+
+func (x *SpatialInterestQuery) GetBoxAOI() *SpatialInterestQuery_BoxAOI {
+	if x != nil {
+		return x.BoxAOI
+	}
+	return nil
+}
+
+func (x *SpatialInterestQuery) GetSphereAOI() *SpatialInterestQuery_SphereAOI {
+	if x != nil {
+		return x.SphereAOI
+	}
+	return nil
+}
+
+func (x *SpatialInterestQuery) GetConeAOI() *SpatialInterestQuery_ConeAOI {
+	if x != nil {
+		return x.ConeAOI
+	}
+	return nil
+}
+
+type UpdateSpatialInterestMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConnId uint32                `protobuf:"varint,1,opt,name=connId,proto3" json:"connId,omitempty"`
+	Query  *SpatialInterestQuery `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+}
+
+func (x *UpdateSpatialInterestMessage) Reset() {
+	*x = UpdateSpatialInterestMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_channeld_proto_msgTypes[22]
+		mi := &file_channeld_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ChannelDataHandoverMessage) String() string {
+func (x *UpdateSpatialInterestMessage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChannelDataHandoverMessage) ProtoMessage() {}
+func (*UpdateSpatialInterestMessage) ProtoMessage() {}
 
-func (x *ChannelDataHandoverMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_channeld_proto_msgTypes[22]
+func (x *UpdateSpatialInterestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_channeld_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1955,67 +4553,139 @@ func (x *ChannelDataHandoverMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChannelDataHandoverMessage.ProtoReflect.Descriptor instead.
-func (*ChannelDataHandoverMessage) Descriptor() ([]byte, []int) {
-	return file_channeld_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use UpdateSpatialInterestMessage.ProtoReflect.Descriptor instead.
+func (*UpdateSpatialInterestMessage) Descriptor() ([]byte, []int) {
+	return file_channeld_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *ChannelDataHandoverMessage) GetSrcChannelId() uint32 {
+func (x *UpdateSpatialInterestMessage) GetConnId() uint32 {
 	if x != nil {
-		return x.SrcChannelId
+		return x.ConnId
 	}
 	return 0
 }
 
-func (x *ChannelDataHandoverMessage) GetDstChannelId() uint32 {
+func (x *UpdateSpatialInterestMessage) GetQuery() *SpatialInterestQuery {
 	if x != nil {
-		return x.DstChannelId
+		return x.Query
 	}
-	return 0
+	return nil
 }
 
-func (x *ChannelDataHandoverMessage) GetContextConnId() uint32 {
+type CreateEntityChannelMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EntityId     uint32                      `protobuf:"varint,1,opt,name=entityId,proto3" json:"entityId,omitempty"`
+	Metadata     string                      `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	SubOptions   *ChannelSubscriptionOptions `protobuf:"bytes,3,opt,name=subOptions,proto3" json:"subOptions,omitempty"`
+	Data         *anypb.Any                  `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	MergeOptions *ChannelDataMergeOptions    `protobuf:"bytes,5,opt,name=mergeOptions,proto3" json:"mergeOptions,omitempty"`
+	IsWellKnown  bool                        `protobuf:"varint,6,opt,name=isWellKnown,proto3" json:"isWellKnown,omitempty"`
+}
+
+func (x *CreateEntityChannelMessage) Reset() {
+	*x = CreateEntityChannelMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_channeld_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateEntityChannelMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateEntityChannelMessage) ProtoMessage() {}
+
+func (x *CreateEntityChannelMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_channeld_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateEntityChannelMessage.ProtoReflect.Descriptor instead.
+func (*CreateEntityChannelMessage) Descriptor() ([]byte, []int) {
+	return file_channeld_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *CreateEntityChannelMessage) GetEntityId() uint32 {
 	if x != nil {
-		return x.ContextConnId
+		return x.EntityId
 	}
 	return 0
 }
 
-func (x *ChannelDataHandoverMessage) GetData() *anypb.Any {
+func (x *CreateEntityChannelMessage) GetMetadata() string {
+	if x != nil {
+		return x.Metadata
+	}
+	return ""
+}
+
+func (x *CreateEntityChannelMessage) GetSubOptions() *ChannelSubscriptionOptions {
+	if x != nil {
+		return x.SubOptions
+	}
+	return nil
+}
+
+func (x *CreateEntityChannelMessage) GetData() *anypb.Any {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-type SpatialRegion struct {
+func (x *CreateEntityChannelMessage) GetMergeOptions() *ChannelDataMergeOptions {
+	if x != nil {
+		return x.MergeOptions
+	}
+	return nil
+}
+
+func (x *CreateEntityChannelMessage) GetIsWellKnown() bool {
+	if x != nil {
+		return x.IsWellKnown
+	}
+	return false
+}
+
+// Add specified entities to the handover/lock group of the entity channel. Should sent by the entity channel owner.
+type AddEntityGroupMessage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Min         *SpatialInfo `protobuf:"bytes,1,opt,name=min,proto3" json:"min,omitempty"`
-	Max         *SpatialInfo `protobuf:"bytes,2,opt,name=max,proto3" json:"max,omitempty"`
-	ChannelId   uint32       `protobuf:"varint,3,opt,name=channelId,proto3" json:"channelId,omitempty"`
-	ServerIndex uint32       `protobuf:"varint,4,opt,name=serverIndex,proto3" json:"serverIndex,omitempty"`
+	Type          EntityGroupType `protobuf:"varint,1,opt,name=type,proto3,enum=channeldpb.EntityGroupType" json:"type,omitempty"`
+	EntitiesToAdd []uint32        `protobuf:"varint,2,rep,packed,name=EntitiesToAdd,proto3" json:"EntitiesToAdd,omitempty"`
 }
 
-func (x *SpatialRegion) Reset() {
-	*x = SpatialRegion{}
+func (x *AddEntityGroupMessage) Reset() {
+	*x = AddEntityGroupMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_channeld_proto_msgTypes[23]
+		mi := &file_channeld_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SpatialRegion) String() string {
+func (x *AddEntityGroupMessage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SpatialRegion) ProtoMessage() {}
+func (*AddEntityGroupMessage) ProtoMessage() {}
 
-func (x *SpatialRegion) ProtoReflect() protoreflect.Message {
-	mi := &file_channeld_proto_msgTypes[23]
+func (x *AddEntityGroupMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_channeld_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2026,67 +4696,149 @@ func (x *SpatialRegion) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SpatialRegion.ProtoReflect.Descriptor instead.
-func (*SpatialRegion) Descriptor() ([]byte, []int) {
-	return file_channeld_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use AddEntityGroupMessage.ProtoReflect.Descriptor instead.
+func (*AddEntityGroupMessage) Descriptor() ([]byte, []int) {
+	return file_channeld_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *SpatialRegion) GetMin() *SpatialInfo {
+func (x *AddEntityGroupMessage) GetType() EntityGroupType {
 	if x != nil {
-		return x.Min
+		return x.Type
 	}
-	return nil
+	return EntityGroupType_HANDOVER
 }
 
-func (x *SpatialRegion) GetMax() *SpatialInfo {
+func (x *AddEntityGroupMessage) GetEntitiesToAdd() []uint32 {
 	if x != nil {
-		return x.Max
+		return x.EntitiesToAdd
 	}
 	return nil
 }
 
-func (x *SpatialRegion) GetChannelId() uint32 {
+// Remove specified entities from the handover/lock group of the entity channel. Should sent by the entity channel owner.
+type RemoveEntityGroupMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type             EntityGroupType `protobuf:"varint,1,opt,name=type,proto3,enum=channeldpb.EntityGroupType" json:"type,omitempty"`
+	EntitiesToRemove []uint32        `protobuf:"varint,2,rep,packed,name=EntitiesToRemove,proto3" json:"EntitiesToRemove,omitempty"`
+}
+
+func (x *RemoveEntityGroupMessage) Reset() {
+	*x = RemoveEntityGroupMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_channeld_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveEntityGroupMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveEntityGroupMessage) ProtoMessage() {}
+
+func (x *RemoveEntityGroupMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_channeld_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveEntityGroupMessage.ProtoReflect.Descriptor instead.
+func (*RemoveEntityGroupMessage) Descriptor() ([]byte, []int) {
+	return file_channeld_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *RemoveEntityGroupMessage) GetType() EntityGroupType {
 	if x != nil {
-		return x.ChannelId
+		return x.Type
+	}
+	return EntityGroupType_HANDOVER
+}
+
+func (x *RemoveEntityGroupMessage) GetEntitiesToRemove() []uint32 {
+	if x != nil {
+		return x.EntitiesToRemove
+	}
+	return nil
+}
+
+// Client requests the spatail regions information. Only valid in Development mode (with "-dev" launch argument).
+// Response: @SpatialRegionsUpdateMessage
+type DebugGetSpatialRegionsMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DebugGetSpatialRegionsMessage) Reset() {
+	*x = DebugGetSpatialRegionsMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_channeld_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DebugGetSpatialRegionsMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DebugGetSpatialRegionsMessage) ProtoMessage() {}
+
+func (x *DebugGetSpatialRegionsMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_channeld_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *SpatialRegion) GetServerIndex() uint32 {
-	if x != nil {
-		return x.ServerIndex
-	}
-	return 0
+// Deprecated: Use DebugGetSpatialRegionsMessage.ProtoReflect.Descriptor instead.
+func (*DebugGetSpatialRegionsMessage) Descriptor() ([]byte, []int) {
+	return file_channeld_proto_rawDescGZIP(), []int{57}
 }
 
-// channeld updates the information of spatial channels and regions to the spatial servers.
-// Spatial servers use this information mainly for mapping the position of a spawned object to a correct channelId at realtime (rather than querying it before sending the spawn message to the client).
-// Sent upon the creation of spatial channels (after @CreateSpatialChannelsResultMessage being sent), or any regional change (basiclally caused by the loadbalancer).
-type SpatialRegionsUpdateMessage struct {
+// The position of the last channel in a page of @ListChannelResultMessage. Serialized as the cursor.
+type ListChannelCursor struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Regions []*SpatialRegion `protobuf:"bytes,1,rep,name=regions,proto3" json:"regions,omitempty"`
+	LastChannelId uint32 `protobuf:"varint,1,opt,name=lastChannelId,proto3" json:"lastChannelId,omitempty"`
+	// The value of @ListChannelMessage.sortByAttribute of the last channel. Not set if the channel doesn't have the attribute.
+	LastSortValue *ChannelAttributeValue `protobuf:"bytes,2,opt,name=lastSortValue,proto3" json:"lastSortValue,omitempty"`
 }
 
-func (x *SpatialRegionsUpdateMessage) Reset() {
-	*x = SpatialRegionsUpdateMessage{}
+func (x *ListChannelCursor) Reset() {
+	*x = ListChannelCursor{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_channeld_proto_msgTypes[24]
+		mi := &file_channeld_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SpatialRegionsUpdateMessage) String() string {
+func (x *ListChannelCursor) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SpatialRegionsUpdateMessage) ProtoMessage() {}
+func (*ListChannelCursor) ProtoMessage() {}
 
-func (x *SpatialRegionsUpdateMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_channeld_proto_msgTypes[24]
+func (x *ListChannelCursor) ProtoReflect() protoreflect.Message {
+	mi := &file_channeld_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2097,46 +4849,54 @@ func (x *SpatialRegionsUpdateMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SpatialRegionsUpdateMessage.ProtoReflect.Descriptor instead.
-func (*SpatialRegionsUpdateMessage) Descriptor() ([]byte, []int) {
-	return file_channeld_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use ListChannelCursor.ProtoReflect.Descriptor instead.
+func (*ListChannelCursor) Descriptor() ([]byte, []int) {
+	return file_channeld_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *SpatialRegionsUpdateMessage) GetRegions() []*SpatialRegion {
+func (x *ListChannelCursor) GetLastChannelId() uint32 {
 	if x != nil {
-		return x.Regions
+		return x.LastChannelId
+	}
+	return 0
+}
+
+func (x *ListChannelCursor) GetLastSortValue() *ChannelAttributeValue {
+	if x != nil {
+		return x.LastSortValue
 	}
 	return nil
 }
 
-type SpatialInterestQuery struct {
+type ListChannelResultMessage_ChannelInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SpotsAOI  *SpatialInterestQuery_SpotsAOI  `protobuf:"bytes,1,opt,name=spotsAOI,proto3,oneof" json:"spotsAOI,omitempty"`
-	BoxAOI    *SpatialInterestQuery_BoxAOI    `protobuf:"bytes,2,opt,name=boxAOI,proto3,oneof" json:"boxAOI,omitempty"`
-	SphereAOI *SpatialInterestQuery_SphereAOI `protobuf:"bytes,3,opt,name=sphereAOI,proto3,oneof" json:"sphereAOI,omitempty"`
-	ConeAOI   *SpatialInterestQuery_ConeAOI   `protobuf:"bytes,4,opt,name=coneAOI,proto3,oneof" json:"coneAOI,omitempty"`
+	ChannelId       uint32                            `protobuf:"varint,1,opt,name=channelId,proto3" json:"channelId,omitempty"`
+	ChannelType     ChannelType                       `protobuf:"varint,2,opt,name=channelType,proto3,enum=channeldpb.ChannelType" json:"channelType,omitempty"`
+	Metadata        string                            `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	ParentChannelId uint32                            `protobuf:"varint,4,opt,name=parentChannelId,proto3" json:"parentChannelId,omitempty"`
+	Attributes      map[string]*ChannelAttributeValue `protobuf:"bytes,5,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *SpatialInterestQuery) Reset() {
-	*x = SpatialInterestQuery{}
+func (x *ListChannelResultMessage_ChannelInfo) Reset() {
+	*x = ListChannelResultMessage_ChannelInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_channeld_proto_msgTypes[25]
+		mi := &file_channeld_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SpatialInterestQuery) String() string {
+func (x *ListChannelResultMessage_ChannelInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SpatialInterestQuery) ProtoMessage() {}
+func (*ListChannelResultMessage_ChannelInfo) ProtoMessage() {}
 
-func (x *SpatialInterestQuery) ProtoReflect() protoreflect.Message {
-	mi := &file_channeld_proto_msgTypes[25]
+func (x *ListChannelResultMessage_ChannelInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_channeld_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2147,65 +4907,72 @@ func (x *SpatialInterestQuery) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SpatialInterestQuery.ProtoReflect.Descriptor instead.
-func (*SpatialInterestQuery) Descriptor() ([]byte, []int) {
-	return file_channeld_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use ListChannelResultMessage_ChannelInfo.ProtoReflect.Descriptor instead.
+func (*ListChannelResultMessage_ChannelInfo) Descriptor() ([]byte, []int) {
+	return file_channeld_proto_rawDescGZIP(), []int{15, 0}
 }
 
-func (x *SpatialInterestQuery) GetSpotsAOI() *SpatialInterestQuery_SpotsAOI {
+func (x *ListChannelResultMessage_ChannelInfo) GetChannelId() uint32 {
 	if x != nil {
-		return x.SpotsAOI
+		return x.ChannelId
 	}
-	return nil
+	return 0
 }
 
-func (x *SpatialInterestQuery) GetBoxAOI() *SpatialInterestQuery_BoxAOI {
+func (x *ListChannelResultMessage_ChannelInfo) GetChannelType() ChannelType {
 	if x != nil {
-		return x.BoxAOI
+		return x.ChannelType
 	}
-	return nil
+	return ChannelType_UNKNOWN
 }
 
-func (x *SpatialInterestQuery) GetSphereAOI() *SpatialInterestQuery_SphereAOI {
+func (x *ListChannelResultMessage_ChannelInfo) GetMetadata() string {
 	if x != nil {
-		return x.SphereAOI
+		return x.Metadata
 	}
-	return nil
+	return ""
 }
 
-func (x *SpatialInterestQuery) GetConeAOI() *SpatialInterestQuery_ConeAOI {
+func (x *ListChannelResultMessage_ChannelInfo) GetParentChannelId() uint32 {
 	if x != nil {
-		return x.ConeAOI
+		return x.ParentChannelId
+	}
+	return 0
+}
+
+func (x *ListChannelResultMessage_ChannelInfo) GetAttributes() map[string]*ChannelAttributeValue {
+	if x != nil {
+		return x.Attributes
 	}
 	return nil
 }
 
-type UpdateSpatialInterestMessage struct {
+type ChannelPresenceMessage_MemberInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ConnId uint32                `protobuf:"varint,1,opt,name=connId,proto3" json:"connId,omitempty"`
-	Query  *SpatialInterestQuery `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	ConnId   uint32         `protobuf:"varint,1,opt,name=connId,proto3" json:"connId,omitempty"`
+	ConnType ConnectionType `protobuf:"varint,2,opt,name=connType,proto3,enum=channeldpb.ConnectionType" json:"connType,omitempty"`
 }
 
-func (x *UpdateSpatialInterestMessage) Reset() {
-	*x = UpdateSpatialInterestMessage{}
+func (x *ChannelPresenceMessage_MemberInfo) Reset() {
+	*x = ChannelPresenceMessage_MemberInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_channeld_proto_msgTypes[26]
+		mi := &file_channeld_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateSpatialInterestMessage) String() string {
+func (x *ChannelPresenceMessage_MemberInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateSpatialInterestMessage) ProtoMessage() {}
+func (*ChannelPresenceMessage_MemberInfo) ProtoMessage() {}
 
-func (x *UpdateSpatialInterestMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_channeld_proto_msgTypes[26]
+func (x *ChannelPresenceMessage_MemberInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_channeld_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2216,55 +4983,55 @@ func (x *UpdateSpatialInterestMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateSpatialInterestMessage.ProtoReflect.Descriptor instead.
-func (*UpdateSpatialInterestMessage) Descriptor() ([]byte, []int) {
-	return file_channeld_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use ChannelPresenceMessage_MemberInfo.ProtoReflect.Descriptor instead.
+func (*ChannelPresenceMessage_MemberInfo) Descriptor() ([]byte, []int) {
+	return file_channeld_proto_rawDescGZIP(), []int{20, 0}
 }
 
-func (x *UpdateSpatialInterestMessage) GetConnId() uint32 {
+func (x *ChannelPresenceMessage_MemberInfo) GetConnId() uint32 {
 	if x != nil {
 		return x.ConnId
 	}
 	return 0
 }
 
-func (x *UpdateSpatialInterestMessage) GetQuery() *SpatialInterestQuery {
+func (x *ChannelPresenceMessage_MemberInfo) GetConnType() ConnectionType {
 	if x != nil {
-		return x.Query
+		return x.ConnType
 	}
-	return nil
+	return ConnectionType_NO_CONNECTION
 }
 
-type CreateEntityChannelMessage struct {
+type ChannelDataConflictMessage_FieldConflict struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	EntityId     uint32                      `protobuf:"varint,1,opt,name=entityId,proto3" json:"entityId,omitempty"`
-	Metadata     string                      `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	SubOptions   *ChannelSubscriptionOptions `protobuf:"bytes,3,opt,name=subOptions,proto3" json:"subOptions,omitempty"`
-	Data         *anypb.Any                  `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
-	MergeOptions *ChannelDataMergeOptions    `protobuf:"bytes,5,opt,name=mergeOptions,proto3" json:"mergeOptions,omitempty"`
-	IsWellKnown  bool                        `protobuf:"varint,6,opt,name=isWellKnown,proto3" json:"isWellKnown,omitempty"`
+	// The name of the top-level field of the channel data, or the path of the entry of a top-level map field, e.g. "players.1001".
+	Field string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	// The connection that wrote the field that won.
+	WinnerConnId uint32 `protobuf:"varint,2,opt,name=winnerConnId,proto3" json:"winnerConnId,omitempty"`
+	// The current version of the field. Only set for VERSION_COUNTER.
+	Version uint64 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
 }
 
-func (x *CreateEntityChannelMessage) Reset() {
-	*x = CreateEntityChannelMessage{}
+func (x *ChannelDataConflictMessage_FieldConflict) Reset() {
+	*x = ChannelDataConflictMessage_FieldConflict{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_channeld_proto_msgTypes[27]
+		mi := &file_channeld_proto_msgTypes[65]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateEntityChannelMessage) String() string {
+func (x *ChannelDataConflictMessage_FieldConflict) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateEntityChannelMessage) ProtoMessage() {}
+func (*ChannelDataConflictMessage_FieldConflict) ProtoMessage() {}
 
-func (x *CreateEntityChannelMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_channeld_proto_msgTypes[27]
+func (x *ChannelDataConflictMessage_FieldConflict) ProtoReflect() protoreflect.Message {
+	mi := &file_channeld_proto_msgTypes[65]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2275,80 +5042,131 @@ func (x *CreateEntityChannelMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateEntityChannelMessage.ProtoReflect.Descriptor instead.
-func (*CreateEntityChannelMessage) Descriptor() ([]byte, []int) {
-	return file_channeld_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use ChannelDataConflictMessage_FieldConflict.ProtoReflect.Descriptor instead.
+func (*ChannelDataConflictMessage_FieldConflict) Descriptor() ([]byte, []int) {
+	return file_channeld_proto_rawDescGZIP(), []int{24, 0}
 }
 
-func (x *CreateEntityChannelMessage) GetEntityId() uint32 {
+func (x *ChannelDataConflictMessage_FieldConflict) GetField() string {
 	if x != nil {
-		return x.EntityId
+		return x.Field
+	}
+	return ""
+}
+
+func (x *ChannelDataConflictMessage_FieldConflict) GetWinnerConnId() uint32 {
+	if x != nil {
+		return x.WinnerConnId
 	}
 	return 0
 }
 
-func (x *CreateEntityChannelMessage) GetMetadata() string {
+func (x *ChannelDataConflictMessage_FieldConflict) GetVersion() uint64 {
 	if x != nil {
-		return x.Metadata
+		return x.Version
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateEntityChannelMessage) GetSubOptions() *ChannelSubscriptionOptions {
+type GetChannelInfoResultMessage_SubscriberInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConnId   uint32                      `protobuf:"varint,1,opt,name=connId,proto3" json:"connId,omitempty"`
+	ConnType ConnectionType              `protobuf:"varint,2,opt,name=connType,proto3,enum=channeldpb.ConnectionType" json:"connType,omitempty"`
+	Options  *ChannelSubscriptionOptions `protobuf:"bytes,3,opt,name=options,proto3" json:"options,omitempty"`
+	// The time when the connection subscribed to the channel, in milliseconds since the channel was created.
+	SubTime int64 `protobuf:"varint,4,opt,name=subTime,proto3" json:"subTime,omitempty"`
+}
+
+func (x *GetChannelInfoResultMessage_SubscriberInfo) Reset() {
+	*x = GetChannelInfoResultMessage_SubscriberInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_channeld_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetChannelInfoResultMessage_SubscriberInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChannelInfoResultMessage_SubscriberInfo) ProtoMessage() {}
+
+func (x *GetChannelInfoResultMessage_SubscriberInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_channeld_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChannelInfoResultMessage_SubscriberInfo.ProtoReflect.Descriptor instead.
+func (*GetChannelInfoResultMessage_SubscriberInfo) Descriptor() ([]byte, []int) {
+	return file_channeld_proto_rawDescGZIP(), []int{30, 0}
+}
+
+func (x *GetChannelInfoResultMessage_SubscriberInfo) GetConnId() uint32 {
 	if x != nil {
-		return x.SubOptions
+		return x.ConnId
 	}
-	return nil
+	return 0
 }
 
-func (x *CreateEntityChannelMessage) GetData() *anypb.Any {
+func (x *GetChannelInfoResultMessage_SubscriberInfo) GetConnType() ConnectionType {
 	if x != nil {
-		return x.Data
+		return x.ConnType
 	}
-	return nil
+	return ConnectionType_NO_CONNECTION
 }
 
-func (x *CreateEntityChannelMessage) GetMergeOptions() *ChannelDataMergeOptions {
+func (x *GetChannelInfoResultMessage_SubscriberInfo) GetOptions() *ChannelSubscriptionOptions {
 	if x != nil {
-		return x.MergeOptions
+		return x.Options
 	}
 	return nil
 }
 
-func (x *CreateEntityChannelMessage) GetIsWellKnown() bool {
+func (x *GetChannelInfoResultMessage_SubscriberInfo) GetSubTime() int64 {
 	if x != nil {
-		return x.IsWellKnown
+		return x.SubTime
 	}
-	return false
+	return 0
 }
 
-// Add specified entities to the handover/lock group of the entity channel. Should sent by the entity channel owner.
-type AddEntityGroupMessage struct {
+type ChannelDataTransactionMessage_Update struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type          EntityGroupType `protobuf:"varint,1,opt,name=type,proto3,enum=channeldpb.EntityGroupType" json:"type,omitempty"`
-	EntitiesToAdd []uint32        `protobuf:"varint,2,rep,packed,name=EntitiesToAdd,proto3" json:"EntitiesToAdd,omitempty"`
+	ChannelId uint32 `protobuf:"varint,1,opt,name=channelId,proto3" json:"channelId,omitempty"`
+	// The same as @ChannelDataUpdateMessage.data. The sender should have the write access to the channel.
+	Data *anypb.Any `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *AddEntityGroupMessage) Reset() {
-	*x = AddEntityGroupMessage{}
+func (x *ChannelDataTransactionMessage_Update) Reset() {
+	*x = ChannelDataTransactionMessage_Update{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_channeld_proto_msgTypes[28]
+		mi := &file_channeld_proto_msgTypes[70]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AddEntityGroupMessage) String() string {
+func (x *ChannelDataTransactionMessage_Update) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddEntityGroupMessage) ProtoMessage() {}
+func (*ChannelDataTransactionMessage_Update) ProtoMessage() {}
 
-func (x *AddEntityGroupMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_channeld_proto_msgTypes[28]
+func (x *ChannelDataTransactionMessage_Update) ProtoReflect() protoreflect.Message {
+	mi := &file_channeld_proto_msgTypes[70]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2359,52 +5177,54 @@ func (x *AddEntityGroupMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddEntityGroupMessage.ProtoReflect.Descriptor instead.
-func (*AddEntityGroupMessage) Descriptor() ([]byte, []int) {
-	return file_channeld_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use ChannelDataTransactionMessage_Update.ProtoReflect.Descriptor instead.
+func (*ChannelDataTransactionMessage_Update) Descriptor() ([]byte, []int) {
+	return file_channeld_proto_rawDescGZIP(), []int{34, 0}
 }
 
-func (x *AddEntityGroupMessage) GetType() EntityGroupType {
+func (x *ChannelDataTransactionMessage_Update) GetChannelId() uint32 {
 	if x != nil {
-		return x.Type
+		return x.ChannelId
 	}
-	return EntityGroupType_HANDOVER
+	return 0
 }
 
-func (x *AddEntityGroupMessage) GetEntitiesToAdd() []uint32 {
+func (x *ChannelDataTransactionMessage_Update) GetData() *anypb.Any {
 	if x != nil {
-		return x.EntitiesToAdd
+		return x.Data
 	}
 	return nil
 }
 
-// Remove specified entities from the handover/lock group of the entity channel. Should sent by the entity channel owner.
-type RemoveEntityGroupMessage struct {
+type GetChannelDataResultMessage_ChannelDataResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type             EntityGroupType `protobuf:"varint,1,opt,name=type,proto3,enum=channeldpb.EntityGroupType" json:"type,omitempty"`
-	EntitiesToRemove []uint32        `protobuf:"varint,2,rep,packed,name=EntitiesToRemove,proto3" json:"EntitiesToRemove,omitempty"`
+	ChannelId uint32 `protobuf:"varint,1,opt,name=channelId,proto3" json:"channelId,omitempty"`
+	// The filtered channel data. Not set if failed.
+	Data *anypb.Any `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	// Why the data can't be read, e.g. the channel doesn't exist or the connection has no access. Empty if succeeded.
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (x *RemoveEntityGroupMessage) Reset() {
-	*x = RemoveEntityGroupMessage{}
+func (x *GetChannelDataResultMessage_ChannelDataResult) Reset() {
+	*x = GetChannelDataResultMessage_ChannelDataResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_channeld_proto_msgTypes[29]
+		mi := &file_channeld_proto_msgTypes[71]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RemoveEntityGroupMessage) String() string {
+func (x *GetChannelDataResultMessage_ChannelDataResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveEntityGroupMessage) ProtoMessage() {}
+func (*GetChannelDataResultMessage_ChannelDataResult) ProtoMessage() {}
 
-func (x *RemoveEntityGroupMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_channeld_proto_msgTypes[29]
+func (x *GetChannelDataResultMessage_ChannelDataResult) ProtoReflect() protoreflect.Message {
+	mi := &file_channeld_proto_msgTypes[71]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2415,50 +5235,58 @@ func (x *RemoveEntityGroupMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveEntityGroupMessage.ProtoReflect.Descriptor instead.
-func (*RemoveEntityGroupMessage) Descriptor() ([]byte, []int) {
-	return file_channeld_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use GetChannelDataResultMessage_ChannelDataResult.ProtoReflect.Descriptor instead.
+func (*GetChannelDataResultMessage_ChannelDataResult) Descriptor() ([]byte, []int) {
+	return file_channeld_proto_rawDescGZIP(), []int{37, 0}
 }
 
-func (x *RemoveEntityGroupMessage) GetType() EntityGroupType {
+func (x *GetChannelDataResultMessage_ChannelDataResult) GetChannelId() uint32 {
 	if x != nil {
-		return x.Type
+		return x.ChannelId
 	}
-	return EntityGroupType_HANDOVER
+	return 0
 }
 
-func (x *RemoveEntityGroupMessage) GetEntitiesToRemove() []uint32 {
+func (x *GetChannelDataResultMessage_ChannelDataResult) GetData() *anypb.Any {
 	if x != nil {
-		return x.EntitiesToRemove
+		return x.Data
 	}
 	return nil
 }
 
-// Client requests the spatail regions information. Only valid in Development mode (with "-dev" launch argument).
-// Response: @SpatialRegionsUpdateMessage
-type DebugGetSpatialRegionsMessage struct {
+func (x *GetChannelDataResultMessage_ChannelDataResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type BatchSubscribeMessage_SubEntry struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	ChannelId  uint32                      `protobuf:"varint,1,opt,name=channelId,proto3" json:"channelId,omitempty"`
+	SubOptions *ChannelSubscriptionOptions `protobuf:"bytes,2,opt,name=subOptions,proto3" json:"subOptions,omitempty"`
 }
 
-func (x *DebugGetSpatialRegionsMessage) Reset() {
-	*x = DebugGetSpatialRegionsMessage{}
+func (x *BatchSubscribeMessage_SubEntry) Reset() {
+	*x = BatchSubscribeMessage_SubEntry{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_channeld_proto_msgTypes[30]
+		mi := &file_channeld_proto_msgTypes[72]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DebugGetSpatialRegionsMessage) String() string {
+func (x *BatchSubscribeMessage_SubEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DebugGetSpatialRegionsMessage) ProtoMessage() {}
+func (*BatchSubscribeMessage_SubEntry) ProtoMessage() {}
 
-func (x *DebugGetSpatialRegionsMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_channeld_proto_msgTypes[30]
+func (x *BatchSubscribeMessage_SubEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_channeld_proto_msgTypes[72]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2469,38 +5297,58 @@ func (x *DebugGetSpatialRegionsMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DebugGetSpatialRegionsMessage.ProtoReflect.Descriptor instead.
-func (*DebugGetSpatialRegionsMessage) Descriptor() ([]byte, []int) {
-	return file_channeld_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use BatchSubscribeMessage_SubEntry.ProtoReflect.Descriptor instead.
+func (*BatchSubscribeMessage_SubEntry) Descriptor() ([]byte, []int) {
+	return file_channeld_proto_rawDescGZIP(), []int{38, 0}
 }
 
-type ListChannelResultMessage_ChannelInfo struct {
+func (x *BatchSubscribeMessage_SubEntry) GetChannelId() uint32 {
+	if x != nil {
+		return x.ChannelId
+	}
+	return 0
+}
+
+func (x *BatchSubscribeMessage_SubEntry) GetSubOptions() *ChannelSubscriptionOptions {
+	if x != nil {
+		return x.SubOptions
+	}
+	return nil
+}
+
+type BatchSubscribeResultMessage_ChannelResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ChannelId   uint32      `protobuf:"varint,1,opt,name=channelId,proto3" json:"channelId,omitempty"`
+	ChannelId uint32 `protobuf:"varint,1,opt,name=channelId,proto3" json:"channelId,omitempty"`
+	// Not set if the channel doesn't exist.
 	ChannelType ChannelType `protobuf:"varint,2,opt,name=channelType,proto3,enum=channeldpb.ChannelType" json:"channelType,omitempty"`
-	Metadata    string      `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// The options after the subscription. Only set for the succeeded subscriptions.
+	SubOptions *ChannelSubscriptionOptions `protobuf:"bytes,3,opt,name=subOptions,proto3" json:"subOptions,omitempty"`
+	// Why the subscription or unsubscription failed. Empty if succeeded.
+	Error string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	// The same as @ErrorResultMessage.errorCode. Only set if failed.
+	ErrorCode ErrorResultMessage_ErrorCode `protobuf:"varint,5,opt,name=errorCode,proto3,enum=channeldpb.ErrorResultMessage_ErrorCode" json:"errorCode,omitempty"`
 }
 
-func (x *ListChannelResultMessage_ChannelInfo) Reset() {
-	*x = ListChannelResultMessage_ChannelInfo{}
+func (x *BatchSubscribeResultMessage_ChannelResult) Reset() {
+	*x = BatchSubscribeResultMessage_ChannelResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_channeld_proto_msgTypes[31]
+		mi := &file_channeld_proto_msgTypes[73]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListChannelResultMessage_ChannelInfo) String() string {
+func (x *BatchSubscribeResultMessage_ChannelResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListChannelResultMessage_ChannelInfo) ProtoMessage() {}
+func (*BatchSubscribeResultMessage_ChannelResult) ProtoMessage() {}
 
-func (x *ListChannelResultMessage_ChannelInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_channeld_proto_msgTypes[31]
+func (x *BatchSubscribeResultMessage_ChannelResult) ProtoReflect() protoreflect.Message {
+	mi := &file_channeld_proto_msgTypes[73]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2511,32 +5359,46 @@ func (x *ListChannelResultMessage_ChannelInfo) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListChannelResultMessage_ChannelInfo.ProtoReflect.Descriptor instead.
-func (*ListChannelResultMessage_ChannelInfo) Descriptor() ([]byte, []int) {
-	return file_channeld_proto_rawDescGZIP(), []int{11, 0}
+// Deprecated: Use BatchSubscribeResultMessage_ChannelResult.ProtoReflect.Descriptor instead.
+func (*BatchSubscribeResultMessage_ChannelResult) Descriptor() ([]byte, []int) {
+	return file_channeld_proto_rawDescGZIP(), []int{39, 0}
 }
 
-func (x *ListChannelResultMessage_ChannelInfo) GetChannelId() uint32 {
+func (x *BatchSubscribeResultMessage_ChannelResult) GetChannelId() uint32 {
 	if x != nil {
 		return x.ChannelId
 	}
 	return 0
 }
 
-func (x *ListChannelResultMessage_ChannelInfo) GetChannelType() ChannelType {
+func (x *BatchSubscribeResultMessage_ChannelResult) GetChannelType() ChannelType {
 	if x != nil {
 		return x.ChannelType
 	}
 	return ChannelType_UNKNOWN
 }
 
-func (x *ListChannelResultMessage_ChannelInfo) GetMetadata() string {
+func (x *BatchSubscribeResultMessage_ChannelResult) GetSubOptions() *ChannelSubscriptionOptions {
 	if x != nil {
-		return x.Metadata
+		return x.SubOptions
+	}
+	return nil
+}
+
+func (x *BatchSubscribeResultMessage_ChannelResult) GetError() string {
+	if x != nil {
+		return x.Error
 	}
 	return ""
 }
 
+func (x *BatchSubscribeResultMessage_ChannelResult) GetErrorCode() ErrorResultMessage_ErrorCode {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ErrorResultMessage_UNKNOWN_ERROR
+}
+
 type SpatialInterestQuery_SpotsAOI struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2550,7 +5412,7 @@ type SpatialInterestQuery_SpotsAOI struct {
 func (x *SpatialInterestQuery_SpotsAOI) Reset() {
 	*x = SpatialInterestQuery_SpotsAOI{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_channeld_proto_msgTypes[32]
+		mi := &file_channeld_proto_msgTypes[74]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2563,7 +5425,7 @@ func (x *SpatialInterestQuery_SpotsAOI) String() string {
 func (*SpatialInterestQuery_SpotsAOI) ProtoMessage() {}
 
 func (x *SpatialInterestQuery_SpotsAOI) ProtoReflect() protoreflect.Message {
-	mi := &file_channeld_proto_msgTypes[32]
+	mi := &file_channeld_proto_msgTypes[74]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2576,7 +5438,7 @@ func (x *SpatialInterestQuery_SpotsAOI) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SpatialInterestQuery_SpotsAOI.ProtoReflect.Descriptor instead.
 func (*SpatialInterestQuery_SpotsAOI) Descriptor() ([]byte, []int) {
-	return file_channeld_proto_rawDescGZIP(), []int{25, 0}
+	return file_channeld_proto_rawDescGZIP(), []int{52, 0}
 }
 
 func (x *SpatialInterestQuery_SpotsAOI) GetSpots() []*SpatialInfo {
@@ -2605,7 +5467,7 @@ type SpatialInterestQuery_BoxAOI struct {
 func (x *SpatialInterestQuery_BoxAOI) Reset() {
 	*x = SpatialInterestQuery_BoxAOI{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_channeld_proto_msgTypes[33]
+		mi := &file_channeld_proto_msgTypes[75]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2618,7 +5480,7 @@ func (x *SpatialInterestQuery_BoxAOI) String() string {
 func (*SpatialInterestQuery_BoxAOI) ProtoMessage() {}
 
 func (x *SpatialInterestQuery_BoxAOI) ProtoReflect() protoreflect.Message {
-	mi := &file_channeld_proto_msgTypes[33]
+	mi := &file_channeld_proto_msgTypes[75]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2631,7 +5493,7 @@ func (x *SpatialInterestQuery_BoxAOI) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SpatialInterestQuery_BoxAOI.ProtoReflect.Descriptor instead.
 func (*SpatialInterestQuery_BoxAOI) Descriptor() ([]byte, []int) {
-	return file_channeld_proto_rawDescGZIP(), []int{25, 1}
+	return file_channeld_proto_rawDescGZIP(), []int{52, 1}
 }
 
 func (x *SpatialInterestQuery_BoxAOI) GetCenter() *SpatialInfo {
@@ -2660,7 +5522,7 @@ type SpatialInterestQuery_SphereAOI struct {
 func (x *SpatialInterestQuery_SphereAOI) Reset() {
 	*x = SpatialInterestQuery_SphereAOI{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_channeld_proto_msgTypes[34]
+		mi := &file_channeld_proto_msgTypes[76]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2673,7 +5535,7 @@ func (x *SpatialInterestQuery_SphereAOI) String() string {
 func (*SpatialInterestQuery_SphereAOI) ProtoMessage() {}
 
 func (x *SpatialInterestQuery_SphereAOI) ProtoReflect() protoreflect.Message {
-	mi := &file_channeld_proto_msgTypes[34]
+	mi := &file_channeld_proto_msgTypes[76]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2686,7 +5548,7 @@ func (x *SpatialInterestQuery_SphereAOI) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SpatialInterestQuery_SphereAOI.ProtoReflect.Descriptor instead.
 func (*SpatialInterestQuery_SphereAOI) Descriptor() ([]byte, []int) {
-	return file_channeld_proto_rawDescGZIP(), []int{25, 2}
+	return file_channeld_proto_rawDescGZIP(), []int{52, 2}
 }
 
 func (x *SpatialInterestQuery_SphereAOI) GetCenter() *SpatialInfo {
@@ -2718,7 +5580,7 @@ type SpatialInterestQuery_ConeAOI struct {
 func (x *SpatialInterestQuery_ConeAOI) Reset() {
 	*x = SpatialInterestQuery_ConeAOI{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_channeld_proto_msgTypes[35]
+		mi := &file_channeld_proto_msgTypes[77]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2731,7 +5593,7 @@ func (x *SpatialInterestQuery_ConeAOI) String() string {
 func (*SpatialInterestQuery_ConeAOI) ProtoMessage() {}
 
 func (x *SpatialInterestQuery_ConeAOI) ProtoReflect() protoreflect.Message {
-	mi := &file_channeld_proto_msgTypes[35]
+	mi := &file_channeld_proto_msgTypes[77]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2744,7 +5606,7 @@ func (x *SpatialInterestQuery_ConeAOI) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SpatialInterestQuery_ConeAOI.ProtoReflect.Descriptor instead.
 func (*SpatialInterestQuery_ConeAOI) Descriptor() ([]byte, []int) {
-	return file_channeld_proto_rawDescGZIP(), []int{25, 3}
+	return file_channeld_proto_rawDescGZIP(), []int{52, 3}
 }
 
 func (x *SpatialInterestQuery_ConeAOI) GetCenter() *SpatialInfo {
@@ -2781,32 +5643,36 @@ var file_channeld_proto_rawDesc = []byte{
 	0x0a, 0x0e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x12, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x64, 0x70, 0x62, 0x1a, 0x19, 0x67, 0x6f,
 	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x61, 0x6e,
-	0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x3d, 0x0a, 0x06, 0x50, 0x61, 0x63, 0x6b, 0x65,
-	0x74, 0x12, 0x33, 0x0a, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x64, 0x70, 0x62,
-	0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x52, 0x08, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x22, 0x95, 0x01, 0x0a, 0x0b, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
-	0x6c, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e,
-	0x65, 0x6c, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x62, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61,
-	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x75, 0x62, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x06, 0x73, 0x74, 0x75, 0x62, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x73,
-	0x67, 0x54, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6d, 0x73, 0x67,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x73, 0x67, 0x42, 0x6f, 0x64, 0x79, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x6d, 0x73, 0x67, 0x42, 0x6f, 0x64, 0x79, 0x22, 0x54,
-	0x0a, 0x14, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
-	0x43, 0x6f, 0x6e, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x63, 0x6c,
-	0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61,
-	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79,
-	0x6c, 0x6f, 0x61, 0x64, 0x22, 0x63, 0x0a, 0x0b, 0x41, 0x75, 0x74, 0x68, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x12, 0x34, 0x0a, 0x15, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x65,
-	0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x15, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
-	0x66, 0x69, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x6c, 0x6f, 0x67,
-	0x69, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c,
-	0x6f, 0x67, 0x69, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xf3, 0x01, 0x0a, 0x11, 0x41, 0x75,
+	0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x13, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x5f, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x3d, 0x0a, 0x06,
+	0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x33, 0x0a, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x50, 0x61, 0x63,
+	0x6b, 0x52, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x22, 0x95, 0x01, 0x0a, 0x0b,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x12, 0x1c, 0x0a, 0x09, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x72, 0x6f,
+	0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x62, 0x72,
+	0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x75, 0x62, 0x49,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x73, 0x74, 0x75, 0x62, 0x49, 0x64, 0x12,
+	0x18, 0x0a, 0x07, 0x6d, 0x73, 0x67, 0x54, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x07, 0x6d, 0x73, 0x67, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x73, 0x67,
+	0x42, 0x6f, 0x64, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x6d, 0x73, 0x67, 0x42,
+	0x6f, 0x64, 0x79, 0x22, 0x54, 0x0a, 0x14, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x46, 0x6f, 0x72,
+	0x77, 0x61, 0x72, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x49, 0x64, 0x12,
+	0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x8d, 0x01, 0x0a, 0x0b, 0x41, 0x75,
+	0x74, 0x68, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x34, 0x0a, 0x15, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x1e, 0x0a, 0x0a, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x28, 0x0a, 0x0f, 0x62, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x42, 0x75, 0x64, 0x67,
+	0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x62, 0x61, 0x6e, 0x64, 0x77, 0x69,
+	0x64, 0x74, 0x68, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x22, 0xf3, 0x01, 0x0a, 0x11, 0x41, 0x75,
 	0x74, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
 	0x40, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
 	0x28, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x41, 0x75, 0x74,
@@ -2822,7 +5688,7 @@ var file_channeld_proto_rawDesc = []byte{
 	0x0a, 0x0a, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x46, 0x55, 0x4c, 0x10, 0x00, 0x12, 0x0f,
 	0x0a, 0x0b, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x50, 0x49, 0x54, 0x10, 0x01, 0x12,
 	0x0e, 0x0a, 0x0a, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x4c, 0x54, 0x10, 0x02, 0x22,
-	0xaf, 0x03, 0x0a, 0x1a, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0xbb, 0x05, 0x0a, 0x1a, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x75, 0x62, 0x73, 0x63,
 	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x42,
 	0x0a, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x64, 0x70, 0x62, 0x2e,
@@ -2842,91 +5708,233 @@ var file_channeld_proto_rawDesc = []byte{
 	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x61, 0x6e, 0x4f, 0x75, 0x74, 0x88, 0x01, 0x01, 0x12,
 	0x2d, 0x0a, 0x0f, 0x73, 0x6b, 0x69, 0x70, 0x46, 0x69, 0x72, 0x73, 0x74, 0x46, 0x61, 0x6e, 0x4f,
 	0x75, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x48, 0x04, 0x52, 0x0f, 0x73, 0x6b, 0x69, 0x70,
-	0x46, 0x69, 0x72, 0x73, 0x74, 0x46, 0x61, 0x6e, 0x4f, 0x75, 0x74, 0x88, 0x01, 0x01, 0x42, 0x0d,
-	0x0a, 0x0b, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x42, 0x13, 0x0a,
-	0x11, 0x5f, 0x66, 0x61, 0x6e, 0x4f, 0x75, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
-	0x4d, 0x73, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x66, 0x61, 0x6e, 0x4f, 0x75, 0x74, 0x44, 0x65, 0x6c,
-	0x61, 0x79, 0x4d, 0x73, 0x42, 0x17, 0x0a, 0x15, 0x5f, 0x73, 0x6b, 0x69, 0x70, 0x53, 0x65, 0x6c,
-	0x66, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x61, 0x6e, 0x4f, 0x75, 0x74, 0x42, 0x12, 0x0a,
-	0x10, 0x5f, 0x73, 0x6b, 0x69, 0x70, 0x46, 0x69, 0x72, 0x73, 0x74, 0x46, 0x61, 0x6e, 0x4f, 0x75,
-	0x74, 0x22, 0xd3, 0x01, 0x0a, 0x17, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x44, 0x61, 0x74,
-	0x61, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2c, 0x0a,
-	0x11, 0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x4c, 0x69,
-	0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64,
-	0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x6c,
-	0x69, 0x73, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x0d, 0x6c, 0x69, 0x73, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x4c, 0x69, 0x6d, 0x69,
-	0x74, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x70,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65,
-	0x54, 0x6f, 0x70, 0x12, 0x42, 0x0a, 0x1c, 0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x43, 0x68, 0x65,
-	0x63, 0x6b, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x61, 0x62, 0x6c, 0x65, 0x4d, 0x61, 0x70, 0x46, 0x69,
-	0x65, 0x6c, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x1c, 0x73, 0x68, 0x6f, 0x75, 0x6c,
-	0x64, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x61, 0x62, 0x6c, 0x65, 0x4d,
-	0x61, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x22, 0xa8, 0x02, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x12, 0x39, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x64,
-	0x70, 0x62, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b,
-	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x46, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x4f, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x68,
-	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
-	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
-	0x28, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x41, 0x6e, 0x79, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x47, 0x0a, 0x0c, 0x6d, 0x65, 0x72,
-	0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x23, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x61,
-	0x6e, 0x6e, 0x65, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x4f, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0c, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x22, 0xb3, 0x01, 0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61,
-	0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x12, 0x39, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x54, 0x79, 0x70, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
-	0x64, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x52,
-	0x0b, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08,
-	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x77, 0x6e, 0x65,
-	0x72, 0x43, 0x6f, 0x6e, 0x6e, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6f,
-	0x77, 0x6e, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x6e, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x68,
-	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x63,
+	0x46, 0x69, 0x72, 0x73, 0x74, 0x46, 0x61, 0x6e, 0x4f, 0x75, 0x74, 0x88, 0x01, 0x01, 0x12, 0x25,
+	0x0a, 0x0b, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x46, 0x61, 0x6e, 0x4f, 0x75, 0x74, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x08, 0x48, 0x05, 0x52, 0x0b, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x46, 0x61, 0x6e, 0x4f,
+	0x75, 0x74, 0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a, 0x0f, 0x62, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64,
+	0x74, 0x68, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x06,
+	0x52, 0x0f, 0x62, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x42, 0x75, 0x64, 0x67, 0x65,
+	0x74, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x07, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69,
+	0x74, 0x79, 0x88, 0x01, 0x01, 0x12, 0x38, 0x0a, 0x0b, 0x66, 0x61, 0x6e, 0x4f, 0x75, 0x74, 0x54,
+	0x69, 0x65, 0x72, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x46, 0x61, 0x6e, 0x4f, 0x75, 0x74, 0x54, 0x69,
+	0x65, 0x72, 0x52, 0x0b, 0x66, 0x61, 0x6e, 0x4f, 0x75, 0x74, 0x54, 0x69, 0x65, 0x72, 0x73, 0x12,
+	0x28, 0x0a, 0x0f, 0x77, 0x72, 0x69, 0x74, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73,
+	0x6b, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x77, 0x72, 0x69, 0x74, 0x65, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x73, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x64, 0x61,
+	0x74, 0x61, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x66, 0x61, 0x6e,
+	0x4f, 0x75, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x4d, 0x73, 0x42, 0x10, 0x0a,
+	0x0e, 0x5f, 0x66, 0x61, 0x6e, 0x4f, 0x75, 0x74, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x4d, 0x73, 0x42,
+	0x17, 0x0a, 0x15, 0x5f, 0x73, 0x6b, 0x69, 0x70, 0x53, 0x65, 0x6c, 0x66, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x46, 0x61, 0x6e, 0x4f, 0x75, 0x74, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x73, 0x6b, 0x69,
+	0x70, 0x46, 0x69, 0x72, 0x73, 0x74, 0x46, 0x61, 0x6e, 0x4f, 0x75, 0x74, 0x42, 0x0e, 0x0a, 0x0c,
+	0x5f, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x46, 0x61, 0x6e, 0x4f, 0x75, 0x74, 0x42, 0x12, 0x0a, 0x10,
+	0x5f, 0x62, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74,
+	0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0x60, 0x0a,
+	0x0a, 0x46, 0x61, 0x6e, 0x4f, 0x75, 0x74, 0x54, 0x69, 0x65, 0x72, 0x12, 0x26, 0x0a, 0x0e, 0x64,
+	0x61, 0x74, 0x61, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0e, 0x64, 0x61, 0x74, 0x61, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61,
+	0x73, 0x6b, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x66, 0x61, 0x6e, 0x4f, 0x75, 0x74, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x76, 0x61, 0x6c, 0x4d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x66,
+	0x61, 0x6e, 0x4f, 0x75, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x4d, 0x73, 0x22,
+	0xd0, 0x04, 0x0a, 0x17, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x4d,
+	0x65, 0x72, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x73,
+	0x68, 0x6f, 0x75, 0x6c, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x52, 0x65,
+	0x70, 0x6c, 0x61, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x6c, 0x69, 0x73,
+	0x74, 0x53, 0x69, 0x7a, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0d, 0x6c, 0x69, 0x73, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12,
+	0x20, 0x0a, 0x0b, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x70, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x54, 0x6f,
+	0x70, 0x12, 0x42, 0x0a, 0x1c, 0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x61, 0x62, 0x6c, 0x65, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x1c, 0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x61, 0x62, 0x6c, 0x65, 0x4d, 0x61, 0x70,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x5a, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63,
+	0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x32, 0x2e,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x12, 0x59, 0x0a, 0x0c, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x64, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x44, 0x61, 0x74, 0x61,
+	0x4d, 0x65, 0x72, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x5e, 0x0a, 0x11,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x33, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x64, 0x70, 0x62, 0x2e,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x64, 0x0a, 0x0e,
+	0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x17,
+	0x0a, 0x13, 0x4c, 0x41, 0x53, 0x54, 0x5f, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x45, 0x44,
+	0x5f, 0x57, 0x49, 0x4e, 0x53, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x4f, 0x57, 0x4e, 0x45, 0x52,
+	0x5f, 0x57, 0x49, 0x4e, 0x53, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x4c, 0x41, 0x53, 0x54, 0x5f,
+	0x57, 0x52, 0x49, 0x54, 0x45, 0x52, 0x5f, 0x57, 0x49, 0x4e, 0x53, 0x10, 0x02, 0x12, 0x13, 0x0a,
+	0x0f, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x45, 0x52,
+	0x10, 0x03, 0x22, 0x92, 0x05, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x39, 0x0a, 0x0b, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x17, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x12, 0x46, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x64, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0a,
+	0x73, 0x75, 0x62, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x28, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x12, 0x47, 0x0a, 0x0c, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x44,
+	0x61, 0x74, 0x61, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x0c, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x4a, 0x0a,
+	0x0e, 0x6d, 0x61, 0x78, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x64,
+	0x70, 0x62, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x72, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x70, 0x61, 0x72,
+	0x65, 0x6e, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0f, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x49, 0x64, 0x12, 0x50, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x64, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x0e, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x50,
+	0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52,
+	0x0e, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x88,
+	0x01, 0x01, 0x1a, 0x60, 0x0a, 0x0f, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x37, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x64, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x41, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x50,
+	0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x82, 0x01, 0x0a, 0x15, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x22, 0x0a, 0x0b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1c, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x1e, 0x0a, 0x09, 0x62, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x09, 0x62, 0x6f, 0x6f, 0x6c, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xcf, 0x02, 0x0a,
+	0x16, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x3b, 0x0a, 0x02, 0x6f, 0x70, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x64, 0x70, 0x62,
+	0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
+	0x52, 0x02, 0x6f, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x37, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x64,
+	0x70, 0x62, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x42, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x64, 0x70, 0x62,
+	0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x73, 0x22, 0x69, 0x0a, 0x08, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12,
+	0x06, 0x0a, 0x02, 0x45, 0x51, 0x10, 0x00, 0x12, 0x06, 0x0a, 0x02, 0x4e, 0x45, 0x10, 0x01, 0x12,
+	0x06, 0x0a, 0x02, 0x4c, 0x54, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03, 0x4c, 0x54, 0x45, 0x10, 0x03,
+	0x12, 0x06, 0x0a, 0x02, 0x47, 0x54, 0x10, 0x04, 0x12, 0x07, 0x0a, 0x03, 0x47, 0x54, 0x45, 0x10,
+	0x05, 0x12, 0x0a, 0x0a, 0x06, 0x45, 0x58, 0x49, 0x53, 0x54, 0x53, 0x10, 0x06, 0x12, 0x07, 0x0a,
+	0x03, 0x41, 0x4e, 0x44, 0x10, 0x07, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x52, 0x10, 0x08, 0x12, 0x0e,
+	0x0a, 0x0a, 0x4e, 0x4f, 0x54, 0x5f, 0x45, 0x58, 0x49, 0x53, 0x54, 0x53, 0x10, 0x09, 0x22, 0x58,
+	0x0a, 0x16, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x72, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x43,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6d, 0x61,
+	0x78, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6d, 0x61,
+	0x78, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x22, 0xdd, 0x01, 0x0a, 0x1a, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x39, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x20,
+	0x0a, 0x0b, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x6e, 0x49, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x6e, 0x49, 0x64,
+	0x12, 0x1c, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x28,
+	0x0a, 0x0f, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43,
 	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x22, 0x34, 0x0a, 0x14, 0x52, 0x65, 0x6d, 0x6f,
 	0x76, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
 	0x12, 0x1c, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x22, 0x77,
-	0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x12, 0x37, 0x0a, 0x0a, 0x74, 0x79, 0x70, 0x65, 0x46, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e,
-	0x65, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x54, 0x79, 0x70,
-	0x65, 0x52, 0x0a, 0x74, 0x79, 0x70, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x28, 0x0a,
-	0x0f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x22, 0xed, 0x01, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74,
-	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x12, 0x4c, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
-	0x64, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x43, 0x68, 0x61,
-	0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
-	0x6c, 0x73, 0x1a, 0x82, 0x01, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e,
-	0x66, 0x6f, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64,
-	0x12, 0x39, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x64,
-	0x70, 0x62, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b,
-	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x7c, 0x0a, 0x1a, 0x53, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x62, 0x65, 0x64, 0x54, 0x6f, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x6e, 0x49, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x6e, 0x49, 0x64, 0x12, 0x46, 0x0a,
-	0x0a, 0x73, 0x75, 0x62, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x26, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x43,
-	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x4f, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xf5, 0x01, 0x0a, 0x20, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x01, 0x28, 0x0d, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x22, 0xf1,
+	0x02, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x37, 0x0a, 0x0a, 0x74, 0x79, 0x70, 0x65, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x63, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x0a, 0x74, 0x79, 0x70, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x28,
+	0x0a, 0x0f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x73, 0x75, 0x62, 0x74,
+	0x72, 0x65, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0d, 0x73, 0x75, 0x62, 0x74, 0x72, 0x65, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x49, 0x64, 0x12, 0x4c,
+	0x0a, 0x0f, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x64, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x41, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x0f, 0x61, 0x74, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x28, 0x0a, 0x0f,
+	0x73, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x73, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x41, 0x74, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x6f, 0x72, 0x74, 0x44, 0x65,
+	0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e,
+	0x73, 0x6f, 0x72, 0x74, 0x44, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x22, 0xfb, 0x03, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x4c, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x30, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x1e, 0x0a,
+	0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x1a, 0xf0, 0x02,
+	0x0a, 0x0b, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1c, 0x0a,
+	0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0b, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x17, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x12, 0x28, 0x0a, 0x0f, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x70, 0x61, 0x72,
+	0x65, 0x6e, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x60, 0x0a, 0x0a,
+	0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x40, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e,
+	0x66, 0x6f, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x1a, 0x60,
+	0x0a, 0x0f, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x37, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x64, 0x70, 0x62, 0x2e,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0xa2, 0x01, 0x0a, 0x1a, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x64, 0x54,
+	0x6f, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x06, 0x63, 0x6f, 0x6e, 0x6e, 0x49, 0x64, 0x12, 0x46, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x24, 0x0a, 0x0d, 0x73, 0x75, 0x62, 0x54, 0x6f, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x73, 0x75, 0x62, 0x54, 0x6f, 0x43, 0x68, 0x69,
+	0x6c, 0x64, 0x72, 0x65, 0x6e, 0x22, 0xf5, 0x01, 0x0a, 0x20, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
 	0x69, 0x62, 0x65, 0x64, 0x54, 0x6f, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73,
 	0x75, 0x6c, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f,
 	0x6e, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x6e,
@@ -2941,175 +5949,513 @@ var file_channeld_proto_rawDesc = []byte{
 	0x70, 0x65, 0x12, 0x39, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x54, 0x79, 0x70,
 	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
 	0x6c, 0x64, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x54, 0x79, 0x70, 0x65,
-	0x52, 0x0b, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x22, 0x38, 0x0a,
-	0x1e, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x64, 0x46, 0x72, 0x6f,
-	0x6d, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
-	0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x06, 0x63, 0x6f, 0x6e, 0x6e, 0x49, 0x64, 0x22, 0xb1, 0x01, 0x0a, 0x24, 0x55, 0x6e, 0x73, 0x75,
-	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x43, 0x68, 0x61, 0x6e,
-	0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x06, 0x63, 0x6f, 0x6e, 0x6e, 0x49, 0x64, 0x12, 0x36, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x6e,
-	0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x63, 0x68, 0x61,
-	0x6e, 0x6e, 0x65, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x6e, 0x54, 0x79, 0x70, 0x65,
-	0x12, 0x39, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x64,
-	0x70, 0x62, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b,
-	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x22, 0x6a, 0x0a, 0x18, 0x43,
-	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x04, 0x64, 0x61, 0x74,
-	0x61, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x43, 0x6f, 0x6e, 0x6e,
-	0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78,
-	0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x49, 0x64, 0x22, 0x2b, 0x0a, 0x11, 0x44, 0x69, 0x73, 0x63, 0x6f,
-	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x52, 0x0b, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x22, 0x79, 0x0a,
+	0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x6e,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x6e, 0x49, 0x64,
+	0x12, 0x46, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x64, 0x70,
+	0x62, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0a, 0x73, 0x75,
+	0x62, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x7f, 0x0a, 0x1d, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x53, 0x75, 0x62, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6e,
+	0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x6e, 0x49,
+	0x64, 0x12, 0x46, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x64,
+	0x70, 0x62, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0a, 0x73,
+	0x75, 0x62, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xbe, 0x02, 0x0a, 0x16, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x4a, 0x0a, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2c, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x64, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x72, 0x65, 0x73,
+	0x65, 0x6e, 0x63, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x47, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2d, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x1a, 0x5c, 0x0a, 0x0a, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x6e, 0x49,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x6e, 0x49, 0x64, 0x12,
+	0x36, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1a, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x63,
+	0x6f, 0x6e, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x22, 0x31, 0x0a, 0x09, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x4a, 0x4f, 0x49, 0x4e, 0x10, 0x00, 0x12, 0x09,
+	0x0a, 0x05, 0x4c, 0x45, 0x41, 0x56, 0x45, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x4d, 0x45, 0x4d,
+	0x42, 0x45, 0x52, 0x5f, 0x4c, 0x49, 0x53, 0x54, 0x10, 0x02, 0x22, 0x38, 0x0a, 0x1e, 0x55, 0x6e,
+	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06,
 	0x63, 0x6f, 0x6e, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x63, 0x6f,
-	0x6e, 0x6e, 0x49, 0x64, 0x22, 0x37, 0x0a, 0x0b, 0x53, 0x70, 0x61, 0x74, 0x69, 0x61, 0x6c, 0x49,
-	0x6e, 0x66, 0x6f, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x01,
-	0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x01, 0x79, 0x12,
-	0x0c, 0x0a, 0x01, 0x7a, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x01, 0x7a, 0x22, 0x8e, 0x01,
-	0x0a, 0x22, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x70, 0x61, 0x74, 0x69, 0x61, 0x6c, 0x43,
-	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x73, 0x70, 0x61, 0x74, 0x69, 0x61, 0x6c, 0x43,
-	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x10,
-	0x73, 0x70, 0x61, 0x74, 0x69, 0x61, 0x6c, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64,
-	0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x20, 0x0a, 0x0b,
-	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x6e, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x0b, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x6e, 0x49, 0x64, 0x22, 0x57,
-	0x0a, 0x1a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x70, 0x61, 0x74, 0x69, 0x61, 0x6c, 0x43, 0x68,
-	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x39, 0x0a, 0x0b,
-	0x73, 0x70, 0x61, 0x74, 0x69, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x17, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x53,
-	0x70, 0x61, 0x74, 0x69, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x73, 0x70, 0x61, 0x74,
-	0x69, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x40, 0x0a, 0x20, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x53, 0x70, 0x61, 0x74, 0x69, 0x61, 0x6c, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65,
+	0x6e, 0x6e, 0x49, 0x64, 0x22, 0xb1, 0x01, 0x0a, 0x24, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x63, 0x6f, 0x6e, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x63,
+	0x6f, 0x6e, 0x6e, 0x49, 0x64, 0x12, 0x36, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x6e, 0x54, 0x79, 0x70,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x64, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x39, 0x0a,
+	0x0b, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x17, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x64, 0x70, 0x62, 0x2e,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x63, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x22, 0xe3, 0x02, 0x0a, 0x18, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12,
+	0x24, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x49, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x43,
+	0x6f, 0x6e, 0x6e, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x73, 0x67, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x73, 0x67, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x75, 0x6c, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x66, 0x75, 0x6c, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x5d, 0x0a,
+	0x0d, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x64, 0x70,
+	0x62, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x40, 0x0a, 0x12,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xa1,
+	0x02, 0x0a, 0x1a, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x43, 0x6f,
+	0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x4a, 0x0a,
+	0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x32, 0x2e,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x52, 0x0a, 0x09, 0x63, 0x6f, 0x6e,
+	0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x44, 0x61, 0x74, 0x61, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69,
+	0x63, 0x74, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x1a, 0x63, 0x0a,
+	0x0d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x43, 0x6f,
+	0x6e, 0x6e, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x77, 0x69, 0x6e, 0x6e,
+	0x65, 0x72, 0x43, 0x6f, 0x6e, 0x6e, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x22, 0x33, 0x0a, 0x15, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x44, 0x61, 0x74,
+	0x61, 0x41, 0x63, 0x6b, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6d,
+	0x73, 0x67, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d,
+	0x73, 0x67, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x77, 0x0a, 0x1d, 0x52, 0x65, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x53, 0x6c, 0x6f, 0x74,
+	0x73, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x6e,
+	0x49, 0x64, 0x73, 0x54, 0x6f, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0d, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x6e, 0x49, 0x64, 0x73, 0x54, 0x6f, 0x52, 0x65, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x6e, 0x49, 0x64, 0x73, 0x54,
+	0x6f, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x10,
+	0x63, 0x6f, 0x6e, 0x6e, 0x49, 0x64, 0x73, 0x54, 0x6f, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x22, 0x82, 0x02, 0x0a, 0x1e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x5a, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x64, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12,
+	0x22, 0x0a, 0x0c, 0x6b, 0x65, 0x79, 0x73, 0x54, 0x6f, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x6b, 0x65, 0x79, 0x73, 0x54, 0x6f, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x1a, 0x60, 0x0a, 0x0f, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x37, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x64, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x41, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xea, 0x01, 0x0a, 0x24, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x60,
+	0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x40, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x64, 0x70, 0x62, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x41, 0x74, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73,
+	0x1a, 0x60, 0x0a, 0x0f, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x37, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x64, 0x70,
+	0x62, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x22, 0x17, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x49, 0x6e, 0x66, 0x6f, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x9a, 0x07, 0x0a, 0x1b,
+	0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
 	0x73, 0x75, 0x6c, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63,
-	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x09,
-	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x22, 0xb4, 0x01, 0x0a, 0x1a, 0x43, 0x68,
-	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x48, 0x61, 0x6e, 0x64, 0x6f, 0x76, 0x65,
-	0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x72, 0x63, 0x43,
-	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c,
-	0x73, 0x72, 0x63, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c,
-	0x64, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x0c, 0x64, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64,
-	0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x49,
-	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74,
-	0x43, 0x6f, 0x6e, 0x6e, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
-	0x22, 0xa5, 0x01, 0x0a, 0x0d, 0x53, 0x70, 0x61, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x67, 0x69,
-	0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x17, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x53, 0x70, 0x61,
-	0x74, 0x69, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12, 0x29, 0x0a,
-	0x03, 0x6d, 0x61, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x68, 0x61,
-	0x6e, 0x6e, 0x65, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x53, 0x70, 0x61, 0x74, 0x69, 0x61, 0x6c, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x6e,
-	0x6e, 0x65, 0x6c, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x63, 0x68, 0x61,
-	0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x73, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x52, 0x0a, 0x1b, 0x53, 0x70, 0x61, 0x74,
-	0x69, 0x61, 0x6c, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x72, 0x65, 0x67, 0x69, 0x6f,
-	0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e,
-	0x65, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x53, 0x70, 0x61, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x67,
-	0x69, 0x6f, 0x6e, 0x52, 0x07, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xa7, 0x06, 0x0a,
-	0x14, 0x53, 0x70, 0x61, 0x74, 0x69, 0x61, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x4a, 0x0a, 0x08, 0x73, 0x70, 0x6f, 0x74, 0x73, 0x41, 0x4f,
-	0x49, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
-	0x6c, 0x64, 0x70, 0x62, 0x2e, 0x53, 0x70, 0x61, 0x74, 0x69, 0x61, 0x6c, 0x49, 0x6e, 0x74, 0x65,
-	0x72, 0x65, 0x73, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x53, 0x70, 0x6f, 0x74, 0x73, 0x41,
-	0x4f, 0x49, 0x48, 0x00, 0x52, 0x08, 0x73, 0x70, 0x6f, 0x74, 0x73, 0x41, 0x4f, 0x49, 0x88, 0x01,
-	0x01, 0x12, 0x44, 0x0a, 0x06, 0x62, 0x6f, 0x78, 0x41, 0x4f, 0x49, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x27, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x53,
-	0x70, 0x61, 0x74, 0x69, 0x61, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x2e, 0x42, 0x6f, 0x78, 0x41, 0x4f, 0x49, 0x48, 0x01, 0x52, 0x06, 0x62, 0x6f,
-	0x78, 0x41, 0x4f, 0x49, 0x88, 0x01, 0x01, 0x12, 0x4d, 0x0a, 0x09, 0x73, 0x70, 0x68, 0x65, 0x72,
-	0x65, 0x41, 0x4f, 0x49, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x63, 0x68, 0x61,
-	0x6e, 0x6e, 0x65, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x53, 0x70, 0x61, 0x74, 0x69, 0x61, 0x6c, 0x49,
-	0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x53, 0x70, 0x68,
-	0x65, 0x72, 0x65, 0x41, 0x4f, 0x49, 0x48, 0x02, 0x52, 0x09, 0x73, 0x70, 0x68, 0x65, 0x72, 0x65,
-	0x41, 0x4f, 0x49, 0x88, 0x01, 0x01, 0x12, 0x47, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x65, 0x41, 0x4f,
-	0x49, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
-	0x6c, 0x64, 0x70, 0x62, 0x2e, 0x53, 0x70, 0x61, 0x74, 0x69, 0x61, 0x6c, 0x49, 0x6e, 0x74, 0x65,
-	0x72, 0x65, 0x73, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x43, 0x6f, 0x6e, 0x65, 0x41, 0x4f,
-	0x49, 0x48, 0x03, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x65, 0x41, 0x4f, 0x49, 0x88, 0x01, 0x01, 0x1a,
-	0x4f, 0x0a, 0x08, 0x53, 0x70, 0x6f, 0x74, 0x73, 0x41, 0x4f, 0x49, 0x12, 0x2d, 0x0a, 0x05, 0x73,
-	0x70, 0x6f, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x68, 0x61,
-	0x6e, 0x6e, 0x65, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x53, 0x70, 0x61, 0x74, 0x69, 0x61, 0x6c, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x05, 0x73, 0x70, 0x6f, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x69,
-	0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x05, 0x64, 0x69, 0x73, 0x74, 0x73,
-	0x1a, 0x6a, 0x0a, 0x06, 0x42, 0x6f, 0x78, 0x41, 0x4f, 0x49, 0x12, 0x2f, 0x0a, 0x06, 0x63, 0x65,
-	0x6e, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x68, 0x61,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0b, 0x63, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17,
+	0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x6e, 0x49, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x6e,
+	0x49, 0x64, 0x12, 0x58, 0x0a, 0x0b, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x64, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x0b, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x73, 0x12, 0x4a, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x34, 0x2e, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x0f,
+	0x64, 0x61, 0x74, 0x61, 0x4d, 0x73, 0x67, 0x54, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x61, 0x74, 0x61, 0x4d, 0x73, 0x67, 0x54, 0x79,
+	0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x53, 0x69,
+	0x7a, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x53, 0x69,
+	0x7a, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x49, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x70, 0x61, 0x72,
+	0x65, 0x6e, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x57, 0x0a, 0x0a,
+	0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x37, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x47, 0x65,
+	0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x73, 0x1a, 0xbc, 0x01, 0x0a, 0x0e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x6e,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x6e, 0x49, 0x64,
+	0x12, 0x36, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x64, 0x70, 0x62, 0x2e,
+	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08,
+	0x63, 0x6f, 0x6e, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x40, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
+	0x62, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x73, 0x75, 0x62,
+	0x54, 0x69, 0x6d, 0x65, 0x1a, 0x60, 0x0a, 0x0f, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x37, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x41, 0x74, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x30, 0x0a, 0x0c, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x4e, 0x49, 0x54, 0x10, 0x00,
+	0x12, 0x08, 0x0a, 0x04, 0x4f, 0x50, 0x45, 0x4e, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x48, 0x41,
+	0x4e, 0x44, 0x4f, 0x56, 0x45, 0x52, 0x10, 0x02, 0x22, 0x91, 0x01, 0x0a, 0x16, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x31, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x64, 0x70,
+	0x62, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x12, 0x1e, 0x0a, 0x0a,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x4d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x4d, 0x73, 0x22, 0x64, 0x0a, 0x1c,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1e, 0x0a, 0x0a,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0a, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0d,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0d, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x54, 0x69, 0x6d, 0x65,
+	0x4d, 0x73, 0x22, 0x38, 0x0a, 0x16, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1e, 0x0a, 0x0a,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0a, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x22, 0xdb, 0x01, 0x0a,
+	0x1d, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x4a,
+	0x0a, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x30, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x73, 0x1a, 0x50, 0x0a, 0x06, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64,
+	0x12, 0x28, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x41, 0x6e, 0x79, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x9a, 0x01, 0x0a, 0x23, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x2d, 0x0a, 0x0f,
+	0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x0f, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x22, 0x7d, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0d, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x73,
+	0x12, 0x26, 0x0a, 0x0e, 0x64, 0x61, 0x74, 0x61, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73,
+	0x6b, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x64, 0x61, 0x74, 0x61, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x4d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x74, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x4d, 0x73, 0x22, 0xe5, 0x01, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x53, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x64, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x44,
+	0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x1a, 0x71, 0x0a, 0x11, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x12, 0x1c, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x28,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41,
+	0x6e, 0x79, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xa9,
+	0x02, 0x0a, 0x15, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x6e,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x6e, 0x49, 0x64,
+	0x12, 0x3e, 0x0a, 0x04, 0x73, 0x75, 0x62, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a,
+	0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x2e, 0x53, 0x75, 0x62, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x73, 0x75, 0x62, 0x73,
+	0x12, 0x28, 0x0a, 0x0f, 0x75, 0x6e, 0x73, 0x75, 0x62, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x49, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x0f, 0x75, 0x6e, 0x73, 0x75, 0x62,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x73, 0x1a, 0x70, 0x0a, 0x08, 0x53, 0x75, 0x62, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x49, 0x64, 0x12, 0x46, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x64, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0a,
+	0x73, 0x75, 0x62, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xf8, 0x03, 0x0a, 0x1b, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f,
+	0x6e, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x6e,
+	0x49, 0x64, 0x12, 0x55, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x64, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x0a, 0x73,
+	0x75, 0x62, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x59, 0x0a, 0x0c, 0x75, 0x6e, 0x73,
+	0x75, 0x62, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x35, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x0c, 0x75, 0x6e, 0x73, 0x75, 0x62, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x73, 0x1a, 0x8e, 0x02, 0x0a, 0x0d, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x54,
+	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x63, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x46, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x64, 0x70, 0x62,
+	0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0a, 0x73, 0x75, 0x62,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x46, 0x0a,
+	0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x28, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x43, 0x6f, 0x64, 0x65, 0x22, 0xb9, 0x02, 0x0a, 0x14, 0x41, 0x75, 0x74, 0x6f, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x37,
+	0x0a, 0x0a, 0x74, 0x79, 0x70, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x17, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x64, 0x70, 0x62, 0x2e,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x74, 0x79, 0x70,
+	0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x28, 0x0a, 0x0f, 0x6d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x73, 0x12, 0x4c, 0x0a, 0x0f, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x41,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x0f,
+	0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12,
+	0x46, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x64, 0x70, 0x62,
+	0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0a, 0x73, 0x75, 0x62,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75,
+	0x64, 0x65, 0x45, 0x78, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x45, 0x78, 0x69, 0x73, 0x74, 0x69, 0x6e,
+	0x67, 0x22, 0x34, 0x0a, 0x1a, 0x41, 0x75, 0x74, 0x6f, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x06, 0x72, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x22, 0x34, 0x0a, 0x1a, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x41, 0x75, 0x74, 0x6f, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x72, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x22, 0x2b, 0x0a,
+	0x11, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x6e, 0x49, 0x64, 0x22, 0x82, 0x02, 0x0a, 0x12, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x73, 0x67, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x07, 0x6d, 0x73, 0x67, 0x54, 0x79, 0x70, 0x65, 0x12, 0x46, 0x0a, 0x09, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x28,
+	0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43,
+	0x6f, 0x64, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x66, 0x0a, 0x09, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x11, 0x0a, 0x0d, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f,
+	0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x4f, 0x5f, 0x41, 0x43,
+	0x43, 0x45, 0x53, 0x53, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45,
+	0x4c, 0x5f, 0x46, 0x55, 0x4c, 0x4c, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x49, 0x4e, 0x56, 0x41,
+	0x4c, 0x49, 0x44, 0x5f, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x10, 0x03, 0x12, 0x10, 0x0a,
+	0x0c, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x42, 0x55, 0x53, 0x59, 0x10, 0x04, 0x22,
+	0x37, 0x0a, 0x0b, 0x53, 0x70, 0x61, 0x74, 0x69, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0c,
+	0x0a, 0x01, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x01, 0x79, 0x12, 0x0c, 0x0a, 0x01, 0x7a, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x01, 0x7a, 0x22, 0x8e, 0x01, 0x0a, 0x22, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x53, 0x70, 0x61, 0x74, 0x69, 0x61, 0x6c, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x2a, 0x0a, 0x10, 0x73, 0x70, 0x61, 0x74, 0x69, 0x61, 0x6c, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x49, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x10, 0x73, 0x70, 0x61, 0x74, 0x69,
+	0x61, 0x6c, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x77, 0x6e, 0x65, 0x72,
+	0x43, 0x6f, 0x6e, 0x6e, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6f, 0x77,
+	0x6e, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x6e, 0x49, 0x64, 0x22, 0x57, 0x0a, 0x1a, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x53, 0x70, 0x61, 0x74, 0x69, 0x61, 0x6c, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x39, 0x0a, 0x0b, 0x73, 0x70, 0x61, 0x74, 0x69,
+	0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x53, 0x70, 0x61, 0x74, 0x69, 0x61,
+	0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x73, 0x70, 0x61, 0x74, 0x69, 0x61, 0x6c, 0x49, 0x6e,
+	0x66, 0x6f, 0x22, 0x40, 0x0a, 0x20, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x70, 0x61, 0x74, 0x69,
+	0x61, 0x6c, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x49, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x49, 0x64, 0x22, 0xb4, 0x01, 0x0a, 0x1a, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x44, 0x61, 0x74, 0x61, 0x48, 0x61, 0x6e, 0x64, 0x6f, 0x76, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x72, 0x63, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x73, 0x72, 0x63, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x64, 0x73, 0x74, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x64,
+	0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x49,
+	0x64, 0x12, 0x28, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xa5, 0x01, 0x0a, 0x0d,
+	0x53, 0x70, 0x61, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a,
+	0x03, 0x6d, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x68, 0x61,
 	0x6e, 0x6e, 0x65, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x53, 0x70, 0x61, 0x74, 0x69, 0x61, 0x6c, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x06, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x2f, 0x0a, 0x06, 0x65,
-	0x78, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x68,
-	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x53, 0x70, 0x61, 0x74, 0x69, 0x61, 0x6c,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x74, 0x1a, 0x54, 0x0a, 0x09,
-	0x53, 0x70, 0x68, 0x65, 0x72, 0x65, 0x41, 0x4f, 0x49, 0x12, 0x2f, 0x0a, 0x06, 0x63, 0x65, 0x6e,
+	0x6e, 0x66, 0x6f, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12, 0x29, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x64,
+	0x70, 0x62, 0x2e, 0x53, 0x70, 0x61, 0x74, 0x69, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x03,
+	0x6d, 0x61, 0x78, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49,
+	0x64, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x22, 0x52, 0x0a, 0x1b, 0x53, 0x70, 0x61, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65,
+	0x67, 0x69, 0x6f, 0x6e, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x64, 0x70, 0x62,
+	0x2e, 0x53, 0x70, 0x61, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x52, 0x07,
+	0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xa7, 0x06, 0x0a, 0x14, 0x53, 0x70, 0x61, 0x74,
+	0x69, 0x61, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x12, 0x4a, 0x0a, 0x08, 0x73, 0x70, 0x6f, 0x74, 0x73, 0x41, 0x4f, 0x49, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x29, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x64, 0x70, 0x62, 0x2e,
+	0x53, 0x70, 0x61, 0x74, 0x69, 0x61, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x2e, 0x53, 0x70, 0x6f, 0x74, 0x73, 0x41, 0x4f, 0x49, 0x48, 0x00, 0x52,
+	0x08, 0x73, 0x70, 0x6f, 0x74, 0x73, 0x41, 0x4f, 0x49, 0x88, 0x01, 0x01, 0x12, 0x44, 0x0a, 0x06,
+	0x62, 0x6f, 0x78, 0x41, 0x4f, 0x49, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x53, 0x70, 0x61, 0x74, 0x69, 0x61,
+	0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x42,
+	0x6f, 0x78, 0x41, 0x4f, 0x49, 0x48, 0x01, 0x52, 0x06, 0x62, 0x6f, 0x78, 0x41, 0x4f, 0x49, 0x88,
+	0x01, 0x01, 0x12, 0x4d, 0x0a, 0x09, 0x73, 0x70, 0x68, 0x65, 0x72, 0x65, 0x41, 0x4f, 0x49, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x64,
+	0x70, 0x62, 0x2e, 0x53, 0x70, 0x61, 0x74, 0x69, 0x61, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65,
+	0x73, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x53, 0x70, 0x68, 0x65, 0x72, 0x65, 0x41, 0x4f,
+	0x49, 0x48, 0x02, 0x52, 0x09, 0x73, 0x70, 0x68, 0x65, 0x72, 0x65, 0x41, 0x4f, 0x49, 0x88, 0x01,
+	0x01, 0x12, 0x47, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x65, 0x41, 0x4f, 0x49, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x28, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x64, 0x70, 0x62, 0x2e,
+	0x53, 0x70, 0x61, 0x74, 0x69, 0x61, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x2e, 0x43, 0x6f, 0x6e, 0x65, 0x41, 0x4f, 0x49, 0x48, 0x03, 0x52, 0x07,
+	0x63, 0x6f, 0x6e, 0x65, 0x41, 0x4f, 0x49, 0x88, 0x01, 0x01, 0x1a, 0x4f, 0x0a, 0x08, 0x53, 0x70,
+	0x6f, 0x74, 0x73, 0x41, 0x4f, 0x49, 0x12, 0x2d, 0x0a, 0x05, 0x73, 0x70, 0x6f, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x64,
+	0x70, 0x62, 0x2e, 0x53, 0x70, 0x61, 0x74, 0x69, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05,
+	0x73, 0x70, 0x6f, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x69, 0x73, 0x74, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0d, 0x52, 0x05, 0x64, 0x69, 0x73, 0x74, 0x73, 0x1a, 0x6a, 0x0a, 0x06, 0x42,
+	0x6f, 0x78, 0x41, 0x4f, 0x49, 0x12, 0x2f, 0x0a, 0x06, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x64,
+	0x70, 0x62, 0x2e, 0x53, 0x70, 0x61, 0x74, 0x69, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06,
+	0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x2f, 0x0a, 0x06, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x64, 0x70, 0x62, 0x2e, 0x53, 0x70, 0x61, 0x74, 0x69, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x06, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x74, 0x1a, 0x54, 0x0a, 0x09, 0x53, 0x70, 0x68, 0x65, 0x72,
+	0x65, 0x41, 0x4f, 0x49, 0x12, 0x2f, 0x0a, 0x06, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x64, 0x70,
+	0x62, 0x2e, 0x53, 0x70, 0x61, 0x74, 0x69, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x63,
+	0x65, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x1a, 0x9f, 0x01,
+	0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x65, 0x41, 0x4f, 0x49, 0x12, 0x2f, 0x0a, 0x06, 0x63, 0x65, 0x6e,
 	0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x68, 0x61, 0x6e,
 	0x6e, 0x65, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x53, 0x70, 0x61, 0x74, 0x69, 0x61, 0x6c, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x06, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61,
-	0x64, 0x69, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x72, 0x61, 0x64, 0x69,
-	0x75, 0x73, 0x1a, 0x9f, 0x01, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x65, 0x41, 0x4f, 0x49, 0x12, 0x2f,
-	0x0a, 0x06, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
-	0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x53, 0x70, 0x61, 0x74,
-	0x69, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x12,
-	0x35, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x64, 0x70, 0x62, 0x2e,
-	0x53, 0x70, 0x61, 0x74, 0x69, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x64, 0x69, 0x72,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6e, 0x67, 0x6c, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x61, 0x6e, 0x67, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06,
-	0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x72, 0x61,
-	0x64, 0x69, 0x75, 0x73, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x73, 0x70, 0x6f, 0x74, 0x73, 0x41, 0x4f,
-	0x49, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x62, 0x6f, 0x78, 0x41, 0x4f, 0x49, 0x42, 0x0c, 0x0a, 0x0a,
-	0x5f, 0x73, 0x70, 0x68, 0x65, 0x72, 0x65, 0x41, 0x4f, 0x49, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x63,
-	0x6f, 0x6e, 0x65, 0x41, 0x4f, 0x49, 0x22, 0x6e, 0x0a, 0x1c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x53, 0x70, 0x61, 0x74, 0x69, 0x61, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x6e, 0x49, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x6e, 0x49, 0x64, 0x12, 0x36,
-	0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x66, 0x6f, 0x52, 0x06, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x35, 0x0a, 0x09, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
 	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x53, 0x70, 0x61, 0x74, 0x69,
-	0x61, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52,
-	0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x22, 0xb1, 0x02, 0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49,
-	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x46, 0x0a,
-	0x0a, 0x73, 0x75, 0x62, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x26, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x43,
-	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x4f, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x28, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12,
-	0x47, 0x0a, 0x0c, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x64,
-	0x70, 0x62, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x65,
-	0x72, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0c, 0x6d, 0x65, 0x72, 0x67,
-	0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x73, 0x57, 0x65,
-	0x6c, 0x6c, 0x4b, 0x6e, 0x6f, 0x77, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69,
-	0x73, 0x57, 0x65, 0x6c, 0x6c, 0x4b, 0x6e, 0x6f, 0x77, 0x6e, 0x22, 0x6e, 0x0a, 0x15, 0x41, 0x64,
-	0x64, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x12, 0x2f, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x1b, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x45,
-	0x6e, 0x74, 0x69, 0x74, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04,
-	0x74, 0x79, 0x70, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73,
-	0x54, 0x6f, 0x41, 0x64, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x0d, 0x45, 0x6e, 0x74,
-	0x69, 0x74, 0x69, 0x65, 0x73, 0x54, 0x6f, 0x41, 0x64, 0x64, 0x22, 0x77, 0x0a, 0x18, 0x52, 0x65,
-	0x6d, 0x6f, 0x76, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2f, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x64, 0x70,
-	0x62, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x79, 0x70,
-	0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x45, 0x6e, 0x74, 0x69, 0x74,
-	0x69, 0x65, 0x73, 0x54, 0x6f, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x0d, 0x52, 0x10, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x54, 0x6f, 0x52, 0x65, 0x6d,
-	0x6f, 0x76, 0x65, 0x22, 0x1f, 0x0a, 0x1d, 0x44, 0x65, 0x62, 0x75, 0x67, 0x47, 0x65, 0x74, 0x53,
-	0x70, 0x61, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x73, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x2a, 0xa7, 0x01, 0x0a, 0x0d, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61,
+	0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6e, 0x67, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x05, 0x61, 0x6e, 0x67, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x64, 0x69, 0x75,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x42,
+	0x0b, 0x0a, 0x09, 0x5f, 0x73, 0x70, 0x6f, 0x74, 0x73, 0x41, 0x4f, 0x49, 0x42, 0x09, 0x0a, 0x07,
+	0x5f, 0x62, 0x6f, 0x78, 0x41, 0x4f, 0x49, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x73, 0x70, 0x68, 0x65,
+	0x72, 0x65, 0x41, 0x4f, 0x49, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x63, 0x6f, 0x6e, 0x65, 0x41, 0x4f,
+	0x49, 0x22, 0x6e, 0x0a, 0x1c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x70, 0x61, 0x74, 0x69,
+	0x61, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x6e, 0x49, 0x64, 0x12, 0x36, 0x0a, 0x05, 0x71, 0x75, 0x65,
+	0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x53, 0x70, 0x61, 0x74, 0x69, 0x61, 0x6c, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x65, 0x73, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72,
+	0x79, 0x22, 0xb1, 0x02, 0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x46, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x28, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x41, 0x6e, 0x79, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x47, 0x0a, 0x0c, 0x6d, 0x65,
+	0x72, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x23, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0c, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x73, 0x57, 0x65, 0x6c, 0x6c, 0x4b, 0x6e, 0x6f,
+	0x77, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x57, 0x65, 0x6c, 0x6c,
+	0x4b, 0x6e, 0x6f, 0x77, 0x6e, 0x22, 0x6e, 0x0a, 0x15, 0x41, 0x64, 0x64, 0x45, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2f,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x24, 0x0a, 0x0d, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x54, 0x6f, 0x41, 0x64, 0x64,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x0d, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73,
+	0x54, 0x6f, 0x41, 0x64, 0x64, 0x22, 0x77, 0x0a, 0x18, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x45,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x2f, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1b, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x45, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x54, 0x6f,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x10, 0x45, 0x6e,
+	0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x54, 0x6f, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x22, 0x1f,
+	0x0a, 0x1d, 0x44, 0x65, 0x62, 0x75, 0x67, 0x47, 0x65, 0x74, 0x53, 0x70, 0x61, 0x74, 0x69, 0x61,
+	0x6c, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x73, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22,
+	0x82, 0x01, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x24, 0x0a, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x6c, 0x61,
+	0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x47, 0x0a, 0x0d, 0x6c,
+	0x61, 0x73, 0x74, 0x53, 0x6f, 0x72, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x64, 0x70, 0x62, 0x2e,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x6f, 0x72, 0x74, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x2a, 0xa7, 0x01, 0x0a, 0x0d, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61,
 	0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x4e, 0x4f, 0x5f, 0x42, 0x52, 0x4f,
 	0x41, 0x44, 0x43, 0x41, 0x53, 0x54, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x49, 0x4e, 0x47,
 	0x4c, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x01, 0x12,
@@ -3132,8 +6478,8 @@ var file_channeld_proto_rawDesc = []byte{
 	0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x10, 0x05, 0x12, 0x08, 0x0a, 0x04, 0x54, 0x45, 0x53, 0x54,
 	0x10, 0x64, 0x12, 0x09, 0x0a, 0x05, 0x54, 0x45, 0x53, 0x54, 0x31, 0x10, 0x65, 0x12, 0x09, 0x0a,
 	0x05, 0x54, 0x45, 0x53, 0x54, 0x32, 0x10, 0x66, 0x12, 0x09, 0x0a, 0x05, 0x54, 0x45, 0x53, 0x54,
-	0x33, 0x10, 0x67, 0x12, 0x09, 0x0a, 0x05, 0x54, 0x45, 0x53, 0x54, 0x34, 0x10, 0x68, 0x2a, 0xbd,
-	0x03, 0x0a, 0x0b, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b,
+	0x33, 0x10, 0x67, 0x12, 0x09, 0x0a, 0x05, 0x54, 0x45, 0x53, 0x54, 0x34, 0x10, 0x68, 0x2a, 0x9e,
+	0x06, 0x0a, 0x0b, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b,
 	0x0a, 0x07, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x41,
 	0x55, 0x54, 0x48, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x5f,
 	0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x10, 0x03, 0x12, 0x12, 0x0a, 0x0e, 0x52, 0x45, 0x4d,
@@ -3157,24 +6503,46 @@ var file_channeld_proto_rawDesc = []byte{
 	0x5f, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x10, 0x0f, 0x12, 0x14, 0x0a, 0x10, 0x45, 0x4e,
 	0x54, 0x49, 0x54, 0x59, 0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x41, 0x44, 0x44, 0x10, 0x10,
 	0x12, 0x17, 0x0a, 0x13, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50,
-	0x5f, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x10, 0x11, 0x12, 0x1d, 0x0a, 0x19, 0x44, 0x45, 0x42,
-	0x55, 0x47, 0x5f, 0x47, 0x45, 0x54, 0x5f, 0x53, 0x50, 0x41, 0x54, 0x49, 0x41, 0x4c, 0x5f, 0x52,
-	0x45, 0x47, 0x49, 0x4f, 0x4e, 0x53, 0x10, 0x63, 0x12, 0x14, 0x0a, 0x10, 0x55, 0x53, 0x45, 0x52,
-	0x5f, 0x53, 0x50, 0x41, 0x43, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x10, 0x64, 0x2a, 0x31,
-	0x0a, 0x0f, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x12, 0x0a, 0x0e, 0x4e, 0x4f, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x52, 0x45, 0x53, 0x53,
-	0x49, 0x4f, 0x4e, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x4e, 0x41, 0x50, 0x50, 0x59, 0x10,
-	0x01, 0x2a, 0x45, 0x0a, 0x11, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x44, 0x61, 0x74, 0x61,
-	0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x4f, 0x5f, 0x41, 0x43, 0x43,
-	0x45, 0x53, 0x53, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x45, 0x41, 0x44, 0x5f, 0x41, 0x43,
-	0x43, 0x45, 0x53, 0x53, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x57, 0x52, 0x49, 0x54, 0x45, 0x5f,
-	0x41, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x02, 0x2a, 0x29, 0x0a, 0x0f, 0x45, 0x6e, 0x74, 0x69,
-	0x74, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0c, 0x0a, 0x08, 0x48,
-	0x41, 0x4e, 0x44, 0x4f, 0x56, 0x45, 0x52, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x4c, 0x4f, 0x43,
-	0x4b, 0x10, 0x01, 0x42, 0x30, 0x5a, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x6d, 0x65, 0x74, 0x61, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2f, 0x63, 0x68,
-	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x64, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x63, 0x68, 0x61, 0x6e, 0x6e,
-	0x65, 0x6c, 0x64, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x5f, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x10, 0x11, 0x12, 0x10, 0x0a, 0x0c, 0x45, 0x52, 0x52,
+	0x4f, 0x52, 0x5f, 0x52, 0x45, 0x53, 0x55, 0x4c, 0x54, 0x10, 0x12, 0x12, 0x15, 0x0a, 0x11, 0x52,
+	0x45, 0x53, 0x45, 0x52, 0x56, 0x45, 0x5f, 0x53, 0x55, 0x42, 0x5f, 0x53, 0x4c, 0x4f, 0x54, 0x53,
+	0x10, 0x13, 0x12, 0x1d, 0x0a, 0x19, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x43, 0x48, 0x41,
+	0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x41, 0x54, 0x54, 0x52, 0x49, 0x42, 0x55, 0x54, 0x45, 0x53, 0x10,
+	0x14, 0x12, 0x14, 0x0a, 0x10, 0x47, 0x45, 0x54, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c,
+	0x5f, 0x49, 0x4e, 0x46, 0x4f, 0x10, 0x15, 0x12, 0x14, 0x0a, 0x10, 0x43, 0x48, 0x41, 0x4e, 0x4e,
+	0x45, 0x4c, 0x5f, 0x50, 0x52, 0x45, 0x53, 0x45, 0x4e, 0x43, 0x45, 0x10, 0x16, 0x12, 0x14, 0x0a,
+	0x10, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x5f, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47,
+	0x45, 0x10, 0x17, 0x12, 0x14, 0x0a, 0x10, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x5f, 0x53, 0x43,
+	0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x44, 0x10, 0x18, 0x12, 0x1c, 0x0a, 0x18, 0x43, 0x48, 0x41,
+	0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41,
+	0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x19, 0x12, 0x14, 0x0a, 0x10, 0x43, 0x48, 0x41, 0x4e, 0x4e,
+	0x45, 0x4c, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x41, 0x43, 0x4b, 0x10, 0x1a, 0x12, 0x19, 0x0a,
+	0x15, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x43, 0x4f,
+	0x4e, 0x46, 0x4c, 0x49, 0x43, 0x54, 0x10, 0x1b, 0x12, 0x14, 0x0a, 0x10, 0x47, 0x45, 0x54, 0x5f,
+	0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x10, 0x1c, 0x12, 0x16,
+	0x0a, 0x12, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x55, 0x42, 0x5f, 0x4f, 0x50, 0x54,
+	0x49, 0x4f, 0x4e, 0x53, 0x10, 0x1d, 0x12, 0x13, 0x0a, 0x0f, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f,
+	0x53, 0x55, 0x42, 0x53, 0x43, 0x52, 0x49, 0x42, 0x45, 0x10, 0x1e, 0x12, 0x12, 0x0a, 0x0e, 0x41,
+	0x55, 0x54, 0x4f, 0x5f, 0x53, 0x55, 0x42, 0x53, 0x43, 0x52, 0x49, 0x42, 0x45, 0x10, 0x1f, 0x12,
+	0x19, 0x0a, 0x15, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x5f, 0x41, 0x55, 0x54, 0x4f, 0x5f, 0x53,
+	0x55, 0x42, 0x53, 0x43, 0x52, 0x49, 0x42, 0x45, 0x10, 0x20, 0x12, 0x1d, 0x0a, 0x19, 0x44, 0x45,
+	0x42, 0x55, 0x47, 0x5f, 0x47, 0x45, 0x54, 0x5f, 0x53, 0x50, 0x41, 0x54, 0x49, 0x41, 0x4c, 0x5f,
+	0x52, 0x45, 0x47, 0x49, 0x4f, 0x4e, 0x53, 0x10, 0x63, 0x12, 0x14, 0x0a, 0x10, 0x55, 0x53, 0x45,
+	0x52, 0x5f, 0x53, 0x50, 0x41, 0x43, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x10, 0x64, 0x2a,
+	0x31, 0x0a, 0x0f, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x12, 0x0a, 0x0e, 0x4e, 0x4f, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x52, 0x45, 0x53,
+	0x53, 0x49, 0x4f, 0x4e, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x4e, 0x41, 0x50, 0x50, 0x59,
+	0x10, 0x01, 0x2a, 0x45, 0x0a, 0x11, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x44, 0x61, 0x74,
+	0x61, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x4f, 0x5f, 0x41, 0x43,
+	0x43, 0x45, 0x53, 0x53, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x45, 0x41, 0x44, 0x5f, 0x41,
+	0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x57, 0x52, 0x49, 0x54, 0x45,
+	0x5f, 0x41, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x02, 0x2a, 0x29, 0x0a, 0x0f, 0x45, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0c, 0x0a, 0x08,
+	0x48, 0x41, 0x4e, 0x44, 0x4f, 0x56, 0x45, 0x52, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x4c, 0x4f,
+	0x43, 0x4b, 0x10, 0x01, 0x42, 0x30, 0x5a, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x6d, 0x65, 0x74, 0x61, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2f, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x64, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x63, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x64, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -3189,101 +6557,198 @@ func file_channeld_proto_rawDescGZIP() []byte {
 	return file_channeld_proto_rawDescData
 }
 
-var file_channeld_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
-var file_channeld_proto_msgTypes = make([]protoimpl.MessageInfo, 36)
+var file_channeld_proto_enumTypes = make([]protoimpl.EnumInfo, 13)
+var file_channeld_proto_msgTypes = make([]protoimpl.MessageInfo, 78)
 var file_channeld_proto_goTypes = []interface{}{
-	(BroadcastType)(0),                           // 0: channeldpb.BroadcastType
-	(ConnectionType)(0),                          // 1: channeldpb.ConnectionType
-	(ChannelType)(0),                             // 2: channeldpb.ChannelType
-	(MessageType)(0),                             // 3: channeldpb.MessageType
-	(CompressionType)(0),                         // 4: channeldpb.CompressionType
-	(ChannelDataAccess)(0),                       // 5: channeldpb.ChannelDataAccess
-	(EntityGroupType)(0),                         // 6: channeldpb.EntityGroupType
-	(AuthResultMessage_AuthResult)(0),            // 7: channeldpb.AuthResultMessage.AuthResult
-	(*Packet)(nil),                               // 8: channeldpb.Packet
-	(*MessagePack)(nil),                          // 9: channeldpb.MessagePack
-	(*ServerForwardMessage)(nil),                 // 10: channeldpb.ServerForwardMessage
-	(*AuthMessage)(nil),                          // 11: channeldpb.AuthMessage
-	(*AuthResultMessage)(nil),                    // 12: channeldpb.AuthResultMessage
-	(*ChannelSubscriptionOptions)(nil),           // 13: channeldpb.ChannelSubscriptionOptions
-	(*ChannelDataMergeOptions)(nil),              // 14: channeldpb.ChannelDataMergeOptions
-	(*CreateChannelMessage)(nil),                 // 15: channeldpb.CreateChannelMessage
-	(*CreateChannelResultMessage)(nil),           // 16: channeldpb.CreateChannelResultMessage
-	(*RemoveChannelMessage)(nil),                 // 17: channeldpb.RemoveChannelMessage
-	(*ListChannelMessage)(nil),                   // 18: channeldpb.ListChannelMessage
-	(*ListChannelResultMessage)(nil),             // 19: channeldpb.ListChannelResultMessage
-	(*SubscribedToChannelMessage)(nil),           // 20: channeldpb.SubscribedToChannelMessage
-	(*SubscribedToChannelResultMessage)(nil),     // 21: channeldpb.SubscribedToChannelResultMessage
-	(*UnsubscribedFromChannelMessage)(nil),       // 22: channeldpb.UnsubscribedFromChannelMessage
-	(*UnsubscribedFromChannelResultMessage)(nil), // 23: channeldpb.UnsubscribedFromChannelResultMessage
-	(*ChannelDataUpdateMessage)(nil),             // 24: channeldpb.ChannelDataUpdateMessage
-	(*DisconnectMessage)(nil),                    // 25: channeldpb.DisconnectMessage
-	(*SpatialInfo)(nil),                          // 26: channeldpb.SpatialInfo
-	(*CreateSpatialChannelsResultMessage)(nil),   // 27: channeldpb.CreateSpatialChannelsResultMessage
-	(*QuerySpatialChannelMessage)(nil),           // 28: channeldpb.QuerySpatialChannelMessage
-	(*QuerySpatialChannelResultMessage)(nil),     // 29: channeldpb.QuerySpatialChannelResultMessage
-	(*ChannelDataHandoverMessage)(nil),           // 30: channeldpb.ChannelDataHandoverMessage
-	(*SpatialRegion)(nil),                        // 31: channeldpb.SpatialRegion
-	(*SpatialRegionsUpdateMessage)(nil),          // 32: channeldpb.SpatialRegionsUpdateMessage
-	(*SpatialInterestQuery)(nil),                 // 33: channeldpb.SpatialInterestQuery
-	(*UpdateSpatialInterestMessage)(nil),         // 34: channeldpb.UpdateSpatialInterestMessage
-	(*CreateEntityChannelMessage)(nil),           // 35: channeldpb.CreateEntityChannelMessage
-	(*AddEntityGroupMessage)(nil),                // 36: channeldpb.AddEntityGroupMessage
-	(*RemoveEntityGroupMessage)(nil),             // 37: channeldpb.RemoveEntityGroupMessage
-	(*DebugGetSpatialRegionsMessage)(nil),        // 38: channeldpb.DebugGetSpatialRegionsMessage
-	(*ListChannelResultMessage_ChannelInfo)(nil), // 39: channeldpb.ListChannelResultMessage.ChannelInfo
-	(*SpatialInterestQuery_SpotsAOI)(nil),        // 40: channeldpb.SpatialInterestQuery.SpotsAOI
-	(*SpatialInterestQuery_BoxAOI)(nil),          // 41: channeldpb.SpatialInterestQuery.BoxAOI
-	(*SpatialInterestQuery_SphereAOI)(nil),       // 42: channeldpb.SpatialInterestQuery.SphereAOI
-	(*SpatialInterestQuery_ConeAOI)(nil),         // 43: channeldpb.SpatialInterestQuery.ConeAOI
-	(*anypb.Any)(nil),                            // 44: google.protobuf.Any
+	(BroadcastType)(0),                            // 0: channeldpb.BroadcastType
+	(ConnectionType)(0),                           // 1: channeldpb.ConnectionType
+	(ChannelType)(0),                              // 2: channeldpb.ChannelType
+	(MessageType)(0),                              // 3: channeldpb.MessageType
+	(CompressionType)(0),                          // 4: channeldpb.CompressionType
+	(ChannelDataAccess)(0),                        // 5: channeldpb.ChannelDataAccess
+	(EntityGroupType)(0),                          // 6: channeldpb.EntityGroupType
+	(AuthResultMessage_AuthResult)(0),             // 7: channeldpb.AuthResultMessage.AuthResult
+	(ChannelDataMergeOptions_ConflictPolicy)(0),   // 8: channeldpb.ChannelDataMergeOptions.ConflictPolicy
+	(ChannelAttributeFilter_Operator)(0),          // 9: channeldpb.ChannelAttributeFilter.Operator
+	(ChannelPresenceMessage_EventType)(0),         // 10: channeldpb.ChannelPresenceMessage.EventType
+	(GetChannelInfoResultMessage_ChannelState)(0), // 11: channeldpb.GetChannelInfoResultMessage.ChannelState
+	(ErrorResultMessage_ErrorCode)(0),             // 12: channeldpb.ErrorResultMessage.ErrorCode
+	(*Packet)(nil),                                // 13: channeldpb.Packet
+	(*MessagePack)(nil),                           // 14: channeldpb.MessagePack
+	(*ServerForwardMessage)(nil),                  // 15: channeldpb.ServerForwardMessage
+	(*AuthMessage)(nil),                           // 16: channeldpb.AuthMessage
+	(*AuthResultMessage)(nil),                     // 17: channeldpb.AuthResultMessage
+	(*ChannelSubscriptionOptions)(nil),            // 18: channeldpb.ChannelSubscriptionOptions
+	(*FanOutTier)(nil),                            // 19: channeldpb.FanOutTier
+	(*ChannelDataMergeOptions)(nil),               // 20: channeldpb.ChannelDataMergeOptions
+	(*CreateChannelMessage)(nil),                  // 21: channeldpb.CreateChannelMessage
+	(*ChannelAttributeValue)(nil),                 // 22: channeldpb.ChannelAttributeValue
+	(*ChannelAttributeFilter)(nil),                // 23: channeldpb.ChannelAttributeFilter
+	(*ChannelSubscriberLimit)(nil),                // 24: channeldpb.ChannelSubscriberLimit
+	(*CreateChannelResultMessage)(nil),            // 25: channeldpb.CreateChannelResultMessage
+	(*RemoveChannelMessage)(nil),                  // 26: channeldpb.RemoveChannelMessage
+	(*ListChannelMessage)(nil),                    // 27: channeldpb.ListChannelMessage
+	(*ListChannelResultMessage)(nil),              // 28: channeldpb.ListChannelResultMessage
+	(*SubscribedToChannelMessage)(nil),            // 29: channeldpb.SubscribedToChannelMessage
+	(*SubscribedToChannelResultMessage)(nil),      // 30: channeldpb.SubscribedToChannelResultMessage
+	(*UpdateSubOptionsMessage)(nil),               // 31: channeldpb.UpdateSubOptionsMessage
+	(*UpdateSubOptionsResultMessage)(nil),         // 32: channeldpb.UpdateSubOptionsResultMessage
+	(*ChannelPresenceMessage)(nil),                // 33: channeldpb.ChannelPresenceMessage
+	(*UnsubscribedFromChannelMessage)(nil),        // 34: channeldpb.UnsubscribedFromChannelMessage
+	(*UnsubscribedFromChannelResultMessage)(nil),  // 35: channeldpb.UnsubscribedFromChannelResultMessage
+	(*ChannelDataUpdateMessage)(nil),              // 36: channeldpb.ChannelDataUpdateMessage
+	(*ChannelDataConflictMessage)(nil),            // 37: channeldpb.ChannelDataConflictMessage
+	(*ChannelDataAckMessage)(nil),                 // 38: channeldpb.ChannelDataAckMessage
+	(*ReserveSubscriberSlotsMessage)(nil),         // 39: channeldpb.ReserveSubscriberSlotsMessage
+	(*UpdateChannelAttributesMessage)(nil),        // 40: channeldpb.UpdateChannelAttributesMessage
+	(*UpdateChannelAttributesResultMessage)(nil),  // 41: channeldpb.UpdateChannelAttributesResultMessage
+	(*GetChannelInfoMessage)(nil),                 // 42: channeldpb.GetChannelInfoMessage
+	(*GetChannelInfoResultMessage)(nil),           // 43: channeldpb.GetChannelInfoResultMessage
+	(*ScheduleMessageMessage)(nil),                // 44: channeldpb.ScheduleMessageMessage
+	(*ScheduleMessageResultMessage)(nil),          // 45: channeldpb.ScheduleMessageResultMessage
+	(*CancelScheduledMessage)(nil),                // 46: channeldpb.CancelScheduledMessage
+	(*ChannelDataTransactionMessage)(nil),         // 47: channeldpb.ChannelDataTransactionMessage
+	(*ChannelDataTransactionResultMessage)(nil),   // 48: channeldpb.ChannelDataTransactionResultMessage
+	(*GetChannelDataMessage)(nil),                 // 49: channeldpb.GetChannelDataMessage
+	(*GetChannelDataResultMessage)(nil),           // 50: channeldpb.GetChannelDataResultMessage
+	(*BatchSubscribeMessage)(nil),                 // 51: channeldpb.BatchSubscribeMessage
+	(*BatchSubscribeResultMessage)(nil),           // 52: channeldpb.BatchSubscribeResultMessage
+	(*AutoSubscribeMessage)(nil),                  // 53: channeldpb.AutoSubscribeMessage
+	(*AutoSubscribeResultMessage)(nil),            // 54: channeldpb.AutoSubscribeResultMessage
+	(*RemoveAutoSubscribeMessage)(nil),            // 55: channeldpb.RemoveAutoSubscribeMessage
+	(*DisconnectMessage)(nil),                     // 56: channeldpb.DisconnectMessage
+	(*ErrorResultMessage)(nil),                    // 57: channeldpb.ErrorResultMessage
+	(*SpatialInfo)(nil),                           // 58: channeldpb.SpatialInfo
+	(*CreateSpatialChannelsResultMessage)(nil),    // 59: channeldpb.CreateSpatialChannelsResultMessage
+	(*QuerySpatialChannelMessage)(nil),            // 60: channeldpb.QuerySpatialChannelMessage
+	(*QuerySpatialChannelResultMessage)(nil),      // 61: channeldpb.QuerySpatialChannelResultMessage
+	(*ChannelDataHandoverMessage)(nil),            // 62: channeldpb.ChannelDataHandoverMessage
+	(*SpatialRegion)(nil),                         // 63: channeldpb.SpatialRegion
+	(*SpatialRegionsUpdateMessage)(nil),           // 64: channeldpb.SpatialRegionsUpdateMessage
+	(*SpatialInterestQuery)(nil),                  // 65: channeldpb.SpatialInterestQuery
+	(*UpdateSpatialInterestMessage)(nil),          // 66: channeldpb.UpdateSpatialInterestMessage
+	(*CreateEntityChannelMessage)(nil),            // 67: channeldpb.CreateEntityChannelMessage
+	(*AddEntityGroupMessage)(nil),                 // 68: channeldpb.AddEntityGroupMessage
+	(*RemoveEntityGroupMessage)(nil),              // 69: channeldpb.RemoveEntityGroupMessage
+	(*DebugGetSpatialRegionsMessage)(nil),         // 70: channeldpb.DebugGetSpatialRegionsMessage
+	(*ListChannelCursor)(nil),                     // 71: channeldpb.ListChannelCursor
+	nil,                                           // 72: channeldpb.ChannelDataMergeOptions.FieldOptionsEntry
+	nil,                                           // 73: channeldpb.CreateChannelMessage.AttributesEntry
+	(*ListChannelResultMessage_ChannelInfo)(nil),  // 74: channeldpb.ListChannelResultMessage.ChannelInfo
+	nil, // 75: channeldpb.ListChannelResultMessage.ChannelInfo.AttributesEntry
+	(*ChannelPresenceMessage_MemberInfo)(nil), // 76: channeldpb.ChannelPresenceMessage.MemberInfo
+	nil, // 77: channeldpb.ChannelDataUpdateMessage.FieldVersionsEntry
+	(*ChannelDataConflictMessage_FieldConflict)(nil), // 78: channeldpb.ChannelDataConflictMessage.FieldConflict
+	nil, // 79: channeldpb.UpdateChannelAttributesMessage.AttributesEntry
+	nil, // 80: channeldpb.UpdateChannelAttributesResultMessage.AttributesEntry
+	(*GetChannelInfoResultMessage_SubscriberInfo)(nil), // 81: channeldpb.GetChannelInfoResultMessage.SubscriberInfo
+	nil, // 82: channeldpb.GetChannelInfoResultMessage.AttributesEntry
+	(*ChannelDataTransactionMessage_Update)(nil),          // 83: channeldpb.ChannelDataTransactionMessage.Update
+	(*GetChannelDataResultMessage_ChannelDataResult)(nil), // 84: channeldpb.GetChannelDataResultMessage.ChannelDataResult
+	(*BatchSubscribeMessage_SubEntry)(nil),                // 85: channeldpb.BatchSubscribeMessage.SubEntry
+	(*BatchSubscribeResultMessage_ChannelResult)(nil),     // 86: channeldpb.BatchSubscribeResultMessage.ChannelResult
+	(*SpatialInterestQuery_SpotsAOI)(nil),                 // 87: channeldpb.SpatialInterestQuery.SpotsAOI
+	(*SpatialInterestQuery_BoxAOI)(nil),                   // 88: channeldpb.SpatialInterestQuery.BoxAOI
+	(*SpatialInterestQuery_SphereAOI)(nil),                // 89: channeldpb.SpatialInterestQuery.SphereAOI
+	(*SpatialInterestQuery_ConeAOI)(nil),                  // 90: channeldpb.SpatialInterestQuery.ConeAOI
+	(*anypb.Any)(nil),                                     // 91: google.protobuf.Any
+	(*FieldMergeOptions)(nil),                             // 92: channeldpb.FieldMergeOptions
 }
 var file_channeld_proto_depIdxs = []int32{
-	9,  // 0: channeldpb.Packet.messages:type_name -> channeldpb.MessagePack
+	14, // 0: channeldpb.Packet.messages:type_name -> channeldpb.MessagePack
 	7,  // 1: channeldpb.AuthResultMessage.result:type_name -> channeldpb.AuthResultMessage.AuthResult
 	4,  // 2: channeldpb.AuthResultMessage.compressionType:type_name -> channeldpb.CompressionType
 	5,  // 3: channeldpb.ChannelSubscriptionOptions.dataAccess:type_name -> channeldpb.ChannelDataAccess
-	2,  // 4: channeldpb.CreateChannelMessage.channelType:type_name -> channeldpb.ChannelType
-	13, // 5: channeldpb.CreateChannelMessage.subOptions:type_name -> channeldpb.ChannelSubscriptionOptions
-	44, // 6: channeldpb.CreateChannelMessage.data:type_name -> google.protobuf.Any
-	14, // 7: channeldpb.CreateChannelMessage.mergeOptions:type_name -> channeldpb.ChannelDataMergeOptions
-	2,  // 8: channeldpb.CreateChannelResultMessage.channelType:type_name -> channeldpb.ChannelType
-	2,  // 9: channeldpb.ListChannelMessage.typeFilter:type_name -> channeldpb.ChannelType
-	39, // 10: channeldpb.ListChannelResultMessage.channels:type_name -> channeldpb.ListChannelResultMessage.ChannelInfo
-	13, // 11: channeldpb.SubscribedToChannelMessage.subOptions:type_name -> channeldpb.ChannelSubscriptionOptions
-	13, // 12: channeldpb.SubscribedToChannelResultMessage.subOptions:type_name -> channeldpb.ChannelSubscriptionOptions
-	1,  // 13: channeldpb.SubscribedToChannelResultMessage.connType:type_name -> channeldpb.ConnectionType
-	2,  // 14: channeldpb.SubscribedToChannelResultMessage.channelType:type_name -> channeldpb.ChannelType
-	1,  // 15: channeldpb.UnsubscribedFromChannelResultMessage.connType:type_name -> channeldpb.ConnectionType
-	2,  // 16: channeldpb.UnsubscribedFromChannelResultMessage.channelType:type_name -> channeldpb.ChannelType
-	44, // 17: channeldpb.ChannelDataUpdateMessage.data:type_name -> google.protobuf.Any
-	26, // 18: channeldpb.QuerySpatialChannelMessage.spatialInfo:type_name -> channeldpb.SpatialInfo
-	44, // 19: channeldpb.ChannelDataHandoverMessage.data:type_name -> google.protobuf.Any
-	26, // 20: channeldpb.SpatialRegion.min:type_name -> channeldpb.SpatialInfo
-	26, // 21: channeldpb.SpatialRegion.max:type_name -> channeldpb.SpatialInfo
-	31, // 22: channeldpb.SpatialRegionsUpdateMessage.regions:type_name -> channeldpb.SpatialRegion
-	40, // 23: channeldpb.SpatialInterestQuery.spotsAOI:type_name -> channeldpb.SpatialInterestQuery.SpotsAOI
-	41, // 24: channeldpb.SpatialInterestQuery.boxAOI:type_name -> channeldpb.SpatialInterestQuery.BoxAOI
-	42, // 25: channeldpb.SpatialInterestQuery.sphereAOI:type_name -> channeldpb.SpatialInterestQuery.SphereAOI
-	43, // 26: channeldpb.SpatialInterestQuery.coneAOI:type_name -> channeldpb.SpatialInterestQuery.ConeAOI
-	33, // 27: channeldpb.UpdateSpatialInterestMessage.query:type_name -> channeldpb.SpatialInterestQuery
-	13, // 28: channeldpb.CreateEntityChannelMessage.subOptions:type_name -> channeldpb.ChannelSubscriptionOptions
-	44, // 29: channeldpb.CreateEntityChannelMessage.data:type_name -> google.protobuf.Any
-	14, // 30: channeldpb.CreateEntityChannelMessage.mergeOptions:type_name -> channeldpb.ChannelDataMergeOptions
-	6,  // 31: channeldpb.AddEntityGroupMessage.type:type_name -> channeldpb.EntityGroupType
-	6,  // 32: channeldpb.RemoveEntityGroupMessage.type:type_name -> channeldpb.EntityGroupType
-	2,  // 33: channeldpb.ListChannelResultMessage.ChannelInfo.channelType:type_name -> channeldpb.ChannelType
-	26, // 34: channeldpb.SpatialInterestQuery.SpotsAOI.spots:type_name -> channeldpb.SpatialInfo
-	26, // 35: channeldpb.SpatialInterestQuery.BoxAOI.center:type_name -> channeldpb.SpatialInfo
-	26, // 36: channeldpb.SpatialInterestQuery.BoxAOI.extent:type_name -> channeldpb.SpatialInfo
-	26, // 37: channeldpb.SpatialInterestQuery.SphereAOI.center:type_name -> channeldpb.SpatialInfo
-	26, // 38: channeldpb.SpatialInterestQuery.ConeAOI.center:type_name -> channeldpb.SpatialInfo
-	26, // 39: channeldpb.SpatialInterestQuery.ConeAOI.direction:type_name -> channeldpb.SpatialInfo
-	40, // [40:40] is the sub-list for method output_type
-	40, // [40:40] is the sub-list for method input_type
-	40, // [40:40] is the sub-list for extension type_name
-	40, // [40:40] is the sub-list for extension extendee
-	0,  // [0:40] is the sub-list for field type_name
+	19, // 4: channeldpb.ChannelSubscriptionOptions.fanOutTiers:type_name -> channeldpb.FanOutTier
+	8,  // 5: channeldpb.ChannelDataMergeOptions.conflictPolicy:type_name -> channeldpb.ChannelDataMergeOptions.ConflictPolicy
+	72, // 6: channeldpb.ChannelDataMergeOptions.fieldOptions:type_name -> channeldpb.ChannelDataMergeOptions.FieldOptionsEntry
+	2,  // 7: channeldpb.CreateChannelMessage.channelType:type_name -> channeldpb.ChannelType
+	18, // 8: channeldpb.CreateChannelMessage.subOptions:type_name -> channeldpb.ChannelSubscriptionOptions
+	91, // 9: channeldpb.CreateChannelMessage.data:type_name -> google.protobuf.Any
+	20, // 10: channeldpb.CreateChannelMessage.mergeOptions:type_name -> channeldpb.ChannelDataMergeOptions
+	24, // 11: channeldpb.CreateChannelMessage.maxSubscribers:type_name -> channeldpb.ChannelSubscriberLimit
+	73, // 12: channeldpb.CreateChannelMessage.attributes:type_name -> channeldpb.CreateChannelMessage.AttributesEntry
+	9,  // 13: channeldpb.ChannelAttributeFilter.op:type_name -> channeldpb.ChannelAttributeFilter.Operator
+	22, // 14: channeldpb.ChannelAttributeFilter.value:type_name -> channeldpb.ChannelAttributeValue
+	23, // 15: channeldpb.ChannelAttributeFilter.subFilters:type_name -> channeldpb.ChannelAttributeFilter
+	2,  // 16: channeldpb.CreateChannelResultMessage.channelType:type_name -> channeldpb.ChannelType
+	2,  // 17: channeldpb.ListChannelMessage.typeFilter:type_name -> channeldpb.ChannelType
+	23, // 18: channeldpb.ListChannelMessage.attributeFilter:type_name -> channeldpb.ChannelAttributeFilter
+	74, // 19: channeldpb.ListChannelResultMessage.channels:type_name -> channeldpb.ListChannelResultMessage.ChannelInfo
+	18, // 20: channeldpb.SubscribedToChannelMessage.subOptions:type_name -> channeldpb.ChannelSubscriptionOptions
+	18, // 21: channeldpb.SubscribedToChannelResultMessage.subOptions:type_name -> channeldpb.ChannelSubscriptionOptions
+	1,  // 22: channeldpb.SubscribedToChannelResultMessage.connType:type_name -> channeldpb.ConnectionType
+	2,  // 23: channeldpb.SubscribedToChannelResultMessage.channelType:type_name -> channeldpb.ChannelType
+	18, // 24: channeldpb.UpdateSubOptionsMessage.subOptions:type_name -> channeldpb.ChannelSubscriptionOptions
+	18, // 25: channeldpb.UpdateSubOptionsResultMessage.subOptions:type_name -> channeldpb.ChannelSubscriptionOptions
+	10, // 26: channeldpb.ChannelPresenceMessage.eventType:type_name -> channeldpb.ChannelPresenceMessage.EventType
+	76, // 27: channeldpb.ChannelPresenceMessage.members:type_name -> channeldpb.ChannelPresenceMessage.MemberInfo
+	1,  // 28: channeldpb.UnsubscribedFromChannelResultMessage.connType:type_name -> channeldpb.ConnectionType
+	2,  // 29: channeldpb.UnsubscribedFromChannelResultMessage.channelType:type_name -> channeldpb.ChannelType
+	91, // 30: channeldpb.ChannelDataUpdateMessage.data:type_name -> google.protobuf.Any
+	77, // 31: channeldpb.ChannelDataUpdateMessage.fieldVersions:type_name -> channeldpb.ChannelDataUpdateMessage.FieldVersionsEntry
+	8,  // 32: channeldpb.ChannelDataConflictMessage.policy:type_name -> channeldpb.ChannelDataMergeOptions.ConflictPolicy
+	78, // 33: channeldpb.ChannelDataConflictMessage.conflicts:type_name -> channeldpb.ChannelDataConflictMessage.FieldConflict
+	79, // 34: channeldpb.UpdateChannelAttributesMessage.attributes:type_name -> channeldpb.UpdateChannelAttributesMessage.AttributesEntry
+	80, // 35: channeldpb.UpdateChannelAttributesResultMessage.attributes:type_name -> channeldpb.UpdateChannelAttributesResultMessage.AttributesEntry
+	2,  // 36: channeldpb.GetChannelInfoResultMessage.channelType:type_name -> channeldpb.ChannelType
+	81, // 37: channeldpb.GetChannelInfoResultMessage.subscribers:type_name -> channeldpb.GetChannelInfoResultMessage.SubscriberInfo
+	11, // 38: channeldpb.GetChannelInfoResultMessage.state:type_name -> channeldpb.GetChannelInfoResultMessage.ChannelState
+	82, // 39: channeldpb.GetChannelInfoResultMessage.attributes:type_name -> channeldpb.GetChannelInfoResultMessage.AttributesEntry
+	14, // 40: channeldpb.ScheduleMessageMessage.message:type_name -> channeldpb.MessagePack
+	83, // 41: channeldpb.ChannelDataTransactionMessage.updates:type_name -> channeldpb.ChannelDataTransactionMessage.Update
+	84, // 42: channeldpb.GetChannelDataResultMessage.results:type_name -> channeldpb.GetChannelDataResultMessage.ChannelDataResult
+	85, // 43: channeldpb.BatchSubscribeMessage.subs:type_name -> channeldpb.BatchSubscribeMessage.SubEntry
+	86, // 44: channeldpb.BatchSubscribeResultMessage.subResults:type_name -> channeldpb.BatchSubscribeResultMessage.ChannelResult
+	86, // 45: channeldpb.BatchSubscribeResultMessage.unsubResults:type_name -> channeldpb.BatchSubscribeResultMessage.ChannelResult
+	2,  // 46: channeldpb.AutoSubscribeMessage.typeFilter:type_name -> channeldpb.ChannelType
+	23, // 47: channeldpb.AutoSubscribeMessage.attributeFilter:type_name -> channeldpb.ChannelAttributeFilter
+	18, // 48: channeldpb.AutoSubscribeMessage.subOptions:type_name -> channeldpb.ChannelSubscriptionOptions
+	12, // 49: channeldpb.ErrorResultMessage.errorCode:type_name -> channeldpb.ErrorResultMessage.ErrorCode
+	58, // 50: channeldpb.QuerySpatialChannelMessage.spatialInfo:type_name -> channeldpb.SpatialInfo
+	91, // 51: channeldpb.ChannelDataHandoverMessage.data:type_name -> google.protobuf.Any
+	58, // 52: channeldpb.SpatialRegion.min:type_name -> channeldpb.SpatialInfo
+	58, // 53: channeldpb.SpatialRegion.max:type_name -> channeldpb.SpatialInfo
+	63, // 54: channeldpb.SpatialRegionsUpdateMessage.regions:type_name -> channeldpb.SpatialRegion
+	87, // 55: channeldpb.SpatialInterestQuery.spotsAOI:type_name -> channeldpb.SpatialInterestQuery.SpotsAOI
+	88, // 56: channeldpb.SpatialInterestQuery.boxAOI:type_name -> channeldpb.SpatialInterestQuery.BoxAOI
+	89, // 57: channeldpb.SpatialInterestQuery.sphereAOI:type_name -> channeldpb.SpatialInterestQuery.SphereAOI
+	90, // 58: channeldpb.SpatialInterestQuery.coneAOI:type_name -> channeldpb.SpatialInterestQuery.ConeAOI
+	65, // 59: channeldpb.UpdateSpatialInterestMessage.query:type_name -> channeldpb.SpatialInterestQuery
+	18, // 60: channeldpb.CreateEntityChannelMessage.subOptions:type_name -> channeldpb.ChannelSubscriptionOptions
+	91, // 61: channeldpb.CreateEntityChannelMessage.data:type_name -> google.protobuf.Any
+	20, // 62: channeldpb.CreateEntityChannelMessage.mergeOptions:type_name -> channeldpb.ChannelDataMergeOptions
+	6,  // 63: channeldpb.AddEntityGroupMessage.type:type_name -> channeldpb.EntityGroupType
+	6,  // 64: channeldpb.RemoveEntityGroupMessage.type:type_name -> channeldpb.EntityGroupType
+	22, // 65: channeldpb.ListChannelCursor.lastSortValue:type_name -> channeldpb.ChannelAttributeValue
+	92, // 66: channeldpb.ChannelDataMergeOptions.FieldOptionsEntry.value:type_name -> channeldpb.FieldMergeOptions
+	22, // 67: channeldpb.CreateChannelMessage.AttributesEntry.value:type_name -> channeldpb.ChannelAttributeValue
+	2,  // 68: channeldpb.ListChannelResultMessage.ChannelInfo.channelType:type_name -> channeldpb.ChannelType
+	75, // 69: channeldpb.ListChannelResultMessage.ChannelInfo.attributes:type_name -> channeldpb.ListChannelResultMessage.ChannelInfo.AttributesEntry
+	22, // 70: channeldpb.ListChannelResultMessage.ChannelInfo.AttributesEntry.value:type_name -> channeldpb.ChannelAttributeValue
+	1,  // 71: channeldpb.ChannelPresenceMessage.MemberInfo.connType:type_name -> channeldpb.ConnectionType
+	22, // 72: channeldpb.UpdateChannelAttributesMessage.AttributesEntry.value:type_name -> channeldpb.ChannelAttributeValue
+	22, // 73: channeldpb.UpdateChannelAttributesResultMessage.AttributesEntry.value:type_name -> channeldpb.ChannelAttributeValue
+	1,  // 74: channeldpb.GetChannelInfoResultMessage.SubscriberInfo.connType:type_name -> channeldpb.ConnectionType
+	18, // 75: channeldpb.GetChannelInfoResultMessage.SubscriberInfo.options:type_name -> channeldpb.ChannelSubscriptionOptions
+	22, // 76: channeldpb.GetChannelInfoResultMessage.AttributesEntry.value:type_name -> channeldpb.ChannelAttributeValue
+	91, // 77: channeldpb.ChannelDataTransactionMessage.Update.data:type_name -> google.protobuf.Any
+	91, // 78: channeldpb.GetChannelDataResultMessage.ChannelDataResult.data:type_name -> google.protobuf.Any
+	18, // 79: channeldpb.BatchSubscribeMessage.SubEntry.subOptions:type_name -> channeldpb.ChannelSubscriptionOptions
+	2,  // 80: channeldpb.BatchSubscribeResultMessage.ChannelResult.channelType:type_name -> channeldpb.ChannelType
+	18, // 81: channeldpb.BatchSubscribeResultMessage.ChannelResult.subOptions:type_name -> channeldpb.ChannelSubscriptionOptions
+	12, // 82: channeldpb.BatchSubscribeResultMessage.ChannelResult.errorCode:type_name -> channeldpb.ErrorResultMessage.ErrorCode
+	58, // 83: channeldpb.SpatialInterestQuery.SpotsAOI.spots:type_name -> channeldpb.SpatialInfo
+	58, // 84: channeldpb.SpatialInterestQuery.BoxAOI.center:type_name -> channeldpb.SpatialInfo
+	58, // 85: channeldpb.SpatialInterestQuery.BoxAOI.extent:type_name -> channeldpb.SpatialInfo
+	58, // 86: channeldpb.SpatialInterestQuery.SphereAOI.center:type_name -> channeldpb.SpatialInfo
+	58, // 87: channeldpb.SpatialInterestQuery.ConeAOI.center:type_name -> channeldpb.SpatialInfo
+	58, // 88: channeldpb.SpatialInterestQuery.ConeAOI.direction:type_name -> channeldpb.SpatialInfo
+	89, // [89:89] is the sub-list for method output_type
+	89, // [89:89] is the sub-list for method input_type
+	89, // [89:89] is the sub-list for extension type_name
+	89, // [89:89] is the sub-list for extension extendee
+	0,  // [0:89] is the sub-list for field type_name
 }
 
 func init() { file_channeld_proto_init() }
@@ -3291,6 +6756,7 @@ func file_channeld_proto_init() {
 	if File_channeld_proto != nil {
 		return
 	}
+	file_merge_options_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_channeld_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Packet); i {
@@ -3304,8 +6770,212 @@ func file_channeld_proto_init() {
 				return nil
 			}
 		}
-		file_channeld_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MessagePack); i {
+		file_channeld_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MessagePack); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_channeld_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerForwardMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_channeld_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_channeld_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthResultMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_channeld_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelSubscriptionOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_channeld_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FanOutTier); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_channeld_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelDataMergeOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_channeld_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateChannelMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_channeld_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelAttributeValue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_channeld_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelAttributeFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_channeld_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelSubscriberLimit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_channeld_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateChannelResultMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_channeld_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveChannelMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_channeld_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListChannelMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_channeld_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListChannelResultMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_channeld_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribedToChannelMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_channeld_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribedToChannelResultMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_channeld_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateSubOptionsMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3316,8 +6986,8 @@ func file_channeld_proto_init() {
 				return nil
 			}
 		}
-		file_channeld_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ServerForwardMessage); i {
+		file_channeld_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateSubOptionsResultMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3328,8 +6998,8 @@ func file_channeld_proto_init() {
 				return nil
 			}
 		}
-		file_channeld_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthMessage); i {
+		file_channeld_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelPresenceMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3340,8 +7010,8 @@ func file_channeld_proto_init() {
 				return nil
 			}
 		}
-		file_channeld_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthResultMessage); i {
+		file_channeld_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnsubscribedFromChannelMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3352,8 +7022,8 @@ func file_channeld_proto_init() {
 				return nil
 			}
 		}
-		file_channeld_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChannelSubscriptionOptions); i {
+		file_channeld_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnsubscribedFromChannelResultMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3364,8 +7034,8 @@ func file_channeld_proto_init() {
 				return nil
 			}
 		}
-		file_channeld_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChannelDataMergeOptions); i {
+		file_channeld_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelDataUpdateMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3376,8 +7046,8 @@ func file_channeld_proto_init() {
 				return nil
 			}
 		}
-		file_channeld_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateChannelMessage); i {
+		file_channeld_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelDataConflictMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3388,8 +7058,8 @@ func file_channeld_proto_init() {
 				return nil
 			}
 		}
-		file_channeld_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateChannelResultMessage); i {
+		file_channeld_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelDataAckMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3400,8 +7070,8 @@ func file_channeld_proto_init() {
 				return nil
 			}
 		}
-		file_channeld_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveChannelMessage); i {
+		file_channeld_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReserveSubscriberSlotsMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3412,8 +7082,8 @@ func file_channeld_proto_init() {
 				return nil
 			}
 		}
-		file_channeld_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListChannelMessage); i {
+		file_channeld_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateChannelAttributesMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3424,8 +7094,8 @@ func file_channeld_proto_init() {
 				return nil
 			}
 		}
-		file_channeld_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListChannelResultMessage); i {
+		file_channeld_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateChannelAttributesResultMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3436,8 +7106,8 @@ func file_channeld_proto_init() {
 				return nil
 			}
 		}
-		file_channeld_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubscribedToChannelMessage); i {
+		file_channeld_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetChannelInfoMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3448,8 +7118,8 @@ func file_channeld_proto_init() {
 				return nil
 			}
 		}
-		file_channeld_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubscribedToChannelResultMessage); i {
+		file_channeld_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetChannelInfoResultMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3460,8 +7130,8 @@ func file_channeld_proto_init() {
 				return nil
 			}
 		}
-		file_channeld_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnsubscribedFromChannelMessage); i {
+		file_channeld_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduleMessageMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3472,8 +7142,8 @@ func file_channeld_proto_init() {
 				return nil
 			}
 		}
-		file_channeld_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnsubscribedFromChannelResultMessage); i {
+		file_channeld_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduleMessageResultMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3484,8 +7154,8 @@ func file_channeld_proto_init() {
 				return nil
 			}
 		}
-		file_channeld_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChannelDataUpdateMessage); i {
+		file_channeld_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelScheduledMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3496,7 +7166,115 @@ func file_channeld_proto_init() {
 				return nil
 			}
 		}
-		file_channeld_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+		file_channeld_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelDataTransactionMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_channeld_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelDataTransactionResultMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_channeld_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetChannelDataMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_channeld_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetChannelDataResultMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_channeld_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchSubscribeMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_channeld_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchSubscribeResultMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_channeld_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AutoSubscribeMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_channeld_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AutoSubscribeResultMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_channeld_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveAutoSubscribeMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_channeld_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DisconnectMessage); i {
 			case 0:
 				return &v.state
@@ -3508,7 +7286,19 @@ func file_channeld_proto_init() {
 				return nil
 			}
 		}
-		file_channeld_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+		file_channeld_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ErrorResultMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_channeld_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SpatialInfo); i {
 			case 0:
 				return &v.state
@@ -3520,7 +7310,7 @@ func file_channeld_proto_init() {
 				return nil
 			}
 		}
-		file_channeld_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+		file_channeld_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateSpatialChannelsResultMessage); i {
 			case 0:
 				return &v.state
@@ -3532,7 +7322,7 @@ func file_channeld_proto_init() {
 				return nil
 			}
 		}
-		file_channeld_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+		file_channeld_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*QuerySpatialChannelMessage); i {
 			case 0:
 				return &v.state
@@ -3544,7 +7334,7 @@ func file_channeld_proto_init() {
 				return nil
 			}
 		}
-		file_channeld_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+		file_channeld_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*QuerySpatialChannelResultMessage); i {
 			case 0:
 				return &v.state
@@ -3556,7 +7346,7 @@ func file_channeld_proto_init() {
 				return nil
 			}
 		}
-		file_channeld_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+		file_channeld_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ChannelDataHandoverMessage); i {
 			case 0:
 				return &v.state
@@ -3568,7 +7358,7 @@ func file_channeld_proto_init() {
 				return nil
 			}
 		}
-		file_channeld_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+		file_channeld_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SpatialRegion); i {
 			case 0:
 				return &v.state
@@ -3580,7 +7370,7 @@ func file_channeld_proto_init() {
 				return nil
 			}
 		}
-		file_channeld_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+		file_channeld_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SpatialRegionsUpdateMessage); i {
 			case 0:
 				return &v.state
@@ -3592,7 +7382,7 @@ func file_channeld_proto_init() {
 				return nil
 			}
 		}
-		file_channeld_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+		file_channeld_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SpatialInterestQuery); i {
 			case 0:
 				return &v.state
@@ -3604,7 +7394,7 @@ func file_channeld_proto_init() {
 				return nil
 			}
 		}
-		file_channeld_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+		file_channeld_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateSpatialInterestMessage); i {
 			case 0:
 				return &v.state
@@ -3616,7 +7406,7 @@ func file_channeld_proto_init() {
 				return nil
 			}
 		}
-		file_channeld_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+		file_channeld_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateEntityChannelMessage); i {
 			case 0:
 				return &v.state
@@ -3628,7 +7418,7 @@ func file_channeld_proto_init() {
 				return nil
 			}
 		}
-		file_channeld_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+		file_channeld_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AddEntityGroupMessage); i {
 			case 0:
 				return &v.state
@@ -3640,7 +7430,7 @@ func file_channeld_proto_init() {
 				return nil
 			}
 		}
-		file_channeld_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+		file_channeld_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RemoveEntityGroupMessage); i {
 			case 0:
 				return &v.state
@@ -3652,7 +7442,7 @@ func file_channeld_proto_init() {
 				return nil
 			}
 		}
-		file_channeld_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+		file_channeld_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DebugGetSpatialRegionsMessage); i {
 			case 0:
 				return &v.state
@@ -3664,7 +7454,19 @@ func file_channeld_proto_init() {
 				return nil
 			}
 		}
-		file_channeld_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+		file_channeld_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListChannelCursor); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_channeld_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListChannelResultMessage_ChannelInfo); i {
 			case 0:
 				return &v.state
@@ -3676,7 +7478,91 @@ func file_channeld_proto_init() {
 				return nil
 			}
 		}
-		file_channeld_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+		file_channeld_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelPresenceMessage_MemberInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_channeld_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelDataConflictMessage_FieldConflict); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_channeld_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetChannelInfoResultMessage_SubscriberInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_channeld_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelDataTransactionMessage_Update); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_channeld_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetChannelDataResultMessage_ChannelDataResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_channeld_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchSubscribeMessage_SubEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_channeld_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchSubscribeResultMessage_ChannelResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_channeld_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SpatialInterestQuery_SpotsAOI); i {
 			case 0:
 				return &v.state
@@ -3688,7 +7574,7 @@ func file_channeld_proto_init() {
 				return nil
 			}
 		}
-		file_channeld_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+		file_channeld_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SpatialInterestQuery_BoxAOI); i {
 			case 0:
 				return &v.state
@@ -3700,7 +7586,7 @@ func file_channeld_proto_init() {
 				return nil
 			}
 		}
-		file_channeld_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+		file_channeld_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SpatialInterestQuery_SphereAOI); i {
 			case 0:
 				return &v.state
@@ -3712,7 +7598,7 @@ func file_channeld_proto_init() {
 				return nil
 			}
 		}
-		file_channeld_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+		file_channeld_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SpatialInterestQuery_ConeAOI); i {
 			case 0:
 				return &v.state
@@ -3726,14 +7612,21 @@ func file_channeld_proto_init() {
 		}
 	}
 	file_channeld_proto_msgTypes[5].OneofWrappers = []interface{}{}
-	file_channeld_proto_msgTypes[25].OneofWrappers = []interface{}{}
+	file_channeld_proto_msgTypes[8].OneofWrappers = []interface{}{}
+	file_channeld_proto_msgTypes[9].OneofWrappers = []interface{}{
+		(*ChannelAttributeValue_StringValue)(nil),
+		(*ChannelAttributeValue_IntValue)(nil),
+		(*ChannelAttributeValue_BoolValue)(nil),
+	}
+	file_channeld_proto_msgTypes[35].OneofWrappers = []interface{}{}
+	file_channeld_proto_msgTypes[52].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_channeld_proto_rawDesc,
-			NumEnums:      8,
-			NumMessages:   36,
+			NumEnums:      13,
+			NumMessages:   78,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
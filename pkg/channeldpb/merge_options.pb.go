@@ -0,0 +1,360 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.20.1
+// source: merge_options.proto
+
+package channeldpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	descriptorpb "google.golang.org/protobuf/types/descriptorpb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// The annotations used by protoc-gen-channeld-merge to generate the implementations of MergeableChannelData and the other interfaces
+// for the channel data messages. See cmd/protoc-gen-channeld-merge.
+//
+// Example:
+//
+//	message MyChannelData {
+//	    option (channeldpb.messageMerge) = { generate: true };
+//	    map<uint32, TransformState> transformStates = 1 [(channeldpb.fieldMerge) = { removable: true, spatialField: "position" }];
+//	    repeated ChatMessage messages = 2 [(channeldpb.fieldMerge) = { listSizeLimit: 100, truncateTop: true }];
+//	}
+type MessageMergeOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Generates Merge() of [channeld.MergeableChannelData] and Init() of [channeld.ChannelDataInitializer] for the message.
+	Generate bool `protobuf:"varint,1,opt,name=generate,proto3" json:"generate,omitempty"`
+	// Generates [channeld.SpatialChannelEntityUpdater] for the spatial channel data message.
+	// The name of the map field that holds the entities, keyed by the entity ID.
+	EntitiesField string `protobuf:"bytes,2,opt,name=entitiesField,proto3" json:"entitiesField,omitempty"`
+	// Generates [channeld.HandoverDataMerger] for the entity channel data message, which merges itself into the map field of
+	// the spatial channel data upon handover, in the form of "<message name>.<field name>", e.g. "MySpatialChannelData.entities".
+	// The spatial channel data message should be in the same package, and the value type of the map should be the entity channel data message.
+	HandoverMapField string `protobuf:"bytes,3,opt,name=handoverMapField,proto3" json:"handoverMapField,omitempty"`
+	// The field of the entity channel data message that holds the entity ID, as the key of @handoverMapField.
+	EntityIdField string `protobuf:"bytes,4,opt,name=entityIdField,proto3" json:"entityIdField,omitempty"`
+}
+
+func (x *MessageMergeOptions) Reset() {
+	*x = MessageMergeOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_merge_options_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MessageMergeOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageMergeOptions) ProtoMessage() {}
+
+func (x *MessageMergeOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_merge_options_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageMergeOptions.ProtoReflect.Descriptor instead.
+func (*MessageMergeOptions) Descriptor() ([]byte, []int) {
+	return file_merge_options_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MessageMergeOptions) GetGenerate() bool {
+	if x != nil {
+		return x.Generate
+	}
+	return false
+}
+
+func (x *MessageMergeOptions) GetEntitiesField() string {
+	if x != nil {
+		return x.EntitiesField
+	}
+	return ""
+}
+
+func (x *MessageMergeOptions) GetHandoverMapField() string {
+	if x != nil {
+		return x.HandoverMapField
+	}
+	return ""
+}
+
+func (x *MessageMergeOptions) GetEntityIdField() string {
+	if x != nil {
+		return x.EntityIdField
+	}
+	return ""
+}
+
+// The merge options of a field of the channel data message. Overrides the @ChannelDataMergeOptions of the channel for the field.
+type FieldMergeOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Replaces the list instead of appending to it.
+	ReplaceList bool `protobuf:"varint,1,opt,name=replaceList,proto3" json:"replaceList,omitempty"`
+	// If the value is greater than 0, truncate the list when oversized.
+	ListSizeLimit uint32 `protobuf:"varint,2,opt,name=listSizeLimit,proto3" json:"listSizeLimit,omitempty"`
+	// If true, the top elements of the list will be truncated instead of the end.
+	TruncateTop bool `protobuf:"varint,3,opt,name=truncateTop,proto3" json:"truncateTop,omitempty"`
+	// Removes the map entry that has removed=true in its value, instead of merging it.
+	Removable bool `protobuf:"varint,4,opt,name=removable,proto3" json:"removable,omitempty"`
+	// Only for the map field of message values. The field of the map value that holds the position of the entity, e.g. "position".
+	// The position message should have x and z (and optionally y) fields. When the position of an existing entry changes,
+	// the spatial notifier is notified, with the handover data that contains the entries of the same key in all the map fields.
+	SpatialField string `protobuf:"bytes,5,opt,name=spatialField,proto3" json:"spatialField,omitempty"`
+}
+
+func (x *FieldMergeOptions) Reset() {
+	*x = FieldMergeOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_merge_options_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FieldMergeOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldMergeOptions) ProtoMessage() {}
+
+func (x *FieldMergeOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_merge_options_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldMergeOptions.ProtoReflect.Descriptor instead.
+func (*FieldMergeOptions) Descriptor() ([]byte, []int) {
+	return file_merge_options_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *FieldMergeOptions) GetReplaceList() bool {
+	if x != nil {
+		return x.ReplaceList
+	}
+	return false
+}
+
+func (x *FieldMergeOptions) GetListSizeLimit() uint32 {
+	if x != nil {
+		return x.ListSizeLimit
+	}
+	return 0
+}
+
+func (x *FieldMergeOptions) GetTruncateTop() bool {
+	if x != nil {
+		return x.TruncateTop
+	}
+	return false
+}
+
+func (x *FieldMergeOptions) GetRemovable() bool {
+	if x != nil {
+		return x.Removable
+	}
+	return false
+}
+
+func (x *FieldMergeOptions) GetSpatialField() string {
+	if x != nil {
+		return x.SpatialField
+	}
+	return ""
+}
+
+var file_merge_options_proto_extTypes = []protoimpl.ExtensionInfo{
+	{
+		ExtendedType:  (*descriptorpb.MessageOptions)(nil),
+		ExtensionType: (*MessageMergeOptions)(nil),
+		Field:         51001,
+		Name:          "channeldpb.messageMerge",
+		Tag:           "bytes,51001,opt,name=messageMerge",
+		Filename:      "merge_options.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FieldOptions)(nil),
+		ExtensionType: (*FieldMergeOptions)(nil),
+		Field:         51001,
+		Name:          "channeldpb.fieldMerge",
+		Tag:           "bytes,51001,opt,name=fieldMerge",
+		Filename:      "merge_options.proto",
+	},
+}
+
+// Extension fields to descriptorpb.MessageOptions.
+var (
+	// optional channeldpb.MessageMergeOptions messageMerge = 51001;
+	E_MessageMerge = &file_merge_options_proto_extTypes[0]
+)
+
+// Extension fields to descriptorpb.FieldOptions.
+var (
+	// optional channeldpb.FieldMergeOptions fieldMerge = 51001;
+	E_FieldMerge = &file_merge_options_proto_extTypes[1]
+)
+
+var File_merge_options_proto protoreflect.FileDescriptor
+
+var file_merge_options_proto_rawDesc = []byte{
+	0x0a, 0x13, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x64, 0x70,
+	0x62, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0xa9, 0x01, 0x0a, 0x13, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4d,
+	0x65, 0x72, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x67,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x67,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x69, 0x65, 0x73, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x2a, 0x0a,
+	0x10, 0x68, 0x61, 0x6e, 0x64, 0x6f, 0x76, 0x65, 0x72, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x68, 0x61, 0x6e, 0x64, 0x6f, 0x76, 0x65,
+	0x72, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x49, 0x64, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x64, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x22,
+	0xbf, 0x01, 0x0a, 0x11, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65,
+	0x4c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x72, 0x65, 0x70, 0x6c,
+	0x61, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x6c, 0x69, 0x73, 0x74, 0x53,
+	0x69, 0x7a, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d,
+	0x6c, 0x69, 0x73, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x20, 0x0a,
+	0x0b, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x70, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0b, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x70, 0x12,
+	0x1c, 0x0a, 0x09, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x22, 0x0a,
+	0x0c, 0x73, 0x70, 0x61, 0x74, 0x69, 0x61, 0x6c, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x70, 0x61, 0x74, 0x69, 0x61, 0x6c, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x3a, 0x66, 0x0a, 0x0c, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4d, 0x65, 0x72, 0x67,
+	0x65, 0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0xb9, 0x8e, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4d,
+	0x65, 0x72, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0c, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x3a, 0x5e, 0x0a, 0x0a, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xb9, 0x8e, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x64, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0a, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x42, 0x30, 0x5a, 0x2e, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x65, 0x74, 0x61, 0x77, 0x6f, 0x72, 0x6b,
+	0x69, 0x6e, 0x67, 0x2f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x64, 0x2f, 0x70, 0x6b, 0x67,
+	0x2f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x64, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_merge_options_proto_rawDescOnce sync.Once
+	file_merge_options_proto_rawDescData = file_merge_options_proto_rawDesc
+)
+
+func file_merge_options_proto_rawDescGZIP() []byte {
+	file_merge_options_proto_rawDescOnce.Do(func() {
+		file_merge_options_proto_rawDescData = protoimpl.X.CompressGZIP(file_merge_options_proto_rawDescData)
+	})
+	return file_merge_options_proto_rawDescData
+}
+
+var file_merge_options_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_merge_options_proto_goTypes = []interface{}{
+	(*MessageMergeOptions)(nil),         // 0: channeldpb.MessageMergeOptions
+	(*FieldMergeOptions)(nil),           // 1: channeldpb.FieldMergeOptions
+	(*descriptorpb.MessageOptions)(nil), // 2: google.protobuf.MessageOptions
+	(*descriptorpb.FieldOptions)(nil),   // 3: google.protobuf.FieldOptions
+}
+var file_merge_options_proto_depIdxs = []int32{
+	2, // 0: channeldpb.messageMerge:extendee -> google.protobuf.MessageOptions
+	3, // 1: channeldpb.fieldMerge:extendee -> google.protobuf.FieldOptions
+	0, // 2: channeldpb.messageMerge:type_name -> channeldpb.MessageMergeOptions
+	1, // 3: channeldpb.fieldMerge:type_name -> channeldpb.FieldMergeOptions
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	2, // [2:4] is the sub-list for extension type_name
+	0, // [0:2] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_merge_options_proto_init() }
+func file_merge_options_proto_init() {
+	if File_merge_options_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_merge_options_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MessageMergeOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_merge_options_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FieldMergeOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_merge_options_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 2,
+			NumServices:   0,
+		},
+		GoTypes:           file_merge_options_proto_goTypes,
+		DependencyIndexes: file_merge_options_proto_depIdxs,
+		MessageInfos:      file_merge_options_proto_msgTypes,
+		ExtensionInfos:    file_merge_options_proto_extTypes,
+	}.Build()
+	File_merge_options_proto = out.File
+	file_merge_options_proto_rawDesc = nil
+	file_merge_options_proto_goTypes = nil
+	file_merge_options_proto_depIdxs = nil
+}
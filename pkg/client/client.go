@@ -87,6 +87,17 @@ func NewClient(addr string) (*ChanneldClient, error) {
 	c.SetMessageEntry(uint32(channeldpb.MessageType_UNSUB_FROM_CHANNEL), &channeldpb.UnsubscribedFromChannelResultMessage{}, handleUnsubToChannel)
 	c.SetMessageEntry(uint32(channeldpb.MessageType_LIST_CHANNEL), &channeldpb.ListChannelResultMessage{}, handleListChannel)
 	c.SetMessageEntry(uint32(channeldpb.MessageType_CHANNEL_DATA_UPDATE), &channeldpb.ChannelDataUpdateMessage{}, defaultMessageHandler)
+	c.SetMessageEntry(uint32(channeldpb.MessageType_ERROR_RESULT), &channeldpb.ErrorResultMessage{}, defaultMessageHandler)
+	c.SetMessageEntry(uint32(channeldpb.MessageType_UPDATE_CHANNEL_ATTRIBUTES), &channeldpb.UpdateChannelAttributesResultMessage{}, defaultMessageHandler)
+	c.SetMessageEntry(uint32(channeldpb.MessageType_GET_CHANNEL_INFO), &channeldpb.GetChannelInfoResultMessage{}, defaultMessageHandler)
+	c.SetMessageEntry(uint32(channeldpb.MessageType_SCHEDULE_MESSAGE), &channeldpb.ScheduleMessageResultMessage{}, defaultMessageHandler)
+	c.SetMessageEntry(uint32(channeldpb.MessageType_CHANNEL_DATA_TRANSACTION), &channeldpb.ChannelDataTransactionResultMessage{}, defaultMessageHandler)
+	c.SetMessageEntry(uint32(channeldpb.MessageType_CHANNEL_PRESENCE), &channeldpb.ChannelPresenceMessage{}, defaultMessageHandler)
+	c.SetMessageEntry(uint32(channeldpb.MessageType_CHANNEL_DATA_CONFLICT), &channeldpb.ChannelDataConflictMessage{}, defaultMessageHandler)
+	c.SetMessageEntry(uint32(channeldpb.MessageType_GET_CHANNEL_DATA), &channeldpb.GetChannelDataResultMessage{}, defaultMessageHandler)
+	c.SetMessageEntry(uint32(channeldpb.MessageType_UPDATE_SUB_OPTIONS), &channeldpb.UpdateSubOptionsResultMessage{}, defaultMessageHandler)
+	c.SetMessageEntry(uint32(channeldpb.MessageType_BATCH_SUBSCRIBE), &channeldpb.BatchSubscribeResultMessage{}, defaultMessageHandler)
+	c.SetMessageEntry(uint32(channeldpb.MessageType_AUTO_SUBSCRIBE), &channeldpb.AutoSubscribeResultMessage{}, defaultMessageHandler)
 
 	return c, nil
 }
@@ -3,6 +3,7 @@ package fsm
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"strconv"
 	"strings"
 	"sync"
@@ -35,36 +36,63 @@ type FiniteStateMachine struct {
 	lock         *sync.RWMutex
 }
 
+// The inclusive range of message types.
+type MsgTypeRange struct {
+	From uint32
+	To   uint32
+}
+
+func (r MsgTypeRange) Contains(msgType uint32) bool {
+	return msgType >= r.From && msgType <= r.To
+}
+
+func parseMsgTypeRange(seg string) (MsgTypeRange, error) {
+	seg = strings.Trim(seg, " ")
+	fromTo := strings.Split(seg, "-")
+	fromType, err := strconv.ParseUint(strings.Trim(fromTo[0], " "), 10, 32)
+	if err != nil {
+		return MsgTypeRange{}, fmt.Errorf("can't convert '%s' to uint32", fromTo[0])
+	}
+	if len(fromTo) == 1 {
+		return MsgTypeRange{From: uint32(fromType), To: uint32(fromType)}, nil
+	}
+	toType, err := strconv.ParseUint(strings.Trim(fromTo[1], " "), 10, 32)
+	if err != nil {
+		return MsgTypeRange{}, fmt.Errorf("can't convert '%s' to uint32", fromTo[1])
+	}
+	return MsgTypeRange{From: uint32(fromType), To: uint32(toType)}, nil
+}
+
+// Parses the message types in the same format as MsgTypeWhitelist, e.g. "1, 2-10, 30".
+func ParseMsgTypeRanges(s string) ([]MsgTypeRange, error) {
+	ranges := make([]MsgTypeRange, 0)
+	if len(s) == 0 {
+		return ranges, nil
+	}
+
+	for _, seg := range strings.Split(s, ",") {
+		r, err := parseMsgTypeRange(seg)
+		if err != nil {
+			return nil, err
+		}
+		ranges = append(ranges, r)
+	}
+	return ranges, nil
+}
+
 func parseMsgTypes(s string, f func(msgType uint32)) {
 	if len(s) == 0 {
 		return
 	}
 
 	for _, seg := range strings.Split(s, ",") {
-		seg = strings.Trim(seg, " ")
-		fromTo := strings.Split(seg, "-")
-		if len(fromTo) == 1 {
-			msgType, err := strconv.ParseUint(fromTo[0], 10, 32)
-			if err != nil {
-				logger.Errorf("Can't convert '%s' to uint32\n", fromTo[0])
-			} else {
-				f(uint32(msgType))
-			}
-		} else {
-			fromType, err := strconv.ParseUint(fromTo[0], 10, 32)
-			if err != nil {
-				logger.Errorf("Can't convert '%s' to uint32\n", fromTo[0])
-			} else {
-				toType, err := strconv.ParseUint(fromTo[1], 10, 32)
-				if err != nil {
-					logger.Errorf("Can't convert '%s' to uint32\n", fromTo[1])
-				} else {
-					for i := fromType; i <= toType; i += 1 {
-						f(uint32(i))
-					}
-				}
-
-			}
+		r, err := parseMsgTypeRange(seg)
+		if err != nil {
+			logger.Errorf("%v\n", err)
+			continue
+		}
+		for i := uint64(r.From); i <= uint64(r.To); i += 1 {
+			f(uint32(i))
 		}
 	}
 }
@@ -169,3 +197,21 @@ func (fsm *FiniteStateMachine) MoveToNextState() bool {
 	}
 	return false
 }
+
+// Replaces the states and the transitions with the template's, and keeps the current state by its name.
+// Used to apply the reloaded FSM to the existing connection.
+func (fsm *FiniteStateMachine) Migrate(template *FiniteStateMachine) error {
+	fsm.lock.Lock()
+	defer fsm.lock.Unlock()
+
+	state, exists := template.stateNameMap[fsm.currentState.Name]
+	if !exists {
+		return errors.New("Invalid state name: " + fsm.currentState.Name)
+	}
+	fsm.InitState = template.InitState
+	fsm.States = template.States
+	fsm.Transitions = template.Transitions
+	fsm.stateNameMap = template.stateNameMap
+	fsm.currentState = state
+	return nil
+}
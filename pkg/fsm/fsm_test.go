@@ -64,3 +64,35 @@ func TestTransitionAndMsgAllowence(t *testing.T) {
 	serverFSM.OnReceived(22)
 	assert.Equal(t, "OPEN", serverFSM.CurrentState().Name)
 }
+
+func TestParseMsgTypeRanges(t *testing.T) {
+	ranges, err := ParseMsgTypeRanges("3-8, 15 , 100-65535")
+	assert.NoError(t, err)
+	assert.Equal(t, []MsgTypeRange{{3, 8}, {15, 15}, {100, 65535}}, ranges)
+	assert.True(t, ranges[0].Contains(8))
+	assert.False(t, ranges[1].Contains(16))
+
+	_, err = ParseMsgTypeRanges("1,a-3")
+	assert.Error(t, err)
+}
+
+func TestMigrate(t *testing.T) {
+	serverFSM := loadServerFSM(t)
+	serverFSM.OnReceived(1)
+	assert.False(t, serverFSM.IsAllowed(9))
+
+	template, err := Load([]byte(`{"States": [{"Name": "INIT", "MsgTypeWhitelist": "1"}, {"Name": "OPEN", "MsgTypeWhitelist": "2-10"}]}`))
+	assert.NoError(t, err)
+	assert.NoError(t, serverFSM.Migrate(template))
+	assert.Equal(t, "OPEN", serverFSM.CurrentState().Name)
+	assert.True(t, serverFSM.IsAllowed(9))
+	assert.False(t, serverFSM.IsAllowed(20))
+
+	// The state of the other FSMs of the template is not affected.
+	assert.Equal(t, "INIT", template.CurrentState().Name)
+
+	template, _ = Load([]byte(`{"States": [{"Name": "INIT", "MsgTypeWhitelist": "1"}]}`))
+	assert.Error(t, serverFSM.Migrate(template))
+	assert.Equal(t, "OPEN", serverFSM.CurrentState().Name)
+	assert.True(t, serverFSM.IsAllowed(9))
+}